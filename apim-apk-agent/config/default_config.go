@@ -46,6 +46,12 @@ var defaultConfig = &Config{
 		},
 		InternalKeyIssuer: "http://am.wso2.com:443/token",
 		Provider:          "admin",
+		RevisionAck: revisionAck{
+			BatchInterval:  2,
+			InitialBackoff: 5,
+			MaxBackoff:     300,
+			MaxAttempts:    50,
+		},
 	},
 	Agent: agent{
 		Enabled: true,
@@ -57,6 +63,59 @@ var defaultConfig = &Config{
 			Location: "/home/wso2/security/truststore",
 		},
 		Mode: "DPtoCP",
+		InternalServer: internalServer{
+			AuthType: "none",
+		},
+		Snapshot: snapshot{
+			Enabled: false,
+			Path:    "/home/wso2/snapshot",
+		},
+		State: state{
+			Path: "/home/wso2/state",
+		},
+		ShutdownTimeout: 25,
+		HealthProbe: healthProbe{
+			Enabled:         true,
+			Port:            18005,
+			LivenessChecks:  []string{},
+			ReadinessChecks: []string{"initial-sync", "grpc-server", "cr-client"},
+		},
+		Audit: audit{
+			Enabled:          false,
+			Output:           "stdout",
+			Path:             "/home/wso2/audit",
+			RetentionDays:    7,
+			MaxRecords:       10000,
+			KubernetesEvents: false,
+		},
+	},
+	DataPlane: dataPlane{
+		Sink: sink{
+			Type: "k8s",
+			Git: gitSink{
+				Path:          "/home/wso2/gitops",
+				CommitEnabled: true,
+				AuthorName:    "apim-apk-agent",
+				AuthorEmail:   "apim-apk-agent@wso2.com",
+				PushEnabled:   false,
+				Remote:        "origin",
+				Branch:        "main",
+			},
+		},
+		TokenIssuer: tokenIssuer{
+			Gateway:                  "wso2-apk-default",
+			InternalKeyIssuerEnabled: true,
+			InternalKeySecretName:    "apim-apk-issuer-cert",
+			InternalKeySecretKey:     "wso2.crt",
+			Mappings:                 []tokenIssuerMapping{},
+		},
+		AIProvider: aiProvider{
+			OverridesPath: "/home/wso2/aiproviders",
+		},
+		PolicySync: policySync{
+			Enabled:    false,
+			OnConflict: "skip",
+		},
 	},
 	Metrics: metrics{
 		Enabled: false,
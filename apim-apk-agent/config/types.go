@@ -84,6 +84,92 @@ type agent struct {
 	Keystore   keystore
 	TrustStore truststore
 	Mode       string
+	// InternalServer contains the configurations related to the internal REST server
+	InternalServer internalServer
+	// Snapshot contains the configurations related to the last known good data fetched from the control plane
+	Snapshot snapshot
+	// State contains the configurations related to the state of the agent which is persisted across the restarts
+	State state
+	// Organizations contains the organizations served by the agent
+	Organizations organizations
+	// ShutdownTimeout is the time in seconds within which the in-flight events are drained and the servers and
+	// the broker connections are closed, once the agent receives a SIGTERM or a SIGINT
+	ShutdownTimeout time.Duration
+	// HealthProbe contains the configurations of the /healthz and /readyz HTTP endpoints
+	HealthProbe healthProbe
+	// Audit contains the configurations of the audit log of the changes applied to the data plane
+	Audit audit
+}
+
+// audit struct contains the configurations of the audit log. The notifications received, the CRs created, updated
+// or deleted and the revision acknowledgements sent are recorded as JSON lines, correlated by the event ID and the
+// API UUID.
+type audit struct {
+	Enabled bool
+	// Output of the records. Supported values are "stdout" and "file".
+	Output string
+	// Path of the directory to which a file of records is written per day, if the Output is "file"
+	Path string
+	// RetentionDays is the number of days for which the records are kept
+	RetentionDays int
+	// MaxRecords is the maximum number of the latest records which can be queried through the internal server
+	MaxRecords int
+	// KubernetesEvents emits a Kubernetes Event on the API CR whenever it is created, updated or deleted
+	KubernetesEvents bool
+}
+
+// healthProbe struct contains the configurations of the liveness (/healthz) and readiness (/readyz) HTTP endpoints.
+// The checks of each endpoint are selected from "control-plane", "broker", "initial-sync", "event-listener",
+// "grpc-server" and "cr-client". An endpoint without checks reports healthy as long as the agent is running.
+type healthProbe struct {
+	Enabled         bool
+	Port            uint
+	LivenessChecks  []string
+	ReadinessChecks []string
+}
+
+// organizations struct contains the organizations of which the resources are deployed in the data plane. An
+// organization in the DenyList is never served. If the AllowList is not empty, only the organizations in it are served.
+type organizations struct {
+	AllowList []string
+	DenyList  []string
+}
+
+// snapshot struct contains the configurations related to persisting the data fetched from the control plane.
+// The persisted data is loaded at the startup when the control plane is unreachable or responds with a server
+// error. It is disabled by default, in which case the agent waits for the control plane at the startup.
+type snapshot struct {
+	Enabled bool
+	// Path of the directory to which the data is persisted. It should be backed by a persistent volume.
+	Path string
+}
+
+// state struct contains the configurations related to the state of the agent, which is always persisted. The latest
+// processed notification event of each resource is persisted, so that the redelivered events are not reapplied, along
+// with the outbox of the revision deployment acknowledgements which are not confirmed by the control plane yet.
+type state struct {
+	// Path of the directory to which the state is persisted. It should be backed by a persistent volume.
+	Path string
+}
+
+// internalServer struct contains the configurations related to the authentication of the internal REST server
+type internalServer struct {
+	// AuthType of the internal REST server. Supported values are "mtls", "jwt" and "none". It is "none" by default
+	// for compatibility with the existing deployments, in which case the /admin endpoints still require a client
+	// certificate.
+	AuthType string
+	JWT      internalServerJWT
+}
+
+// internalServerJWT struct contains the configurations used to validate the bearer tokens when the AuthType is "jwt"
+type internalServerJWT struct {
+	// Issuer of the accepted tokens. The controlPlane.internalKeyIssuer is used if it is not set.
+	Issuer   string
+	Audience string
+	// JwksURL of the issuer. The Certificate is used to validate the tokens if it is not set.
+	JwksURL string
+	// Certificate is the path of the PEM encoded public certificate of the issuer
+	Certificate string
 }
 type keystore struct {
 	KeyPath  string
@@ -114,6 +200,20 @@ type controlPlane struct {
 	ClientID                   string
 	ClientSecret               string
 	Provider                   string
+	// RevisionAck contains the configurations related to sending the revision deployment acknowledgements
+	RevisionAck revisionAck
+}
+
+// revisionAck struct contains the configurations of the outbox of the revision deployment acknowledgements. The
+// queued acknowledgements are sent in batches after BatchInterval, and the failed ones are retried with an
+// exponential backoff from InitialBackoff up to MaxBackoff until the control plane confirms them. The values are
+// in seconds. An acknowledgement which the control plane rejects with a client error, or which is not confirmed
+// within MaxAttempts, is dropped. The outbox is persisted with the state of the agent under agent.state.
+type revisionAck struct {
+	BatchInterval  time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	MaxAttempts    int
 }
 
 // Dataplane struct contains the configurations related to the APK
@@ -121,6 +221,87 @@ type dataPlane struct {
 	Enabled            bool
 	K8ResourceEndpoint string
 	Namespace          string
+	// Sink is the destination to which the generated CRs are written
+	Sink sink
+	// TokenIssuer contains the configurations of the TokenIssuer CRs created for the key managers
+	TokenIssuer tokenIssuer
+	// AIProvider contains the configurations of the AIProvider CRs created for the AI providers
+	AIProvider aiProvider
+	// PolicySync contains the configurations related to syncing the rate limit policy CRs to the control plane
+	PolicySync policySync
+}
+
+// policySync struct contains the configurations related to syncing the RateLimitPolicy and AIRateLimitPolicy CRs,
+// created on the data plane, to the control plane as throttling policies in the DPtoCP mode. It is disabled by
+// default. The subscription policies synced to the control plane are not created again as CRs when they are
+// notified back by the control plane. OnConflict decides what happens when the control plane already has a policy
+// of the same name, which is not created by the agent. Supported values are "skip" (leave the policy in the control
+// plane as it is) and "overwrite".
+type policySync struct {
+	Enabled    bool
+	OnConflict string
+}
+
+// aiProvider struct contains the configurations related to the AIProvider CRs.
+type aiProvider struct {
+	// OverridesPath is the directory of the files which override the configurations of the AI providers received
+	// from the control plane. The file of a provider is named <name>-<apiVersion>.json or <name>.json.
+	OverridesPath string
+}
+
+// tokenIssuer struct contains the configurations related to the TokenIssuer CRs created for the key managers.
+type tokenIssuer struct {
+	// Gateway is the name of the Gateway targeted by the TokenIssuers, unless a mapping overrides it
+	Gateway string
+	// InternalKeyIssuerEnabled creates a TokenIssuer per organization to validate the internal keys
+	InternalKeyIssuerEnabled bool
+	// InternalKeySecretName and InternalKeySecretKey refer to the certificate of the internal key issuer
+	InternalKeySecretName string
+	InternalKeySecretKey  string
+	// Mappings are applied to the TokenIssuers of the matching key managers, in the given order
+	Mappings []tokenIssuerMapping
+}
+
+// tokenIssuerMapping struct contains the properties of the TokenIssuers created for the key managers of a type
+// and an organization. An empty KeyManagerType or Organization matches any. The properties which are set override
+// those of the key manager and the earlier mappings.
+type tokenIssuerMapping struct {
+	KeyManagerType   string
+	Organization     string
+	Gateway          string
+	ConsumerKeyClaim string
+	ScopesClaim      string
+	// ClaimMappings are added to the claim mappings of the key manager
+	ClaimMappings []claimMapping
+	// SignatureValidation is the preferred way of validating the token signatures, "JWKS" or "Certificate".
+	// The other one is used if the key manager does not provide the preferred one.
+	SignatureValidation string
+}
+
+type claimMapping struct {
+	RemoteClaim string
+	LocalClaim  string
+}
+
+// sink struct contains the configurations related to the destination of the generated CRs
+type sink struct {
+	// Type of the sink. Supported values are "k8s" (apply to the cluster) and "git" (write to a git working tree)
+	Type string
+	Git  gitSink
+}
+
+// gitSink struct contains the configurations related to the git working tree sink
+type gitSink struct {
+	// Path is the location of the git working tree. It is initialized if it is not a git repository.
+	Path string
+	// CommitEnabled commits the changes of each API revision to the working tree
+	CommitEnabled bool
+	AuthorName    string
+	AuthorEmail   string
+	// PushEnabled pushes each commit to the Remote/Branch
+	PushEnabled bool
+	Remote      string
+	Branch      string
 }
 
 type requestWorkerPool struct {
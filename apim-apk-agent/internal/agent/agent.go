@@ -28,6 +28,7 @@ import (
 	"os/signal"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -40,14 +41,18 @@ import (
 	"github.com/wso2/apk/common-go-libs/loggers"
 	"github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/service/apkmgt"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/controller"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/eventhub"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
 	logging "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/logging"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/messaging"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/notifier"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/synchronizer"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/audit"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/health"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/metrics"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -85,15 +90,13 @@ func init() {
 
 }
 
-// Run starts the GRPC server and Rest API server.
+// Run starts the GRPC server and Rest API server. The agent is shut down gracefully upon a SIGINT or a SIGTERM.
 func Run(conf *config.Config) {
-	sig := make(chan os.Signal, 2)
-	signal.Notify(sig, os.Interrupt)
 	// TODO: (VirajSalaka) Support the REST API Configuration via flags only if it is a valid requirement
 	flag.Parse()
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	logger.LoggerAgent.Debugf("Run method started with context : %v", ctx)
 
@@ -111,7 +114,11 @@ func Run(conf *config.Config) {
 	logger.LoggerAgent.Info("Starting apim-apk-agent ....")
 	eventHubEnabled := conf.ControlPlane.Enabled
 
-	var probeAddr string
+	// The liveness and readiness probes are served on /healthz and /readyz by the manager
+	probeAddr := "0"
+	if conf.Agent.HealthProbe.Enabled {
+		probeAddr = fmt.Sprintf(":%d", conf.Agent.HealthProbe.Port)
+	}
 	var scheme = runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(gwapiv1.AddToScheme(scheme))
@@ -155,14 +162,33 @@ func Run(conf *config.Config) {
 	if err != nil {
 		logger.LoggerAgent.Error("unable to start kubernetes controller manager", err)
 	}
+	if conf.Agent.HealthProbe.Enabled {
+		if err := registerHealthProbes(mgr, conf); err != nil {
+			logger.LoggerAgent.Errorf("unable to register the health probes: %v", err)
+		}
+	}
+	// The rate limit policies created on the data plane are synced to the control plane in the DPtoCP mode
+	if eventHubEnabled && conf.Agent.Mode != "CPtoDP" && conf.DataPlane.PolicySync.Enabled {
+		if err := controller.SetupPolicySyncControllers(mgr, conf); err != nil {
+			logger.LoggerAgent.Errorf("unable to set up the rate limit policy sync controllers: %v", err)
+		}
+	}
+
+	// The CRs applied by the agent are recorded in the audit log, if it is enabled
+	k8sClient := audit.WithScope(mgr.GetClient(), audit.Scope{})
+	if conf.Agent.Audit.Enabled && conf.Agent.Audit.KubernetesEvents {
+		audit.SetEventRecorder(mgr.GetEventRecorderFor("apim-apk-agent"))
+	}
 
-	// Start the manager in a goroutine
+	// Start the manager in a goroutine. It is stopped at the end of the shutdown, since the in-flight events
+	// apply CRs through it.
+	managerCtx, stopManager := context.WithCancel(context.Background())
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		logger.LoggerAgent.Info("starting manager")
-		if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		if err := mgr.Start(managerCtx); err != nil {
 			logger.LoggerAgent.Warnf("problem running manager: %v", err)
 		}
 	}()
@@ -172,26 +198,33 @@ func Run(conf *config.Config) {
 
 	if AgentMode == "CPtoDP" {
 		// Load initial Policy data from control plane
-		synchronizer.FetchRateLimitPoliciesOnEvent("", "", mgr.GetClient())
+		synchronizer.FetchRateLimitPoliciesOnEvent("", "", k8sClient)
 	}
 	// Load initial Subscription Rate Limit data from control plane
-	synchronizer.FetchSubscriptionRateLimitPoliciesOnEvent("", "", mgr.GetClient(), true)
+	synchronizer.FetchSubscriptionRateLimitPoliciesOnEvent("", "", k8sClient, true)
 	// Load initial AI Provider data from control plane
-	synchronizer.FetchAIProvidersOnEvent("", "", "", mgr.GetClient(), true)
-
-	// Load initial data from control plane
-	eventhub.LoadInitialData(conf, mgr.GetClient())
+	synchronizer.FetchAIProvidersOnEvent("", "", "", k8sClient, true)
+
+	// Load initial data from control plane. The agent is stopped if it is shut down meanwhile.
+	if err := eventhub.LoadInitialData(ctx, conf, k8sClient); err != nil {
+		logger.LoggerAgent.Infof("Shutting down before the initial data is loaded from the control plane: %v", err)
+		watcherLogConf.Close()
+		stopManager()
+		wg.Wait()
+		return
+	}
 	health.RestService.SetStatus(true)
 
 	if eventHubEnabled {
+		notifier.StartRevisionAckOutbox()
 		var connectionURLList = conf.ControlPlane.BrokerConnectionParameters.EventListeningEndpoints
 		if strings.Contains(connectionURLList[0], amqpProtocol) {
-			go messaging.ProcessEvents(conf, mgr.GetClient())
+			go messaging.ProcessEvents(conf, k8sClient)
 		}
 	}
 
 	// Load initial KM data from control plane
-	synchronizer.FetchKeyManagersOnStartUp(mgr.GetClient())
+	synchronizer.FetchKeyManagersOnStartUp(k8sClient)
 
 	health.NotificationListenerService.SetStatus(true)
 
@@ -203,18 +236,16 @@ func Run(conf *config.Config) {
 		}),
 		grpc.MaxConcurrentStreams(grpcMaxConcurrentStreams),
 	)
-	publicKeyLocation, privateKeyLocation, truststoreLocation := config.GetKeyLocations()
-	cert, err := config.GetServerCertificate(publicKeyLocation, privateKeyLocation)
-
-	caCertPool := config.GetTrustedCertPool(truststoreLocation)
+	// The certificate provider reloads the keystore and the truststore when they are rotated
+	certificateProvider := tlsutils.GetCertificateProvider()
+	_, err = certificateProvider.GetCertificate(nil)
 
 	if err == nil {
 		grpcOptions = append(grpcOptions, grpc.Creds(
-			credentials.NewTLS(&tls.Config{
-				Certificates: []tls.Certificate{cert},
-				ClientAuth:   tls.RequireAndVerifyClientCert,
-				ClientCAs:    caCertPool,
-			}),
+			credentials.NewTLS(certificateProvider.ServerTLSConfig(&tls.Config{
+				ClientAuth: tls.RequireAndVerifyClientCert,
+				NextProtos: []string{"h2"},
+			})),
 		))
 	} else {
 		logger.LoggerAgent.Warn("failed to initiate the ssl context: ", err)
@@ -238,7 +269,22 @@ func Run(conf *config.Config) {
 	healthservice.RegisterHealthServer(grpcServer, &health.Server{})
 	logger.LoggerAgent.Info("port: ", port, " APK agent Listening for gRPC connections")
 
-	go managementserver.StartInternalServer(restPort)
+	managementserver.SetResyncHandler(func() {
+		if AgentMode == "CPtoDP" {
+			synchronizer.FetchRateLimitPoliciesOnEvent("", "", k8sClient)
+		}
+		synchronizer.FetchSubscriptionRateLimitPoliciesOnEvent("", "", k8sClient, true)
+		synchronizer.FetchAIProvidersOnEvent("", "", "", k8sClient, true)
+		if err := eventhub.LoadInitialData(ctx, conf, k8sClient); err != nil {
+			logger.LoggerAgent.Warnf("Resync is interrupted by the shutdown: %v", err)
+			return
+		}
+		synchronizer.FetchKeyManagersOnStartUp(k8sClient)
+	})
+	if err := managementserver.StartInternalServer(restPort); err != nil {
+		logger.LoggerAgent.Errorf("Failed to start the internal server: %v", err)
+		panic(err)
+	}
 
 	go func() {
 		logger.LoggerAgent.Info("Starting GRPC server.")
@@ -258,13 +304,58 @@ OUTER:
 				config.ClearLogConfigInstance()
 				logger.UpdateLoggers()
 			}
-		case s := <-sig:
-			switch s {
-			case os.Interrupt:
-				logger.LoggerAgent.Info("Shutting down...")
-				break OUTER
-			}
+		case <-ctx.Done():
+			logger.LoggerAgent.Info("Shutting down...")
+			break OUTER
 		}
 	}
+	watcherLogConf.Close()
+	shutdown(conf.Agent.ShutdownTimeout*time.Second, grpcServer, stopManager, &wg)
 	logger.LoggerAgent.Info("Bye!")
 }
+
+// shutdown stops the agent within the given timeout. The events in progress are processed and the pending revision
+// acknowledgements are sent before the servers and the broker connection are closed.
+func shutdown(timeout time.Duration, grpcServer *grpc.Server, stopManager context.CancelFunc, manager *sync.WaitGroup) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	health.NotificationListenerService.SetStatus(false)
+
+	if err := messaging.StopEventProcessing(ctx); err != nil {
+		logger.LoggerAgent.Warnf("Events in progress are not processed within the shutdown timeout, they are requeued: %v", err)
+	}
+	if err := notifier.WaitForPendingAcks(ctx); err != nil {
+		logger.LoggerAgent.Warnf("Revision acknowledgements are not sent within the shutdown timeout: %v", err)
+	}
+
+	managementserver.CloseEventStreams()
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		logger.LoggerAgent.Warn("GRPC server is not stopped within the shutdown timeout, hence it is stopped forcefully")
+		grpcServer.Stop()
+	}
+	health.CommonControllerGrpcService.SetStatus(false)
+	if err := managementserver.StopInternalServer(ctx); err != nil {
+		logger.LoggerAgent.Warnf("Internal server is not stopped within the shutdown timeout: %v", err)
+	}
+
+	messaging.CloseBrokerConnection()
+
+	stopManager()
+	managerStopped := make(chan struct{})
+	go func() {
+		manager.Wait()
+		close(managerStopped)
+	}()
+	select {
+	case <-managerStopped:
+	case <-ctx.Done():
+		logger.LoggerAgent.Warn("Kubernetes controller manager is not stopped within the shutdown timeout")
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/health"
+	msg "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/messaging"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// Names of the checks of the /healthz and /readyz endpoints
+const (
+	controlPlaneCheck  = "control-plane"
+	brokerCheck        = "broker"
+	initialSyncCheck   = "initial-sync"
+	eventListenerCheck = "event-listener"
+	grpcServerCheck    = "grpc-server"
+	crClientCheck      = "cr-client"
+	healthCheckTimeout = 3 * time.Second
+)
+
+// healthChecks returns the checks of the components of the agent by their names
+func healthChecks(conf *config.Config, restConfig *rest.Config) map[string]healthz.Checker {
+	return map[string]healthz.Checker{
+		controlPlaneCheck:  controlPlaneChecker(conf),
+		brokerCheck:        brokerChecker(conf),
+		initialSyncCheck:   health.RestService.Check,
+		eventListenerCheck: health.NotificationListenerService.Check,
+		grpcServerCheck:    health.CommonControllerGrpcService.Check,
+		crClientCheck:      crClientChecker(restConfig),
+	}
+}
+
+// registerHealthProbes adds the configured liveness and readiness checks to the health probe endpoints of the
+// manager. An unknown check name is rejected.
+func registerHealthProbes(mgr ctrl.Manager, conf *config.Config) error {
+	checks := healthChecks(conf, mgr.GetConfig())
+	for _, name := range conf.Agent.HealthProbe.LivenessChecks {
+		check, exists := checks[strings.ToLower(name)]
+		if !exists {
+			return fmt.Errorf("unknown liveness check %s", name)
+		}
+		if err := mgr.AddHealthzCheck(strings.ToLower(name), check); err != nil {
+			return err
+		}
+	}
+	for _, name := range conf.Agent.HealthProbe.ReadinessChecks {
+		check, exists := checks[strings.ToLower(name)]
+		if !exists {
+			return fmt.Errorf("unknown readiness check %s", name)
+		}
+		if err := mgr.AddReadyzCheck(strings.ToLower(name), check); err != nil {
+			return err
+		}
+	}
+	logger.LoggerAgent.Infof("Health probes are served on port %d with the liveness checks %v and the readiness checks %v",
+		conf.Agent.HealthProbe.Port, conf.Agent.HealthProbe.LivenessChecks, conf.Agent.HealthProbe.ReadinessChecks)
+	return nil
+}
+
+// controlPlaneChecker checks whether the control plane responds. Any HTTP response is accepted, since the check is
+// about reaching the control plane rather than the state of it.
+func controlPlaneChecker(conf *config.Config) healthz.Checker {
+	return func(req *http.Request) error {
+		if !conf.ControlPlane.Enabled {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), healthCheckTimeout)
+		defer cancel()
+		cpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, conf.ControlPlane.ServiceURL, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := tlsutils.InvokeControlPlane(cpReq, conf.ControlPlane.SkipSSLVerification)
+		if err != nil {
+			return fmt.Errorf("control plane is not reachable: %v", err)
+		}
+		resp.Body.Close()
+		return nil
+	}
+}
+
+// brokerChecker checks whether the connection to the control plane broker is established
+func brokerChecker(conf *config.Config) healthz.Checker {
+	return func(_ *http.Request) error {
+		if !conf.ControlPlane.Enabled {
+			return nil
+		}
+		if !msg.IsConnected() {
+			return errors.New("control plane broker is not connected")
+		}
+		return nil
+	}
+}
+
+// crClientChecker checks whether the Kubernetes API server, to which the CRs are applied, responds
+func crClientChecker(restConfig *rest.Config) healthz.Checker {
+	return func(_ *http.Request) error {
+		timeoutConfig := rest.CopyConfig(restConfig)
+		timeoutConfig.Timeout = healthCheckTimeout
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(timeoutConfig)
+		if err != nil {
+			return err
+		}
+		if _, err := discoveryClient.ServerVersion(); err != nil {
+			return fmt.Errorf("kubernetes API server is not reachable: %v", err)
+		}
+		return nil
+	}
+}
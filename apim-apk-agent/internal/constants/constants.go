@@ -29,8 +29,6 @@ const (
 	ConsumerKeyClaim           = "azp"
 	ScopesClaim                = "scope"
 	InternalKeyTokenIssuerName = "Internal Key TokenIssuer"
-	InternalKeySecretName      = "apim-apk-issuer-cert"
-	InternalKeySecretKey       = "wso2.crt"
 	InternalKeySuffix          = "-internal-key-issuer"
 )
 
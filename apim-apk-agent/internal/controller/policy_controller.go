@@ -0,0 +1,141 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+// Package controller contains the controllers which sync the resources created on the data plane to the control plane
+package controller
+
+import (
+	"context"
+	"errors"
+
+	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// throttlingPolicyFinalizer keeps a synced CR until its throttling policy is deleted from the control plane
+	throttlingPolicyFinalizer = "apim-apk-agent.wso2.com/throttling-policy"
+	initiateFromLabel         = "InitiateFrom"
+	controlPlaneOrigin        = "CP"
+)
+
+// policyReconciler syncs a kind of rate limit policy CRs to the control plane as throttling policies
+type policyReconciler struct {
+	client             client.Client
+	syncer             *throttlingPolicySyncer
+	kind               string
+	newObject          func() client.Object
+	toThrottlingPolicy func(client.Object) (throttlingPolicy, error)
+}
+
+// Reconcile creates or updates the throttling policy of the CR in the control plane, and deletes it once the CR is
+// deleted. The failed requests are retried by requeuing the CR, and the finalizer is kept until the policy is
+// deleted.
+func (r *policyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := r.newObject()
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	policy, err := r.toThrottlingPolicy(obj)
+	if !obj.GetDeletionTimestamp().IsZero() {
+		if !controllerutil.ContainsFinalizer(obj, throttlingPolicyFinalizer) {
+			return ctrl.Result{}, nil
+		}
+		if err != nil && !errors.Is(err, errUnsupportedPolicy) {
+			logger.LoggerController.Errorf("Unable to resolve the throttling policy of the %s %s: %v", r.kind, req.NamespacedName, err)
+			return ctrl.Result{}, err
+		}
+		// The CR may have been changed to an unsupported limit after it was synced, hence the policies of all the
+		// types are deleted
+		if err := r.syncer.removeAllTypes(policy); err != nil {
+			logger.LoggerController.Errorf("Unable to delete the throttling policy of the %s %s from the control plane: %v", r.kind, req.NamespacedName, err)
+			return ctrl.Result{}, err
+		}
+		logger.LoggerController.Infof("Throttling policy of the %s %s is deleted from the control plane", r.kind, req.NamespacedName)
+		controllerutil.RemoveFinalizer(obj, throttlingPolicyFinalizer)
+		return ctrl.Result{}, r.client.Update(ctx, obj)
+	}
+	if err != nil {
+		logger.LoggerController.Warnf("%s %s is not synced to the control plane: %v", r.kind, req.NamespacedName, err)
+		return ctrl.Result{}, nil
+	}
+	if err := r.syncer.apply(policy); err != nil {
+		if errors.Is(err, errPolicyConflict) {
+			logger.LoggerController.Warnf("%s %s is not synced to the control plane: %v", r.kind, req.NamespacedName, err)
+			return ctrl.Result{}, nil
+		}
+		logger.LoggerController.Errorf("Unable to sync the %s %s to the control plane: %v", r.kind, req.NamespacedName, err)
+		return ctrl.Result{}, err
+	}
+	logger.LoggerController.Infof("%s %s is synced to the control plane as the %s policy %s", r.kind, req.NamespacedName,
+		policy.policyType, policy.PolicyName)
+	if controllerutil.AddFinalizer(obj, throttlingPolicyFinalizer) {
+		return ctrl.Result{}, r.client.Update(ctx, obj)
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupPolicySyncControllers registers the controllers which sync the RateLimitPolicy and AIRateLimitPolicy CRs
+// created on the data plane to the control plane. The CRs created by the agent from the control plane policies are
+// not synced back.
+func SetupPolicySyncControllers(mgr ctrl.Manager, conf *config.Config) error {
+	syncer := &throttlingPolicySyncer{client: restAdminClient{}, onConflict: conf.DataPlane.PolicySync.OnConflict}
+	dataPlaneResources := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if conf.DataPlane.Namespace != "" && obj.GetNamespace() != conf.DataPlane.Namespace {
+			return false
+		}
+		return obj.GetLabels()[initiateFromLabel] != controlPlaneOrigin
+	})
+	rateLimitPolicyReconciler := &policyReconciler{
+		client:    mgr.GetClient(),
+		syncer:    syncer,
+		kind:      "RateLimitPolicy",
+		newObject: func() client.Object { return &dpv1alpha3.RateLimitPolicy{} },
+		toThrottlingPolicy: func(obj client.Object) (throttlingPolicy, error) {
+			return rateLimitPolicyToThrottlingPolicy(obj.(*dpv1alpha3.RateLimitPolicy))
+		},
+	}
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("ratelimitpolicy-sync").
+		For(&dpv1alpha3.RateLimitPolicy{}, builder.WithPredicates(dataPlaneResources, predicate.GenerationChangedPredicate{})).
+		Complete(rateLimitPolicyReconciler); err != nil {
+		return err
+	}
+	aiRateLimitPolicyReconciler := &policyReconciler{
+		client:    mgr.GetClient(),
+		syncer:    syncer,
+		kind:      "AIRateLimitPolicy",
+		newObject: func() client.Object { return &dpv1alpha3.AIRateLimitPolicy{} },
+		toThrottlingPolicy: func(obj client.Object) (throttlingPolicy, error) {
+			return aiRateLimitPolicyToThrottlingPolicy(obj.(*dpv1alpha3.AIRateLimitPolicy))
+		},
+	}
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("airatelimitpolicy-sync").
+		For(&dpv1alpha3.AIRateLimitPolicy{}, builder.WithPredicates(dataPlaneResources, predicate.GenerationChangedPredicate{})).
+		Complete(aiRateLimitPolicyReconciler); err != nil {
+		return err
+	}
+	logger.LoggerController.Info("Rate limit policies of the data plane are synced to the control plane")
+	return nil
+}
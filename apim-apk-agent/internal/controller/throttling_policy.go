@@ -0,0 +1,292 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+)
+
+const (
+	conflictOverwrite = "overwrite"
+	requestCountLimit = "REQUESTCOUNTLIMIT"
+	aiAPIQuotaLimit   = "AIAPIQUOTALIMIT"
+	freeBillingPlan   = "FREE"
+	subscriptionKind  = "Subscription"
+)
+
+var (
+	// errUnsupportedPolicy is returned when a CR can not be represented as a throttling policy of the control plane
+	errUnsupportedPolicy = errors.New("unsupported rate limit policy")
+	// errPolicyConflict is returned when the control plane has a policy of the same name, which is not created by
+	// the agent
+	errPolicyConflict = errors.New("control plane has a throttling policy of the same name")
+)
+
+// throttlingPolicy is the payload of a throttling policy in admin rest API
+type throttlingPolicy struct {
+	PolicyName       string        `json:"policyName"`
+	DisplayName      string        `json:"displayName"`
+	Description      string        `json:"description"`
+	DefaultLimit     throttleLimit `json:"defaultLimit"`
+	StopOnQuotaReach *bool         `json:"stopOnQuotaReach,omitempty"`
+	BillingPlan      string        `json:"billingPlan,omitempty"`
+	// policyType is the type of the policy in admin rest API, "advanced" or "subscription"
+	policyType string
+}
+
+type throttleLimit struct {
+	Type         string             `json:"type"`
+	RequestCount *requestCountQuota `json:"requestCount,omitempty"`
+	AIAPIQuota   *aiAPIQuota        `json:"aiApiQuota,omitempty"`
+}
+
+type requestCountQuota struct {
+	TimeUnit     string `json:"timeUnit"`
+	UnitTime     int    `json:"unitTime"`
+	RequestCount uint32 `json:"requestCount"`
+}
+
+type aiAPIQuota struct {
+	TimeUnit             string  `json:"timeUnit"`
+	UnitTime             int     `json:"unitTime"`
+	RequestCount         *uint32 `json:"requestCount,omitempty"`
+	TotalTokenCount      *uint32 `json:"totalTokenCount,omitempty"`
+	PromptTokenCount     *uint32 `json:"promptTokenCount,omitempty"`
+	CompletionTokenCount *uint32 `json:"completionTokenCount,omitempty"`
+}
+
+// syncedPolicyDescription is the description of the policies created by the agent, by which they are told apart
+// from the policies created in the control plane
+func syncedPolicyDescription(kind string, namespace string, name string) string {
+	return fmt.Sprintf("Synced from the %s %s/%s of the data plane", kind, namespace, name)
+}
+
+// toControlPlaneTimeUnit converts the time unit of a CR to the one of the control plane
+func toControlPlaneTimeUnit(unit string) (string, error) {
+	switch unit {
+	case "Minute":
+		return "min", nil
+	case "Hour":
+		return "hours", nil
+	case "Day":
+		return "days", nil
+	}
+	return "", fmt.Errorf("%w: time unit %s is not supported by the control plane", errUnsupportedPolicy, unit)
+}
+
+// rateLimitPolicyToThrottlingPolicy converts a RateLimitPolicy to a subscription policy if it limits the
+// subscriptions, or to an advanced policy if it limits an API. The override limit takes precedence over the default.
+func rateLimitPolicyToThrottlingPolicy(rateLimitPolicy *dpv1alpha3.RateLimitPolicy) (throttlingPolicy, error) {
+	policy := throttlingPolicy{
+		PolicyName:  rateLimitPolicy.Name,
+		DisplayName: rateLimitPolicy.Name,
+		Description: syncedPolicyDescription("RateLimitPolicy", rateLimitPolicy.Namespace, rateLimitPolicy.Name),
+	}
+	limit := rateLimitPolicy.Spec.Override
+	if limit == nil {
+		limit = rateLimitPolicy.Spec.Default
+	}
+	switch {
+	case limit != nil && limit.Subscription != nil && limit.Subscription.RequestCount != nil:
+		timeUnit, err := toControlPlaneTimeUnit(limit.Subscription.RequestCount.Unit)
+		if err != nil {
+			return policy, err
+		}
+		stopOnQuotaReach := limit.Subscription.StopOnQuotaReach
+		policy.policyType = utils.SubscriptionThrottlingPolicy
+		policy.StopOnQuotaReach = &stopOnQuotaReach
+		policy.BillingPlan = freeBillingPlan
+		policy.DefaultLimit = throttleLimit{Type: requestCountLimit, RequestCount: &requestCountQuota{
+			TimeUnit: timeUnit, UnitTime: 1, RequestCount: limit.Subscription.RequestCount.RequestsPerUnit}}
+	case limit != nil && limit.API != nil:
+		timeUnit, err := toControlPlaneTimeUnit(limit.API.Unit)
+		if err != nil {
+			return policy, err
+		}
+		policy.policyType = utils.AdvancedThrottlingPolicy
+		policy.DefaultLimit = throttleLimit{Type: requestCountLimit, RequestCount: &requestCountQuota{
+			TimeUnit: timeUnit, UnitTime: 1, RequestCount: limit.API.RequestsPerUnit}}
+	default:
+		return policy, fmt.Errorf("%w: neither an API nor a subscription limit is set", errUnsupportedPolicy)
+	}
+	return policy, nil
+}
+
+// aiRateLimitPolicyToThrottlingPolicy converts an AIRateLimitPolicy of the subscriptions to an AI subscription
+// policy. The AI rate limits of an API are part of the API in the control plane, hence they are not supported.
+func aiRateLimitPolicyToThrottlingPolicy(aiRateLimitPolicy *dpv1alpha3.AIRateLimitPolicy) (throttlingPolicy, error) {
+	policy := throttlingPolicy{
+		PolicyName:  aiRateLimitPolicy.Name,
+		DisplayName: aiRateLimitPolicy.Name,
+		Description: syncedPolicyDescription("AIRateLimitPolicy", aiRateLimitPolicy.Namespace, aiRateLimitPolicy.Name),
+		policyType:  utils.SubscriptionThrottlingPolicy,
+		BillingPlan: freeBillingPlan,
+	}
+	if string(aiRateLimitPolicy.Spec.TargetRef.Kind) != subscriptionKind {
+		return policy, fmt.Errorf("%w: only the AI rate limits of the subscriptions are supported", errUnsupportedPolicy)
+	}
+	limit := aiRateLimitPolicy.Spec.Override
+	if limit == nil {
+		limit = aiRateLimitPolicy.Spec.Default
+	}
+	if limit == nil || (limit.TokenCount == nil && limit.RequestCount == nil) {
+		return policy, fmt.Errorf("%w: neither a token count nor a request count is set", errUnsupportedPolicy)
+	}
+	quota := &aiAPIQuota{UnitTime: 1}
+	unit := ""
+	if limit.TokenCount != nil {
+		unit = limit.TokenCount.Unit
+		quota.PromptTokenCount = &limit.TokenCount.RequestTokenCount
+		quota.CompletionTokenCount = &limit.TokenCount.ResponseTokenCount
+		quota.TotalTokenCount = &limit.TokenCount.TotalTokenCount
+	}
+	if limit.RequestCount != nil {
+		if unit != "" && unit != limit.RequestCount.Unit {
+			return policy, fmt.Errorf("%w: token count and request count have different time units", errUnsupportedPolicy)
+		}
+		unit = limit.RequestCount.Unit
+		quota.RequestCount = &limit.RequestCount.RequestsPerUnit
+	}
+	timeUnit, err := toControlPlaneTimeUnit(unit)
+	if err != nil {
+		return policy, err
+	}
+	quota.TimeUnit = timeUnit
+	stopOnQuotaReach := true
+	policy.StopOnQuotaReach = &stopOnQuotaReach
+	policy.DefaultLimit = throttleLimit{Type: aiAPIQuotaLimit, AIAPIQuota: quota}
+	return policy, nil
+}
+
+// adminClient manages the throttling policies of the control plane
+type adminClient interface {
+	GetThrottlingPolicies(policyType string) ([]utils.ThrottlingPolicyInfo, error)
+	CreateThrottlingPolicy(policyType string, policy interface{}) error
+	UpdateThrottlingPolicy(policyType string, policyID string, policy interface{}) error
+	DeleteThrottlingPolicy(policyType string, policyID string) error
+}
+
+// restAdminClient manages the throttling policies through admin rest API of the control plane
+type restAdminClient struct{}
+
+func (restAdminClient) GetThrottlingPolicies(policyType string) ([]utils.ThrottlingPolicyInfo, error) {
+	return utils.GetThrottlingPolicies(policyType)
+}
+
+func (restAdminClient) CreateThrottlingPolicy(policyType string, policy interface{}) error {
+	return utils.CreateThrottlingPolicy(policyType, policy)
+}
+
+func (restAdminClient) UpdateThrottlingPolicy(policyType string, policyID string, policy interface{}) error {
+	return utils.UpdateThrottlingPolicy(policyType, policyID, policy)
+}
+
+func (restAdminClient) DeleteThrottlingPolicy(policyType string, policyID string) error {
+	return utils.DeleteThrottlingPolicy(policyType, policyID)
+}
+
+// throttlingPolicySyncer creates, updates and deletes the throttling policies of the CRs in the control plane. The
+// policies created by the agent are identified by their descriptions.
+type throttlingPolicySyncer struct {
+	client     adminClient
+	onConflict string
+}
+
+// apply creates the policy in the control plane, or updates the existing policy of the same name. A policy which is
+// not created by the agent is updated only if conflicts are configured to be overwritten, and errPolicyConflict is
+// returned otherwise.
+func (s *throttlingPolicySyncer) apply(policy throttlingPolicy) error {
+	existing, err := s.find(policy)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		err = s.client.CreateThrottlingPolicy(policy.policyType, policy)
+	} else {
+		if existing.Description != policy.Description {
+			if !strings.EqualFold(s.onConflict, conflictOverwrite) {
+				return fmt.Errorf("%w: %s", errPolicyConflict, policy.PolicyName)
+			}
+			logger.LoggerController.Warnf("Throttling policy %s of the control plane is overwritten by the data plane policy", policy.PolicyName)
+		}
+		err = s.client.UpdateThrottlingPolicy(policy.policyType, existing.PolicyID, policy)
+	}
+	if err != nil {
+		return err
+	}
+	return s.removeOtherTypes(policy)
+}
+
+// removeOtherTypes deletes the policies of the CR which the agent created with the other policy types. They are left
+// behind when the limit of the CR changes between an API limit and a subscription limit.
+func (s *throttlingPolicySyncer) removeOtherTypes(policy throttlingPolicy) error {
+	return s.removeTypes(policy, policy.policyType)
+}
+
+// removeAllTypes deletes the policies of the CR which the agent created with any policy type
+func (s *throttlingPolicySyncer) removeAllTypes(policy throttlingPolicy) error {
+	return s.removeTypes(policy, "")
+}
+
+// removeTypes deletes the policies of the CR which the agent created with the policy types other than the excluded one
+func (s *throttlingPolicySyncer) removeTypes(policy throttlingPolicy, excludedType string) error {
+	for _, policyType := range []string{utils.AdvancedThrottlingPolicy, utils.SubscriptionThrottlingPolicy} {
+		if policyType == excludedType {
+			continue
+		}
+		otherPolicy := policy
+		otherPolicy.policyType = policyType
+		if err := s.remove(otherPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remove deletes the policy from the control plane, unless it is not created by the agent
+func (s *throttlingPolicySyncer) remove(policy throttlingPolicy) error {
+	existing, err := s.find(policy)
+	if err != nil || existing == nil {
+		return err
+	}
+	if existing.Description != policy.Description {
+		logger.LoggerController.Infof("Throttling policy %s is not deleted from the control plane, since it is not created by the agent", policy.PolicyName)
+		return nil
+	}
+	return s.client.DeleteThrottlingPolicy(policy.policyType, existing.PolicyID)
+}
+
+// find returns the policy of the same type and name in the control plane, or nil if there is none
+func (s *throttlingPolicySyncer) find(policy throttlingPolicy) (*utils.ThrottlingPolicyInfo, error) {
+	policies, err := s.client.GetThrottlingPolicies(policy.policyType)
+	if err != nil {
+		return nil, err
+	}
+	for i := range policies {
+		if policies[i].PolicyName == policy.PolicyName {
+			return &policies[i], nil
+		}
+	}
+	return nil, nil
+}
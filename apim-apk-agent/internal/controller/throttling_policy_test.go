@@ -0,0 +1,165 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+type fakeAdminClient struct {
+	policies map[string][]utils.ThrottlingPolicyInfo
+	created  []throttlingPolicy
+	updated  []string
+	deleted  []string
+}
+
+func (c *fakeAdminClient) GetThrottlingPolicies(policyType string) ([]utils.ThrottlingPolicyInfo, error) {
+	return c.policies[policyType], nil
+}
+
+func (c *fakeAdminClient) CreateThrottlingPolicy(_ string, policy interface{}) error {
+	c.created = append(c.created, policy.(throttlingPolicy))
+	return nil
+}
+
+func (c *fakeAdminClient) UpdateThrottlingPolicy(_ string, policyID string, _ interface{}) error {
+	c.updated = append(c.updated, policyID)
+	return nil
+}
+
+func (c *fakeAdminClient) DeleteThrottlingPolicy(_ string, policyID string) error {
+	c.deleted = append(c.deleted, policyID)
+	return nil
+}
+
+func TestRateLimitPolicyToThrottlingPolicy(t *testing.T) {
+	subscriptionPolicy := &dpv1alpha3.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gold", Namespace: "apk"},
+		Spec: dpv1alpha3.RateLimitPolicySpec{Override: &dpv1alpha3.RateLimitAPIPolicy{
+			Subscription: &dpv1alpha3.SubscriptionRateLimitPolicy{StopOnQuotaReach: true,
+				RequestCount: &dpv1alpha3.RequestCount{RequestsPerUnit: 100, Unit: "Minute"}}}},
+	}
+	policy, err := rateLimitPolicyToThrottlingPolicy(subscriptionPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.SubscriptionThrottlingPolicy, policy.policyType)
+	assert.Equal(t, "gold", policy.PolicyName)
+	assert.True(t, *policy.StopOnQuotaReach)
+	assert.Equal(t, &requestCountQuota{TimeUnit: "min", UnitTime: 1, RequestCount: 100}, policy.DefaultLimit.RequestCount)
+
+	apiPolicy := &dpv1alpha3.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-limit", Namespace: "apk"},
+		Spec: dpv1alpha3.RateLimitPolicySpec{Default: &dpv1alpha3.RateLimitAPIPolicy{
+			API: &dpv1alpha3.APIRateLimitPolicy{RequestsPerUnit: 10, Unit: "Hour"}}},
+	}
+	policy, err = rateLimitPolicyToThrottlingPolicy(apiPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.AdvancedThrottlingPolicy, policy.policyType)
+	assert.Nil(t, policy.StopOnQuotaReach)
+	assert.Equal(t, "hours", policy.DefaultLimit.RequestCount.TimeUnit)
+
+	apiPolicy.Spec.Default.API.Unit = "Second"
+	_, err = rateLimitPolicyToThrottlingPolicy(apiPolicy)
+	assert.ErrorIs(t, err, errUnsupportedPolicy)
+	_, err = rateLimitPolicyToThrottlingPolicy(&dpv1alpha3.RateLimitPolicy{})
+	assert.ErrorIs(t, err, errUnsupportedPolicy)
+}
+
+func TestAIRateLimitPolicyToThrottlingPolicy(t *testing.T) {
+	aiPolicy := &dpv1alpha3.AIRateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ai-gold", Namespace: "apk"},
+		Spec: dpv1alpha3.AIRateLimitPolicySpec{
+			Override: &dpv1alpha3.AIRateLimit{
+				TokenCount:   &dpv1alpha3.TokenCount{Unit: "Day", RequestTokenCount: 100, ResponseTokenCount: 200, TotalTokenCount: 300},
+				RequestCount: &dpv1alpha3.RequestCount{RequestsPerUnit: 10, Unit: "Day"},
+			},
+			TargetRef: gwapiv1b1.NamespacedPolicyTargetReference{Kind: "Subscription", Name: "default"},
+		},
+	}
+	policy, err := aiRateLimitPolicyToThrottlingPolicy(aiPolicy)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.SubscriptionThrottlingPolicy, policy.policyType)
+	assert.Equal(t, aiAPIQuotaLimit, policy.DefaultLimit.Type)
+	assert.Equal(t, "days", policy.DefaultLimit.AIAPIQuota.TimeUnit)
+	assert.Equal(t, uint32(300), *policy.DefaultLimit.AIAPIQuota.TotalTokenCount)
+	assert.Equal(t, uint32(10), *policy.DefaultLimit.AIAPIQuota.RequestCount)
+
+	aiPolicy.Spec.Override.RequestCount.Unit = "Minute"
+	_, err = aiRateLimitPolicyToThrottlingPolicy(aiPolicy)
+	assert.ErrorIs(t, err, errUnsupportedPolicy)
+	aiPolicy.Spec.TargetRef.Kind = "API"
+	_, err = aiRateLimitPolicyToThrottlingPolicy(aiPolicy)
+	assert.ErrorIs(t, err, errUnsupportedPolicy)
+}
+
+func TestThrottlingPolicySyncerHandlesConflicts(t *testing.T) {
+	policy := throttlingPolicy{PolicyName: "gold", policyType: utils.SubscriptionThrottlingPolicy,
+		Description: syncedPolicyDescription("RateLimitPolicy", "apk", "gold")}
+	adminClient := &fakeAdminClient{policies: map[string][]utils.ThrottlingPolicyInfo{}}
+	syncer := &throttlingPolicySyncer{client: adminClient, onConflict: "skip"}
+
+	assert.NoError(t, syncer.apply(policy))
+	assert.Len(t, adminClient.created, 1)
+
+	// A policy created by the agent is updated and deleted
+	adminClient.policies[utils.SubscriptionThrottlingPolicy] = []utils.ThrottlingPolicyInfo{
+		{PolicyID: "1", PolicyName: "gold", Description: policy.Description}}
+	assert.NoError(t, syncer.apply(policy))
+	assert.Equal(t, []string{"1"}, adminClient.updated)
+	assert.NoError(t, syncer.remove(policy))
+	assert.Equal(t, []string{"1"}, adminClient.deleted)
+
+	// A policy created in the control plane is neither updated nor deleted, unless it is overwritten
+	adminClient.policies[utils.SubscriptionThrottlingPolicy] = []utils.ThrottlingPolicyInfo{
+		{PolicyID: "2", PolicyName: "gold", Description: "Allows 5000 requests per minute"}}
+	err := syncer.apply(policy)
+	assert.True(t, errors.Is(err, errPolicyConflict))
+	assert.NoError(t, syncer.remove(policy))
+	assert.Equal(t, []string{"1"}, adminClient.updated)
+	assert.Equal(t, []string{"1"}, adminClient.deleted)
+
+	syncer.onConflict = "overwrite"
+	assert.NoError(t, syncer.apply(policy))
+	assert.Equal(t, []string{"1", "2"}, adminClient.updated)
+}
+
+func TestThrottlingPolicySyncerRemovesPolicyOfPreviousType(t *testing.T) {
+	description := syncedPolicyDescription("RateLimitPolicy", "apk", "gold")
+	adminClient := &fakeAdminClient{policies: map[string][]utils.ThrottlingPolicyInfo{
+		utils.SubscriptionThrottlingPolicy: {{PolicyID: "1", PolicyName: "gold", Description: description}},
+	}}
+	syncer := &throttlingPolicySyncer{client: adminClient, onConflict: "skip"}
+
+	// The CR is changed from a subscription limit to an API limit
+	assert.NoError(t, syncer.apply(throttlingPolicy{PolicyName: "gold", policyType: utils.AdvancedThrottlingPolicy,
+		Description: description}))
+	assert.Len(t, adminClient.created, 1)
+	assert.Equal(t, []string{"1"}, adminClient.deleted)
+
+	// The policies of all the types are deleted with a CR of an unsupported limit
+	adminClient.policies[utils.AdvancedThrottlingPolicy] = []utils.ThrottlingPolicyInfo{
+		{PolicyID: "2", PolicyName: "gold", Description: description}}
+	assert.NoError(t, syncer.removeAllTypes(throttlingPolicy{PolicyName: "gold", Description: description}))
+	assert.Equal(t, []string{"1", "2", "1"}, adminClient.deleted)
+}
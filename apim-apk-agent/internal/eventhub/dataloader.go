@@ -18,18 +18,20 @@
 package eventhub
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strconv"
+	"sync/atomic"
 	"time"
 
-	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
-	internalk8sClient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
 	internalutils "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/utils"
 	pkgAuth "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/auth"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
@@ -50,6 +52,8 @@ const (
 	GatewayLabelParam string = "gatewayLabel"
 	// APIUUIDParam is required to call /apis endpoint
 	APIUUIDParam string = "apiId"
+	// defaultRetryInterval is the interval in seconds between the retries, if it is not configured
+	defaultRetryInterval time.Duration = 5
 )
 
 var (
@@ -75,6 +79,8 @@ var (
 	}
 	accessToken string
 	conf        *config.Config
+
+	apiReconcileInProgress atomic.Bool
 )
 
 type response struct {
@@ -91,11 +97,13 @@ type resource struct {
 	responseType interface{}
 }
 
-// LoadInitialData loads subscription/application and keymapping data from control-plane
-func LoadInitialData(configFile *config.Config, client client.Client) {
+// LoadInitialData loads subscription/application and keymapping data from control-plane. The requests are retried
+// until the control plane responds, or the context is done, in which case the error of the context is returned.
+func LoadInitialData(ctx context.Context, configFile *config.Config, client client.Client) error {
 	conf = configFile
 	accessToken = pkgAuth.GetBasicAuth(configFile.ControlPlane.Username, configFile.ControlPlane.Password)
-	var responseChannel = make(chan response)
+	// A single request is in progress at a time, hence the buffer lets it complete after the context is done
+	var responseChannel = make(chan response, 1)
 	for _, url := range resources {
 		// Create a local copy of the loop variable
 		localURL := url
@@ -103,38 +111,101 @@ func LoadInitialData(configFile *config.Config, client client.Client) {
 		go InvokeService(localURL.endpoint, localURL.responseType, nil, responseChannel, 0)
 
 		for {
-			data := <-responseChannel
+			var data response
+			select {
+			case data = <-responseChannel:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
 			logger.LoggerEventhub.Info("Receiving subscription data for an environment")
 			if data.Payload != nil {
 				logger.LoggerEventhub.Info("Payload data information received" + string(data.Payload))
 				retrieveDataFromResponseChannel(data)
+				snapshot.Save(snapshot.EndpointName(data.Endpoint), data.Payload)
+				break
+			} else if snapshot.IsControlPlaneUnavailable(data.ErrorCode) && loadResourceFromSnapshot(localURL) {
+				// Continue the startup with the snapshot and reconcile once the control plane is reachable
+				go reconcileResource(ctx, localURL)
 				break
 			} else if data.ErrorCode >= 400 && data.ErrorCode < 500 {
-				//Error handle
-				logger.LoggerEventhub.Info("Error data information received")
+				// The snapshot is not loaded since the control plane rejected the request
+				logger.LoggerEventhub.Errorf("Control plane responded to the request of %s with %d: %v", localURL.endpoint,
+					data.ErrorCode, data.Error)
 				//health.SetControlPlaneRestAPIStatus(false)
 			} else {
 				// Keep the iteration going on until a response is received.
 				// Error handle
-				go func(d response, endpoint string, responseType interface{}) {
+				go func(endpoint string, responseType interface{}) {
 					// Retry fetching from control plane after a configured time interval
-					if conf.ControlPlane.RetryInterval == 0 {
-						// Assign default retry interval
-						conf.ControlPlane.RetryInterval = 5
+					logger.LoggerEventhub.Debugf("Time Duration for retrying: %v", retryInterval(conf))
+					select {
+					case <-time.After(retryInterval(conf)):
+					case <-ctx.Done():
+						return
 					}
-					logger.LoggerEventhub.Debugf("Time Duration for retrying: %v", conf.ControlPlane.RetryInterval*time.Second)
-					time.Sleep(conf.ControlPlane.RetryInterval * time.Second)
-					logger.LoggerEventhub.Infof("Retrying to fetch APIs from control plane. Time Duration for the next retry: %v", conf.ControlPlane.RetryInterval*time.Second)
+					logger.LoggerEventhub.Infof("Retrying to fetch APIs from control plane. Time Duration for the next retry: %v", retryInterval(conf))
 					go InvokeService(endpoint, responseType, nil, responseChannel, 0)
-				}(data, localURL.endpoint, localURL.responseType)
+				}(localURL.endpoint, localURL.responseType)
 			}
 		}
 	}
 	AgentMode := conf.Agent.Mode
 	if AgentMode == "CPtoDP" {
-		FetchAPIsOnStartUp(conf, client)
+		FetchAPIsOnStartUp(ctx, conf, client)
 	}
 	go utils.SendInitialEventToAllConnectedClients()
+	return nil
+}
+
+// retryInterval returns the configured interval between the retries of the control plane requests, or the default
+// interval. The configuration is shared by the goroutines, hence the default is not written back to it.
+func retryInterval(conf *config.Config) time.Duration {
+	if conf.ControlPlane.RetryInterval == 0 {
+		return defaultRetryInterval * time.Second
+	}
+	return conf.ControlPlane.RetryInterval * time.Second
+}
+
+// loadResourceFromSnapshot loads the persisted data of the given resource, the first time the control plane
+// cannot be reached. It returns false if there is no snapshot to load.
+func loadResourceFromSnapshot(res resource) bool {
+	payload, found := snapshot.LoadOnce(snapshot.EndpointName(res.endpoint))
+	if !found {
+		return false
+	}
+	logger.LoggerEventhub.Infof("Starting with the %s loaded from the snapshot since the control plane is unavailable",
+		res.endpoint)
+	retrieveDataFromResponseChannel(response{Payload: payload, Endpoint: res.endpoint, Type: res.responseType})
+	return true
+}
+
+// reconcileResource keeps fetching the given resource from the control plane until it responds, or the context
+// is done, and then replaces the data loaded from the snapshot and notifies the connected clients.
+func reconcileResource(ctx context.Context, res resource) {
+	var responseChannel = make(chan response, 1)
+	for {
+		select {
+		case <-time.After(retryInterval(conf)):
+		case <-ctx.Done():
+			return
+		}
+		go InvokeService(res.endpoint, res.responseType, nil, responseChannel, 0)
+		var data response
+		select {
+		case data = <-responseChannel:
+		case <-ctx.Done():
+			return
+		}
+		if data.Payload != nil {
+			logger.LoggerEventhub.Infof("Reconciling the %s loaded from the snapshot with the control plane", res.endpoint)
+			retrieveDataFromResponseChannel(data)
+			snapshot.Save(snapshot.EndpointName(data.Endpoint), data.Payload)
+			utils.SendInitialEventToAllConnectedClients()
+			return
+		}
+		logger.LoggerEventhub.Debugf("Control plane is still unreachable for %s. Retrying in %v", res.endpoint,
+			retryInterval(conf))
+	}
 }
 
 // InvokeService invokes the internal data resource
@@ -230,39 +301,57 @@ func retrieveDataFromResponseChannel(response response) {
 }
 
 // FetchAPIsOnStartUp APIs from control plane during the server start up and push them
-// to the router and enforcer components.
-func FetchAPIsOnStartUp(conf *config.Config, k8sClient client.Client) {
-	k8sAPIS, _, err := internalk8sClient.RetrieveAllAPISFromK8s(k8sClient, "")
-	if err != nil {
-		logger.LoggerEventhub.Errorf("Error occurred while fetching APIs from K8s %v", err)
-	}
+// to the router and enforcer components. The APIs are reconciled in the background until the context is done, if
+// the control plane is unreachable.
+func FetchAPIsOnStartUp(ctx context.Context, conf *config.Config, k8sClient client.Client) {
 	apis, err := internalutils.FetchAPIsOnEvent(conf, nil, k8sClient)
 	if err != nil {
 		logger.LoggerEventhub.Errorf("Error occurred while fetching APIs from control plane %v", err)
-	}
-	removeApis := make([]dpv1alpha3.API, 0)
-	for _, k8sAPI := range k8sAPIS {
-		found := false
-		if apis != nil {
-			for _, api := range *apis {
-				apiUUID, exist := k8sAPI.ObjectMeta.Labels["apiUUID"]
-				if exist {
-					if apiUUID == api {
-						found = true
-						break
-					}
-				}
+		if !errors.Is(err, internalutils.ErrControlPlaneUnreachable) {
+			return
+		}
+		if archive, found := snapshot.LoadOnce(snapshot.APIs); found {
+			if _, err := internalutils.DeployAPIsFromArchive(conf, archive, k8sClient); err != nil {
+				logger.LoggerEventhub.Errorf("Error occurred while deploying the APIs from the snapshot %v", err)
 			}
 		}
-		if !found {
-			logger.LoggerEventhub.Infof("API %s is not found in the control plane. Hence removing it from the K8s", k8sAPI.Name)
-			removeApis = append(removeApis, k8sAPI)
+		// The APIs are not removed until the control plane responds, hence a restart during an outage of the
+		// control plane does not take the deployed APIs down.
+		if apiReconcileInProgress.CompareAndSwap(false, true) {
+			go reconcileAPIs(ctx, conf, k8sClient)
 		}
+		return
 	}
-	for _, removeAPI := range removeApis {
-		if !removeAPI.Spec.SystemAPI {
-			logger.LoggerEventhub.Infof("Undeploying API %s from K8s", removeAPI.Name)
-			internalk8sClient.UndeployK8sAPICR(k8sClient, removeAPI)
+	pruneAPIs(k8sClient, *apis)
+}
+
+// reconcileAPIs keeps fetching the APIs from the control plane until it responds, or the context is done, and then
+// removes the APIs which are no longer in the control plane.
+func reconcileAPIs(ctx context.Context, conf *config.Config, k8sClient client.Client) {
+	defer apiReconcileInProgress.Store(false)
+	for {
+		select {
+		case <-time.After(retryInterval(conf)):
+		case <-ctx.Done():
+			return
+		}
+		apis, err := internalutils.FetchAPIsOnEvent(conf, nil, k8sClient)
+		if err == nil {
+			logger.LoggerEventhub.Info("Reconciled the APIs with the control plane")
+			pruneAPIs(k8sClient, *apis)
+			return
+		}
+		if !errors.Is(err, internalutils.ErrControlPlaneUnreachable) {
+			logger.LoggerEventhub.Errorf("Error occurred while reconciling the APIs with the control plane %v", err)
+			return
 		}
+		logger.LoggerEventhub.Debugf("Control plane is still unreachable for the APIs: %v", err)
+	}
+}
+
+// pruneAPIs removes the APIs which are not in the given list of APIs fetched from the control plane.
+func pruneAPIs(k8sClient client.Client, activeAPIs []string) {
+	if err := sink.GetSink(k8sClient).PruneAPIs(activeAPIs); err != nil {
+		logger.LoggerEventhub.Errorf("Error occurred while removing the APIs which are not in the control plane %v", err)
 	}
 }
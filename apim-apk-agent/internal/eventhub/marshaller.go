@@ -239,6 +239,9 @@ func marshalKeyManagrConfig(configuration map[string]interface{}) eventhubTypes.
 	if configuration["certificate_value"] != nil {
 		marshalledConfiguration.CertificateValue = configuration["certificate_value"].(string)
 	}
+	if configuration["jwks_endpoint"] != nil {
+		marshalledConfiguration.JwksEndpoint = configuration["jwks_endpoint"].(string)
+	}
 	if configuration["consumer_key_claim"] != nil {
 		marshalledConfiguration.ConsumerKeyClaim = configuration["consumer_key_claim"].(string)
 	}
@@ -260,7 +263,14 @@ func marshalGrantTypes(grantTypes []interface{}) []string {
 func marshalClaimMappings(claimMappings []interface{}) []eventhubTypes.Claim {
 	resolvedClaimMappings := make([]eventhubTypes.Claim, 0)
 	for _, claim := range claimMappings {
-		if resolvedClaim, ok := claim.(eventhubTypes.Claim); ok {
+		// The claim mappings are received as JSON objects
+		if claimMap, ok := claim.(map[string]interface{}); ok {
+			remoteClaim, _ := claimMap["remoteClaim"].(string)
+			localClaim, _ := claimMap["localClaim"].(string)
+			if remoteClaim != "" && localClaim != "" {
+				resolvedClaimMappings = append(resolvedClaimMappings, eventhubTypes.Claim{RemoteClaim: remoteClaim, LocalClaim: localClaim})
+			}
+		} else if resolvedClaim, ok := claim.(eventhubTypes.Claim); ok {
 			resolvedClaimMappings = append(resolvedClaimMappings, resolvedClaim)
 		}
 	}
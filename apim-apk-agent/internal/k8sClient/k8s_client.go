@@ -40,7 +40,6 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
-	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
@@ -420,8 +419,9 @@ func DeployAIRateLimitPolicyCR(aiRateLimitPolicies *dpv1alpha3.AIRateLimitPolicy
 	}
 }
 
-// UpdateRateLimitPolicyCR applies the updated policy details to all the RateLimitPolicies struct which has the provided label to the Kubernetes cluster.
-func UpdateRateLimitPolicyCR(policy eventhubTypes.RateLimitPolicy, k8sClient client.Client) {
+// PrepareUpdatedRateLimitPolicyCRs returns the RateLimitPolicies in the Kubernetes cluster which refer to the given
+// policy, with the limits of them updated to the limits of the policy.
+func PrepareUpdatedRateLimitPolicyCRs(policy eventhubTypes.RateLimitPolicy, k8sClient client.Client) ([]dpv1alpha1.RateLimitPolicy, error) {
 	conf, _ := config.ReadConfigs()
 	policyName := getSha1Value(policy.Name)
 	policyOrganization := getSha1Value(policy.TenantDomain)
@@ -437,74 +437,68 @@ func UpdateRateLimitPolicyCR(policy eventhubTypes.RateLimitPolicy, k8sClient cli
 	err := k8sClient.List(context.Background(), rateLimitPolicyList, listOption)
 	if err != nil {
 		loggers.LoggerK8sClient.Errorf("Unable to list RateLimitPolicies CR: %v", err)
+		return nil, err
 	}
 	loggers.LoggerK8sClient.Infof("RateLimitPolicies CR list retrieved: %v", rateLimitPolicyList.Items)
-	for _, rateLimitPolicy := range rateLimitPolicyList.Items {
-		rateLimitPolicy.Spec.Default.API.RequestsPerUnit = uint32(policy.DefaultLimit.RequestCount.RequestCount)
-		rateLimitPolicy.Spec.Default.API.Unit = policy.DefaultLimit.RequestCount.TimeUnit
-		loggers.LoggerK8sClient.Infof("RateLimitPolicy CR updated: %v", rateLimitPolicy)
-		if err := k8sClient.Update(context.Background(), &rateLimitPolicy); err != nil {
-			loggers.LoggerK8sClient.Errorf("Unable to update RateLimitPolicies CR: %v", err)
-		} else {
-			loggers.LoggerK8sClient.Infof("RateLimitPolicies CR updated: %v", rateLimitPolicy.Name)
-		}
+	for i := range rateLimitPolicyList.Items {
+		rateLimitPolicyList.Items[i].Spec.Default.API.RequestsPerUnit = uint32(policy.DefaultLimit.RequestCount.RequestCount)
+		rateLimitPolicyList.Items[i].Spec.Default.API.Unit = policy.DefaultLimit.RequestCount.TimeUnit
 	}
+	return rateLimitPolicyList.Items, nil
 }
 
-// DeploySubscriptionRateLimitPolicyCR applies the given RateLimitPolicies struct to the Kubernetes cluster.
-func DeploySubscriptionRateLimitPolicyCR(policy eventhubTypes.SubscriptionPolicy, k8sClient client.Client) {
+// NewSubscriptionRateLimitPolicyCR returns the RateLimitPolicy CR of the given subscription policy.
+func NewSubscriptionRateLimitPolicyCR(policy eventhubTypes.SubscriptionPolicy) *dpv1alpha3.RateLimitPolicy {
 	conf, _ := config.ReadConfigs()
-	crRateLimitPolicy := dpv1alpha3.RateLimitPolicy{}
-	crName := PrepareSubscritionPolicyCRName(policy.Name, policy.TenantDomain)
 	labelMap := map[string]string{
 		"InitiateFrom": "CP",
 		"CPName":       policy.Name,
 	}
-	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: conf.DataPlane.Namespace, Name: crName}, &crRateLimitPolicy); err != nil {
-		crRateLimitPolicy = dpv1alpha3.RateLimitPolicy{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      crName,
-				Namespace: conf.DataPlane.Namespace,
-				Labels:    labelMap,
-			},
-			Spec: dpv1alpha3.RateLimitPolicySpec{
-				Override: &dpv1alpha3.RateLimitAPIPolicy{
-					Subscription: &dpv1alpha3.SubscriptionRateLimitPolicy{
-						StopOnQuotaReach: policy.StopOnQuotaReach,
-						Organization:     policy.TenantDomain,
-						RequestCount: &dpv1alpha3.RequestCount{
-							RequestsPerUnit: uint32(policy.DefaultLimit.RequestCount.RequestCount),
-							Unit:            policy.DefaultLimit.RequestCount.TimeUnit,
-						},
+	return &dpv1alpha3.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PrepareSubscritionPolicyCRName(policy.Name, policy.TenantDomain),
+			Namespace: conf.DataPlane.Namespace,
+			Labels:    labelMap,
+		},
+		Spec: dpv1alpha3.RateLimitPolicySpec{
+			Override: &dpv1alpha3.RateLimitAPIPolicy{
+				Subscription: &dpv1alpha3.SubscriptionRateLimitPolicy{
+					StopOnQuotaReach: policy.StopOnQuotaReach,
+					Organization:     policy.TenantDomain,
+					RequestCount: &dpv1alpha3.RequestCount{
+						RequestsPerUnit: uint32(policy.DefaultLimit.RequestCount.RequestCount),
+						Unit:            policy.DefaultLimit.RequestCount.TimeUnit,
 					},
 				},
-				TargetRef: gwapiv1b1.NamespacedPolicyTargetReference{Group: constants.GatewayGroup, Kind: "Subscription", Name: "default"},
 			},
-		}
-		if err := k8sClient.Create(context.Background(), &crRateLimitPolicy); err != nil {
-			loggers.LoggerK8sClient.Error("Unable to create RateLimitPolicies CR: " + err.Error())
-		} else {
-			loggers.LoggerK8sClient.Info("RateLimitPolicies CR created: " + crRateLimitPolicy.Name)
-		}
-	} else {
-		crRateLimitPolicy.Spec.Override.Subscription.StopOnQuotaReach = policy.StopOnQuotaReach
-		crRateLimitPolicy.Spec.Override.Subscription.Organization = policy.TenantDomain
-		crRateLimitPolicy.Spec.Override.Subscription.RequestCount.RequestsPerUnit = uint32(policy.DefaultLimit.RequestCount.RequestCount)
-		crRateLimitPolicy.Spec.Override.Subscription.RequestCount.Unit = policy.DefaultLimit.RequestCount.TimeUnit
-		if err := k8sClient.Update(context.Background(), &crRateLimitPolicy); err != nil {
-			loggers.LoggerK8sClient.Error("Unable to update RateLimitPolicies CR: " + err.Error())
-		} else {
-			loggers.LoggerK8sClient.Info("RateLimitPolicies CR updated: " + crRateLimitPolicy.Name)
-		}
+			TargetRef: gwapiv1b1.NamespacedPolicyTargetReference{Group: constants.GatewayGroup, Kind: "Subscription", Name: "default"},
+		},
 	}
+}
 
+// IsDataPlaneRateLimitPolicy returns true if a RateLimitPolicy or an AIRateLimitPolicy of the given name is created on
+// the data plane, rather than by the agent from a control plane policy. Such a policy is synced to the control plane
+// by the policy sync controllers, hence a CR must not be created for it again when it comes back from the control
+// plane.
+func IsDataPlaneRateLimitPolicy(k8sClient client.Client, name string) bool {
+	if k8sClient == nil {
+		return false
+	}
+	conf, _ := config.ReadConfigs()
+	key := client.ObjectKey{Namespace: conf.DataPlane.Namespace, Name: name}
+	for _, obj := range []client.Object{&dpv1alpha3.RateLimitPolicy{}, &dpv1alpha3.AIRateLimitPolicy{}} {
+		if err := k8sClient.Get(context.Background(), key, obj); err == nil && obj.GetLabels()["InitiateFrom"] != "CP" {
+			return true
+		}
+	}
+	return false
 }
 
-// DeployAIRateLimitPolicyFromCPPolicy applies the given AIRateLimitPolicies struct to the Kubernetes cluster.
-func DeployAIRateLimitPolicyFromCPPolicy(policy eventhubTypes.SubscriptionPolicy, k8sClient client.Client) {
+// NewAIRateLimitPolicyCRFromCPPolicy returns the AIRateLimitPolicy CR of the given AI subscription policy.
+func NewAIRateLimitPolicyCRFromCPPolicy(policy eventhubTypes.SubscriptionPolicy) *dpv1alpha3.AIRateLimitPolicy {
 	conf, _ := config.ReadConfigs()
-	tokenCount := &dpv1alpha3.TokenCount{}
-	requestCount := &dpv1alpha3.RequestCount{}
+	var tokenCount *dpv1alpha3.TokenCount
+	var requestCount *dpv1alpha3.RequestCount
 	if policy.DefaultLimit.AiAPIQuota.PromptTokenCount != nil &&
 		policy.DefaultLimit.AiAPIQuota.CompletionTokenCount != nil &&
 		policy.DefaultLimit.AiAPIQuota.TotalTokenCount != nil {
@@ -514,23 +508,18 @@ func DeployAIRateLimitPolicyFromCPPolicy(policy eventhubTypes.SubscriptionPolicy
 			ResponseTokenCount: uint32(*policy.DefaultLimit.AiAPIQuota.CompletionTokenCount),
 			TotalTokenCount:    uint32(*policy.DefaultLimit.AiAPIQuota.TotalTokenCount),
 		}
-	} else {
-		tokenCount = nil
 	}
 	if policy.DefaultLimit.AiAPIQuota.RequestCount != nil {
 		requestCount = &dpv1alpha3.RequestCount{
 			RequestsPerUnit: uint32(*policy.DefaultLimit.AiAPIQuota.RequestCount),
 			Unit:            policy.DefaultLimit.AiAPIQuota.TimeUnit,
 		}
-	} else {
-		requestCount = nil
 	}
 	labelMap := map[string]string{
 		"InitiateFrom": "CP",
 		"CPName":       policy.Name,
 	}
-
-	crRateLimitPolicies := dpv1alpha3.AIRateLimitPolicy{
+	return &dpv1alpha3.AIRateLimitPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      PrepareSubscritionPolicyCRName(policy.Name, policy.TenantDomain),
 			Namespace: conf.DataPlane.Namespace,
@@ -545,53 +534,6 @@ func DeployAIRateLimitPolicyFromCPPolicy(policy eventhubTypes.SubscriptionPolicy
 			TargetRef: gwapiv1b1.NamespacedPolicyTargetReference{Group: constants.GatewayGroup, Kind: "Subscription", Name: "default"},
 		},
 	}
-	crRateLimitPolicyFetched := &dpv1alpha3.AIRateLimitPolicy{}
-	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: crRateLimitPolicies.ObjectMeta.Namespace, Name: crRateLimitPolicies.Name}, crRateLimitPolicyFetched); err != nil {
-		if !k8error.IsNotFound(err) {
-			loggers.LoggerK8sClient.Error("Unable to get AiratelimitPolicy CR: " + err.Error())
-		}
-		if err := k8sClient.Create(context.Background(), &crRateLimitPolicies); err != nil {
-			loggers.LoggerK8sClient.Error("Unable to create AIRateLimitPolicies CR: " + err.Error())
-		} else {
-			loggers.LoggerK8sClient.Info("AIRateLimitPolicies CR created: " + crRateLimitPolicies.Name)
-		}
-	} else {
-		crRateLimitPolicyFetched.Spec = crRateLimitPolicies.Spec
-		crRateLimitPolicyFetched.ObjectMeta.Labels = crRateLimitPolicies.ObjectMeta.Labels
-		if err := k8sClient.Update(context.Background(), crRateLimitPolicyFetched); err != nil {
-			loggers.LoggerK8sClient.Error("Unable to update AiRatelimitPolicy CR: " + err.Error())
-		} else {
-			loggers.LoggerK8sClient.Info("AiRatelimitPolicy CR updated: " + crRateLimitPolicyFetched.Name)
-		}
-	}
-}
-
-// UnDeploySubscriptionRateLimitPolicyCR applies the given RateLimitPolicies struct to the Kubernetes cluster.
-func UnDeploySubscriptionRateLimitPolicyCR(crName string, k8sClient client.Client) {
-	conf, _ := config.ReadConfigs()
-	crRateLimitPolicies := &dpv1alpha1.RateLimitPolicy{}
-	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: conf.DataPlane.Namespace, Name: crName}, crRateLimitPolicies); err != nil {
-		loggers.LoggerK8sClient.Error("Unable to get RateLimitPolicies CR: " + err.Error())
-	}
-	err := k8sClient.Delete(context.Background(), crRateLimitPolicies, &client.DeleteOptions{})
-	if err != nil {
-		loggers.LoggerK8sClient.Error("Unable to delete RateLimitPolicies CR: " + err.Error())
-	}
-	loggers.LoggerK8sClient.Debug("RateLimitPolicies CR deleted: " + crRateLimitPolicies.Name)
-}
-
-// UndeploySubscriptionAIRateLimitPolicyCR applies the given AIRateLimitPolicies struct to the Kubernetes cluster.
-func UndeploySubscriptionAIRateLimitPolicyCR(crName string, k8sClient client.Client) {
-	conf, _ := config.ReadConfigs()
-	crAIRateLimitPolicies := &dpv1alpha3.AIRateLimitPolicy{}
-	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: conf.DataPlane.Namespace, Name: crName}, crAIRateLimitPolicies); err != nil {
-		loggers.LoggerK8sClient.Error("Unable to get AIRateLimitPolicies CR: " + err.Error())
-	}
-	err := k8sClient.Delete(context.Background(), crAIRateLimitPolicies, &client.DeleteOptions{})
-	if err != nil {
-		loggers.LoggerK8sClient.Error("Unable to delete AIRateLimitPolicies CR: " + err.Error())
-	}
-	loggers.LoggerK8sClient.Debug("AIRateLimitPolicies CR deleted: " + crAIRateLimitPolicies.Name)
 }
 
 // DeployBackendCR applies the given Backends struct to the Kubernetes cluster.
@@ -616,9 +558,15 @@ func DeployBackendCR(backends *dpv1alpha2.Backend, k8sClient client.Client) {
 	}
 }
 
-// CreateAndUpdateTokenIssuersCR applies the given TokenIssuers struct to the Kubernetes cluster.
-func CreateAndUpdateTokenIssuersCR(keyManager eventhubTypes.ResolvedKeyManager, k8sClient client.Client) error {
+// NewTokenIssuerCRs returns the TokenIssuer CR of the given key manager, followed by the internal key TokenIssuer CR
+// of its organization if it is enabled. ErrInvalidKeyManager is returned if the JWKS URL or the certificate of the key
+// manager can not be resolved.
+func NewTokenIssuerCRs(keyManager eventhubTypes.ResolvedKeyManager) ([]*dpv1alpha2.TokenIssuer, error) {
 	conf, _ := config.ReadConfigs()
+	tokenIssuerSpec, err := buildTokenIssuerSpec(keyManager, conf)
+	if err != nil {
+		return nil, err
+	}
 	sha1ValueofKmName := getSha1Value(keyManager.Name)
 	sha1ValueOfOrganization := getSha1Value(keyManager.Organization)
 	labelMap := map[string]string{"name": sha1ValueofKmName,
@@ -626,49 +574,17 @@ func CreateAndUpdateTokenIssuersCR(keyManager eventhubTypes.ResolvedKeyManager,
 		"InitiateFrom": "CP",
 	}
 
-	tokenIssuer := dpv1alpha2.TokenIssuer{
+	tokenIssuers := []*dpv1alpha2.TokenIssuer{{
 		ObjectMeta: metav1.ObjectMeta{Name: keyManager.UUID,
 			Namespace: conf.DataPlane.Namespace,
 			Labels:    labelMap,
 		},
-		Spec: dpv1alpha2.TokenIssuerSpec{
-			Name:                keyManager.Name,
-			Organization:        keyManager.Organization,
-			Issuer:              keyManager.KeyManagerConfig.Issuer,
-			ClaimMappings:       marshalClaimMappings(keyManager.KeyManagerConfig.ClaimMappings),
-			SignatureValidation: marshalSignatureValidation(keyManager.KeyManagerConfig),
-			TargetRef:           &v1alpha2.NamespacedPolicyTargetReference{Group: constants.GatewayGroup, Kind: constants.GatewayKind, Name: constants.GatewayName},
-		},
-	}
-	tokenIssuer.Spec.ConsumerKeyClaim = constants.ConsumerKeyClaim
-	if keyManager.KeyManagerConfig.ConsumerKeyClaim != "" {
-		tokenIssuer.Spec.ConsumerKeyClaim = keyManager.KeyManagerConfig.ConsumerKeyClaim
-	}
-	keyManager.KeyManagerConfig.ScopesClaim = constants.ScopesClaim
-	if keyManager.KeyManagerConfig.ScopesClaim != "" {
-		tokenIssuer.Spec.ScopesClaim = keyManager.KeyManagerConfig.ScopesClaim
+		Spec: tokenIssuerSpec,
+	}}
+	if !conf.DataPlane.TokenIssuer.InternalKeyIssuerEnabled {
+		return tokenIssuers, nil
 	}
-	crTokenIssuer := &dpv1alpha2.TokenIssuer{}
-	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: tokenIssuer.ObjectMeta.Namespace, Name: tokenIssuer.Name}, crTokenIssuer); err != nil {
-		if !k8error.IsNotFound(err) {
-			loggers.LoggerK8sClient.Error("Unable to get TokenIssuer CR: " + err.Error())
-		}
-		err := k8sClient.Create(context.Background(), &tokenIssuer)
-		if err != nil {
-			loggers.LoggerK8sClient.Error("Unable to create TokenIssuer CR: " + err.Error())
-			return err
-		}
-		loggers.LoggerK8sClient.Infof("TokenIssuer CR created: " + tokenIssuer.Name)
-	} else {
-		crTokenIssuer.Spec = tokenIssuer.Spec
-		if err := k8sClient.Update(context.Background(), crTokenIssuer); err != nil {
-			loggers.LoggerK8sClient.Error("Unable to update TokenIssuer CR: " + err.Error())
-		} else {
-			loggers.LoggerK8sClient.Info("TokenIssuer CR updated: " + tokenIssuer.Name)
-		}
-	}
-
-	internalKeyTokenIssuer := dpv1alpha2.TokenIssuer{
+	internalKeyTokenIssuer := &dpv1alpha2.TokenIssuer{
 		ObjectMeta: metav1.ObjectMeta{Name: keyManager.Organization + constants.InternalKeySuffix,
 			Namespace: conf.DataPlane.Namespace,
 			Labels:    labelMap,
@@ -677,58 +593,32 @@ func CreateAndUpdateTokenIssuersCR(keyManager eventhubTypes.ResolvedKeyManager,
 			Name:          constants.InternalKeyTokenIssuerName,
 			Organization:  keyManager.Organization,
 			Issuer:        conf.ControlPlane.InternalKeyIssuer,
-			ClaimMappings: marshalClaimMappings(keyManager.KeyManagerConfig.ClaimMappings),
+			ClaimMappings: tokenIssuerSpec.ClaimMappings,
 			SignatureValidation: &dpv1alpha2.SignatureValidation{
 				Certificate: &dpv1alpha2.CERTConfig{
 					SecretRef: &dpv1alpha2.RefConfig{
-						Name: constants.InternalKeySecretName,
-						Key:  constants.InternalKeySecretKey,
+						Name: conf.DataPlane.TokenIssuer.InternalKeySecretName,
+						Key:  conf.DataPlane.TokenIssuer.InternalKeySecretKey,
 					},
 				},
 			},
-			TargetRef: &v1alpha2.NamespacedPolicyTargetReference{Group: constants.GatewayGroup, Kind: constants.GatewayKind, Name: constants.GatewayName},
+			TargetRef: tokenIssuerSpec.TargetRef,
 		},
 	}
 	internalKeyTokenIssuer.Spec.ConsumerKeyClaim = constants.ConsumerKeyClaim
 	internalKeyTokenIssuer.Spec.ScopesClaim = constants.ScopesClaim
-	crInternalTokenIssuer := &dpv1alpha2.TokenIssuer{}
-	if err := k8sClient.Get(context.Background(), client.ObjectKey{Namespace: internalKeyTokenIssuer.ObjectMeta.Namespace, Name: internalKeyTokenIssuer.Name}, crInternalTokenIssuer); err != nil {
-		if !k8error.IsNotFound(err) {
-			loggers.LoggerK8sClient.Error("Unable to get Internal TokenIssuer CR: " + err.Error())
-		}
-		err = k8sClient.Create(context.Background(), &internalKeyTokenIssuer)
-		if err != nil {
-			loggers.LoggerK8sClient.Error("Unable to create Internal TokenIssuer CR: " + err.Error())
-			return err
-		}
-		loggers.LoggerK8sClient.Infof("Internal TokenIssuer CR created: " + internalKeyTokenIssuer.Name)
-	} else {
-		crInternalTokenIssuer.Spec = internalKeyTokenIssuer.Spec
-		if err := k8sClient.Update(context.Background(), crInternalTokenIssuer); err != nil {
-			loggers.LoggerK8sClient.Error("Unable to update Internal TokenIssuer CR: " + err.Error())
-		} else {
-			loggers.LoggerK8sClient.Info("TokenIssuer CR updated: " + internalKeyTokenIssuer.Name)
-		}
-	}
-	return nil
+	return append(tokenIssuers, internalKeyTokenIssuer), nil
 }
 
-// DeleteTokenIssuerCR deletes the TokenIssuer struct from the Kubernetes cluster.
-func DeleteTokenIssuerCR(k8sClient client.Client, tokenIssuer dpv1alpha2.TokenIssuer) error {
-	// Skip the deletion if the token issuer is for internal keys
-	if !strings.Contains(tokenIssuer.Name, constants.InternalKeySuffix) {
-		err := k8sClient.Delete(context.Background(), &tokenIssuer, &client.DeleteOptions{})
-		if err != nil {
-			loggers.LoggerK8sClient.Error("Unable to delete TokenIssuer CR: " + err.Error())
-			return err
-		}
-		loggers.LoggerK8sClient.Debug("TokenIssuer CR deleted: " + tokenIssuer.Name)
-	}
-	return nil
+// IsInternalKeyTokenIssuer returns true if the TokenIssuer of the given name is the internal key TokenIssuer of an
+// organization, which is shared by the key managers of the organization and hence never deleted.
+func IsInternalKeyTokenIssuer(name string) bool {
+	return strings.Contains(name, constants.InternalKeySuffix)
 }
 
-// DeleteTokenIssuersCR deletes the TokenIssuers struct from the Kubernetes cluster.
-func DeleteTokenIssuersCR(k8sClient client.Client, keymanagerName string, tenantDomain string) error {
+// RetrieveTokenIssuersOfKeyManager retrieves the TokenIssuer CRs of the given key manager from the Kubernetes
+// cluster, excluding the internal key TokenIssuer of the organization.
+func RetrieveTokenIssuersOfKeyManager(k8sClient client.Client, keymanagerName string, tenantDomain string) ([]dpv1alpha2.TokenIssuer, error) {
 	conf, _ := config.ReadConfigs()
 	sha1ValueofKmName := getSha1Value(keymanagerName)
 	sha1ValueOfOrganization := getSha1Value(tenantDomain)
@@ -740,66 +630,17 @@ func DeleteTokenIssuersCR(k8sClient client.Client, keymanagerName string, tenant
 	}
 
 	tokenIssuerList := &dpv1alpha2.TokenIssuerList{}
-	err := k8sClient.List(context.Background(), tokenIssuerList, listOption)
-	if err != nil {
+	if err := k8sClient.List(context.Background(), tokenIssuerList, listOption); err != nil {
 		loggers.LoggerK8sClient.Error("Unable to list TokenIssuer CR: " + err.Error())
+		return nil, err
 	}
-	if len(tokenIssuerList.Items) == 0 {
-		loggers.LoggerK8sClient.Debug("No TokenIssuer CR found for deletion")
-	}
+	tokenIssuers := make([]dpv1alpha2.TokenIssuer, 0, len(tokenIssuerList.Items))
 	for _, tokenIssuer := range tokenIssuerList.Items {
-		err := DeleteTokenIssuerCR(k8sClient, tokenIssuer)
-		if err != nil {
-			loggers.LoggerK8sClient.Error("Unable to delete TokenIssuer CR: " + err.Error())
-			return err
+		if !IsInternalKeyTokenIssuer(tokenIssuer.Name) {
+			tokenIssuers = append(tokenIssuers, tokenIssuer)
 		}
-		loggers.LoggerK8sClient.Debug("TokenIssuer CR deleted: " + tokenIssuer.Name)
 	}
-	return nil
-}
-
-// UpdateTokenIssuersCR applies the given TokenIssuers struct to the Kubernetes cluster.
-func UpdateTokenIssuersCR(keyManager eventhubTypes.ResolvedKeyManager, k8sClient client.Client) error {
-	conf, _ := config.ReadConfigs()
-	sha1ValueofKmName := getSha1Value(keyManager.Name)
-	sha1ValueOfOrganization := getSha1Value(keyManager.Organization)
-	labelMap := map[string]string{"name": sha1ValueofKmName, "organization": sha1ValueOfOrganization}
-	tokenIssuer := &dpv1alpha2.TokenIssuer{}
-	err := k8sClient.Get(context.Background(), client.ObjectKey{Name: keyManager.UUID, Namespace: conf.DataPlane.Namespace}, tokenIssuer)
-	if err != nil {
-		loggers.LoggerK8sClient.Error("Unable to get TokenIssuer CR: " + err.Error())
-		return err
-	}
-	tokenIssuer.ObjectMeta.Labels = labelMap
-	tokenIssuer.Spec.Name = keyManager.Name
-	tokenIssuer.Spec.Organization = keyManager.Organization
-	tokenIssuer.Spec.Issuer = keyManager.KeyManagerConfig.Issuer
-	tokenIssuer.Spec.ClaimMappings = marshalClaimMappings(keyManager.KeyManagerConfig.ClaimMappings)
-	tokenIssuer.Spec.SignatureValidation = marshalSignatureValidation(keyManager.KeyManagerConfig)
-	tokenIssuer.Spec.TargetRef = &v1alpha2.NamespacedPolicyTargetReference{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "default"}
-	if keyManager.KeyManagerConfig.ConsumerKeyClaim != "" {
-		tokenIssuer.Spec.ConsumerKeyClaim = keyManager.KeyManagerConfig.ConsumerKeyClaim
-	}
-	if keyManager.KeyManagerConfig.ScopesClaim != "" {
-		tokenIssuer.Spec.ScopesClaim = keyManager.KeyManagerConfig.ScopesClaim
-	}
-	err = k8sClient.Update(context.Background(), tokenIssuer)
-	if err != nil {
-		loggers.LoggerK8sClient.Error("Unable to update TokenIssuer CR: " + err.Error())
-		return err
-	}
-	loggers.LoggerK8sClient.Debug("TokenIssuer CR updated: " + tokenIssuer.Name)
-	return nil
-}
-
-func marshalSignatureValidation(keyManagerConfig eventhubTypes.KeyManagerConfig) *dpv1alpha2.SignatureValidation {
-	if keyManagerConfig.CertificateType != "" && keyManagerConfig.CertificateValue != "" {
-		if keyManagerConfig.CertificateType == "JWKS" {
-			return &dpv1alpha2.SignatureValidation{JWKS: &dpv1alpha2.JWKS{URL: keyManagerConfig.CertificateValue}}
-		}
-		return &dpv1alpha2.SignatureValidation{Certificate: &dpv1alpha2.CERTConfig{CertificateInline: &keyManagerConfig.CertificateValue}}
-	}
-	return nil
+	return tokenIssuers, nil
 }
 
 func marshalClaimMappings(claimMappings []eventhubTypes.Claim) *[]dpv1alpha2.ClaimMapping {
@@ -0,0 +1,212 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package k8sclient
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	dpv1alpha2 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha2"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/constants"
+	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	signatureValidationJWKS        = "JWKS"
+	signatureValidationCertificate = "Certificate"
+	jwksResolveTimeout             = 10 * time.Second
+)
+
+// ErrInvalidKeyManager is returned when a TokenIssuer can not be created for a key manager, since the JWKS URL or
+// the certificate of it can not be resolved.
+var ErrInvalidKeyManager = errors.New("invalid key manager")
+
+// newJWKSHTTPClient returns the client used to resolve the JWKS URLs of the key managers. It trusts the agent
+// truststore, and skips the verification of the certificates if controlPlane.skipSSLVerification is set.
+func newJWKSHTTPClient(conf *config.Config) *http.Client {
+	if conf == nil {
+		return &http.Client{Timeout: jwksResolveTimeout}
+	}
+	_, _, truststoreLocation := tlsutils.GetKeyLocations()
+	return &http.Client{
+		Timeout: jwksResolveTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: tlsutils.GetClientTLSConfig(truststoreLocation, conf.ControlPlane.SkipSSLVerification),
+		},
+	}
+}
+
+// tokenIssuerMapping holds the properties of the TokenIssuer of a key manager, resolved from the
+// dataPlane.tokenIssuer configurations.
+type tokenIssuerMapping struct {
+	gateway             string
+	consumerKeyClaim    string
+	scopesClaim         string
+	claimMappings       []eventhubTypes.Claim
+	signatureValidation string
+}
+
+// resolveTokenIssuerMapping applies the mappings which match the type and the organization of the key manager, in
+// the configured order, on top of the properties of the key manager.
+func resolveTokenIssuerMapping(keyManager eventhubTypes.ResolvedKeyManager, conf *config.Config) tokenIssuerMapping {
+	mapping := tokenIssuerMapping{
+		gateway:          constants.GatewayName,
+		consumerKeyClaim: constants.ConsumerKeyClaim,
+		scopesClaim:      constants.ScopesClaim,
+		claimMappings:    append([]eventhubTypes.Claim{}, keyManager.KeyManagerConfig.ClaimMappings...),
+	}
+	if keyManager.KeyManagerConfig.ConsumerKeyClaim != "" {
+		mapping.consumerKeyClaim = keyManager.KeyManagerConfig.ConsumerKeyClaim
+	}
+	if keyManager.KeyManagerConfig.ScopesClaim != "" {
+		mapping.scopesClaim = keyManager.KeyManagerConfig.ScopesClaim
+	}
+	if conf == nil {
+		return mapping
+	}
+	if conf.DataPlane.TokenIssuer.Gateway != "" {
+		mapping.gateway = conf.DataPlane.TokenIssuer.Gateway
+	}
+	for _, configured := range conf.DataPlane.TokenIssuer.Mappings {
+		if (configured.KeyManagerType != "" && !strings.EqualFold(configured.KeyManagerType, keyManager.Type)) ||
+			(configured.Organization != "" && !strings.EqualFold(configured.Organization, keyManager.Organization)) {
+			continue
+		}
+		if configured.Gateway != "" {
+			mapping.gateway = configured.Gateway
+		}
+		if configured.ConsumerKeyClaim != "" {
+			mapping.consumerKeyClaim = configured.ConsumerKeyClaim
+		}
+		if configured.ScopesClaim != "" {
+			mapping.scopesClaim = configured.ScopesClaim
+		}
+		if configured.SignatureValidation != "" {
+			mapping.signatureValidation = configured.SignatureValidation
+		}
+		for _, claim := range configured.ClaimMappings {
+			mapping.claimMappings = append(mapping.claimMappings,
+				eventhubTypes.Claim{RemoteClaim: claim.RemoteClaim, LocalClaim: claim.LocalClaim})
+		}
+	}
+	return mapping
+}
+
+// buildTokenIssuerSpec returns the spec of the TokenIssuer of the key manager. ErrInvalidKeyManager is returned if
+// the signature of the tokens can not be validated with the JWKS URL or the certificate of the key manager.
+func buildTokenIssuerSpec(keyManager eventhubTypes.ResolvedKeyManager, conf *config.Config) (dpv1alpha2.TokenIssuerSpec, error) {
+	mapping := resolveTokenIssuerMapping(keyManager, conf)
+	signatureValidation, err := marshalSignatureValidation(keyManager.KeyManagerConfig, mapping.signatureValidation,
+		newJWKSHTTPClient(conf))
+	if err != nil {
+		return dpv1alpha2.TokenIssuerSpec{}, fmt.Errorf("%w %s: %v", ErrInvalidKeyManager, keyManager.Name, err)
+	}
+	return dpv1alpha2.TokenIssuerSpec{
+		Name:                keyManager.Name,
+		Organization:        keyManager.Organization,
+		Issuer:              keyManager.KeyManagerConfig.Issuer,
+		ConsumerKeyClaim:    mapping.consumerKeyClaim,
+		ScopesClaim:         mapping.scopesClaim,
+		ClaimMappings:       marshalClaimMappings(mapping.claimMappings),
+		SignatureValidation: signatureValidation,
+		TargetRef:           &v1alpha2.NamespacedPolicyTargetReference{Group: constants.GatewayGroup, Kind: constants.GatewayKind, Name: v1alpha2.ObjectName(mapping.gateway)},
+	}, nil
+}
+
+// marshalSignatureValidation returns the signature validation of the key manager. The JWKS URL is either the
+// certificate value of a JWKS certificate type or the jwks_endpoint of the key manager. The preferred one of the
+// JWKS URL and the certificate is used if the key manager provides both. The JWKS URL is resolved with the given
+// client.
+func marshalSignatureValidation(keyManagerConfig eventhubTypes.KeyManagerConfig, preferred string,
+	jwksClient *http.Client) (*dpv1alpha2.SignatureValidation, error) {
+	jwksURL := keyManagerConfig.JwksEndpoint
+	certificate := ""
+	if keyManagerConfig.CertificateValue != "" {
+		if strings.EqualFold(keyManagerConfig.CertificateType, signatureValidationJWKS) {
+			jwksURL = keyManagerConfig.CertificateValue
+		} else {
+			certificate = keyManagerConfig.CertificateValue
+		}
+	}
+	useJWKS := jwksURL != "" && (certificate == "" || !strings.EqualFold(preferred, signatureValidationCertificate))
+	if useJWKS {
+		if err := resolveJWKS(jwksURL, jwksClient); err != nil {
+			return nil, err
+		}
+		return &dpv1alpha2.SignatureValidation{JWKS: &dpv1alpha2.JWKS{URL: jwksURL}}, nil
+	}
+	if certificate != "" {
+		pemCertificate, err := resolveCertificate(certificate)
+		if err != nil {
+			return nil, err
+		}
+		return &dpv1alpha2.SignatureValidation{Certificate: &dpv1alpha2.CERTConfig{CertificateInline: &pemCertificate}}, nil
+	}
+	return nil, errors.New("neither a JWKS URL nor a certificate is provided")
+}
+
+// resolveJWKS fetches the JWKS URL and verifies that it returns at least one key.
+func resolveJWKS(jwksURL string, jwksClient *http.Client) error {
+	parsedURL, err := url.Parse(jwksURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return fmt.Errorf("JWKS URL %s is not a valid HTTP URL", jwksURL)
+	}
+	resp, err := jwksClient.Get(jwksURL)
+	if err != nil {
+		return fmt.Errorf("unable to fetch the JWKS from %s: %v", jwksURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to fetch the JWKS from %s: status %d", jwksURL, resp.StatusCode)
+	}
+	var jwks struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil || len(jwks.Keys) == 0 {
+		return fmt.Errorf("JWKS URL %s does not return any keys", jwksURL)
+	}
+	return nil
+}
+
+// resolveCertificate returns the PEM encoded certificate of the given certificate value, which is either PEM
+// encoded or base64 encoded PEM, as the control plane accepts both.
+func resolveCertificate(certificate string) (string, error) {
+	content := []byte(strings.TrimSpace(certificate))
+	if decoded, err := base64.StdEncoding.DecodeString(string(content)); err == nil {
+		content = decoded
+	}
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", errors.New("certificate is not PEM encoded")
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return "", fmt.Errorf("unable to parse the certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(block)), nil
+}
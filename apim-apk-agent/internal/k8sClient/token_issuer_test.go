@@ -0,0 +1,127 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package k8sclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+)
+
+func testCertificate(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "km"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestResolveTokenIssuerMapping(t *testing.T) {
+	conf := &config.Config{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"DataPlane":{"TokenIssuer":{"Gateway":"default","Mappings":[
+		{"KeyManagerType":"Okta","Gateway":"okta-gateway","ClaimMappings":[{"RemoteClaim":"groups","LocalClaim":"roles"}]},
+		{"KeyManagerType":"okta","Organization":"org1","ScopesClaim":"scp"},
+		{"Organization":"org2","Gateway":"org2-gateway"}]}}}`), conf))
+
+	keyManager := eventhubTypes.ResolvedKeyManager{Name: "okta", Type: "Okta", Organization: "org1",
+		KeyManagerConfig: eventhubTypes.KeyManagerConfig{ScopesClaim: "scopes", ConsumerKeyClaim: "cid",
+			ClaimMappings: []eventhubTypes.Claim{{RemoteClaim: "sub", LocalClaim: "user"}}}}
+
+	// The claims of the key manager are not overridden by the defaults
+	mapping := resolveTokenIssuerMapping(keyManager, nil)
+	assert.Equal(t, "scopes", mapping.scopesClaim)
+	assert.Equal(t, "cid", mapping.consumerKeyClaim)
+
+	mapping = resolveTokenIssuerMapping(keyManager, conf)
+	assert.Equal(t, "okta-gateway", mapping.gateway)
+	assert.Equal(t, "scp", mapping.scopesClaim)
+	assert.Equal(t, "cid", mapping.consumerKeyClaim)
+	assert.Equal(t, []eventhubTypes.Claim{{RemoteClaim: "sub", LocalClaim: "user"}, {RemoteClaim: "groups", LocalClaim: "roles"}},
+		mapping.claimMappings)
+
+	keyManager.Type = "WSO2-IS"
+	mapping = resolveTokenIssuerMapping(keyManager, conf)
+	assert.Equal(t, "default", mapping.gateway)
+	assert.Equal(t, "scopes", mapping.scopesClaim)
+}
+
+func TestMarshalSignatureValidation(t *testing.T) {
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/jwks" {
+			w.Write([]byte(`{"keys":[{"kty":"EC"}]}`))
+			return
+		}
+		w.Write([]byte(`{"keys":[]}`))
+	}))
+	defer jwksServer.Close()
+	certificate := testCertificate(t)
+
+	signatureValidation, err := marshalSignatureValidation(eventhubTypes.KeyManagerConfig{CertificateType: "JWKS",
+		CertificateValue: jwksServer.URL + "/jwks"}, "", jwksServer.Client())
+	assert.NoError(t, err)
+	assert.Equal(t, jwksServer.URL+"/jwks", signatureValidation.JWKS.URL)
+
+	_, err = marshalSignatureValidation(eventhubTypes.KeyManagerConfig{CertificateType: "JWKS",
+		CertificateValue: jwksServer.URL + "/empty"}, "", jwksServer.Client())
+	assert.Error(t, err)
+
+	// The certificate is accepted either PEM encoded or base64 encoded
+	for _, value := range []string{certificate, base64.StdEncoding.EncodeToString([]byte(certificate))} {
+		signatureValidation, err = marshalSignatureValidation(eventhubTypes.KeyManagerConfig{CertificateType: "PEM",
+			CertificateValue: value}, "", jwksServer.Client())
+		assert.NoError(t, err)
+		assert.Equal(t, certificate, *signatureValidation.Certificate.CertificateInline)
+	}
+
+	_, err = marshalSignatureValidation(eventhubTypes.KeyManagerConfig{CertificateType: "PEM", CertificateValue: "invalid"}, "", jwksServer.Client())
+	assert.Error(t, err)
+
+	// The preferred one is used when both are available
+	bothConfig := eventhubTypes.KeyManagerConfig{CertificateType: "PEM", CertificateValue: certificate,
+		JwksEndpoint: jwksServer.URL + "/jwks"}
+	signatureValidation, err = marshalSignatureValidation(bothConfig, "", jwksServer.Client())
+	assert.NoError(t, err)
+	assert.NotNil(t, signatureValidation.JWKS)
+	signatureValidation, err = marshalSignatureValidation(bothConfig, "Certificate", jwksServer.Client())
+	assert.NoError(t, err)
+	assert.NotNil(t, signatureValidation.Certificate)
+
+	_, err = buildTokenIssuerSpec(eventhubTypes.ResolvedKeyManager{Name: "km"}, nil)
+	assert.True(t, errors.Is(err, ErrInvalidKeyManager))
+}
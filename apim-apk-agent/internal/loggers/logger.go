@@ -40,6 +40,9 @@ const (
 	pkgSynchronizer = "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/synchronizer"
 	pkgUtils        = "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/utils"
 	pkgEventhub     = "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/eventhub"
+	pkgSink         = "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
+	pkgSnapshot     = "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
+	pkgController   = "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/controller"
 )
 
 // logger package references
@@ -52,6 +55,9 @@ var (
 	LoggerUtils        logging.Log
 	LoggerAgent        logging.Log
 	LoggerEventhub     logging.Log
+	LoggerSink         logging.Log
+	LoggerSnapshot     logging.Log
+	LoggerController   logging.Log
 )
 
 func init() {
@@ -69,5 +75,8 @@ func UpdateLoggers() {
 	LoggerUtils = logging.InitPackageLogger(pkgUtils)
 	LoggerAgent = logging.InitPackageLogger(pkgAgent)
 	LoggerEventhub = logging.InitPackageLogger(pkgEventhub)
+	LoggerSink = logging.InitPackageLogger(pkgSink)
+	LoggerSnapshot = logging.InitPackageLogger(pkgSnapshot)
+	LoggerController = logging.InitPackageLogger(pkgController)
 	logrus.Info("Updated loggers")
 }
@@ -0,0 +1,289 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
+	msg "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/messaging"
+)
+
+const (
+	// highWaterMarkRetention is how long the high-water mark of a resource is kept after its latest event. The
+	// redelivered events are not older than this, hence the marks of the deleted resources are pruned after it.
+	highWaterMarkRetention = 7 * 24 * time.Hour
+	// highWaterMarkPersistDelay is the delay after which the changed high-water marks are persisted, so that a burst
+	// of events is written once
+	highWaterMarkPersistDelay = time.Second
+)
+
+var (
+	onceEventSequencerInit sync.Once
+	sequencer              *eventSequencer
+)
+
+// highWaterMark is the latest processed event of a type of a resource. EventIDs holds the IDs of all the processed
+// events with the TimeStamp, since several events of a resource can be published within the same millisecond.
+type highWaterMark struct {
+	TimeStamp int64    `json:"timeStamp"`
+	EventIDs  []string `json:"eventIds"`
+}
+
+// eventSequencer processes the notification events of a resource one at a time, in the order they are received.
+// The events of different resources are processed concurrently. An event which is older than, or the same as, an
+// already processed event of the same type of the resource is dropped. The high-water marks are persisted, so that
+// the events redelivered after a restart do not reapply an old state.
+type eventSequencer struct {
+	mutex          sync.Mutex
+	queues         map[string][]func()
+	highWaterMarks map[string]*highWaterMark
+	persist        func([]byte)
+	// persistMutex serialises the writes of the high-water marks, so that an older state is never written last
+	persistMutex sync.Mutex
+	// dirty is set when the high-water marks are changed after they were last persisted, and persistScheduled is
+	// set while a write is pending
+	dirty            bool
+	persistScheduled bool
+	now              func() time.Time
+	// closed is set once the agent is shutting down, after which the submitted events are left unacknowledged
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+// getEventSequencer returns the sequencer of the control plane notifications. The high-water marks are persisted
+// with the state of the agent.
+func getEventSequencer() *eventSequencer {
+	onceEventSequencerInit.Do(func() {
+		sequencer = newEventSequencer(snapshot.LoadState(snapshot.EventHighWaterMarks), func(content []byte) {
+			snapshot.SaveState(snapshot.EventHighWaterMarks, content)
+		})
+	})
+	return sequencer
+}
+
+func newEventSequencer(persisted []byte, persist func([]byte)) *eventSequencer {
+	s := &eventSequencer{
+		queues:         make(map[string][]func()),
+		highWaterMarks: make(map[string]*highWaterMark),
+		persist:        persist,
+		now:            time.Now,
+	}
+	if len(persisted) > 0 {
+		if err := json.Unmarshal(persisted, &s.highWaterMarks); err != nil {
+			logger.LoggerMessaging.Errorf("Error occurred while loading the high-water marks of the events %v", err)
+			s.highWaterMarks = make(map[string]*highWaterMark)
+		}
+	}
+	return s
+}
+
+// submit queues the processing of an event of the given type of the resource with the given key. The event is
+// acknowledged once it is processed or dropped. The events without a key are neither ordered nor deduplicated.
+func (s *eventSequencer) submit(key string, eventType string, event msg.Event, process func(), ack func()) {
+	// The events of a resource are ordered together, but deduplicated per type, since the control plane publishes
+	// the events of different types of a change (e.g. an update and a deployment) with unrelated timestamps.
+	markKey := key + ":" + eventType
+	task := func() {
+		defer s.inFlight.Done()
+		defer ack()
+		if key != "" && s.isProcessed(markKey, event) {
+			logger.LoggerMessaging.Infof("%s event %s of %s is dropped since a later or the same event is already processed",
+				eventType, event.EventID, key)
+			return
+		}
+		process()
+		if key != "" {
+			s.markProcessed(markKey, event)
+		}
+	}
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		logger.LoggerMessaging.Infof("Event %s of %s is not processed since the agent is shutting down", event.EventID, key)
+		return
+	}
+	s.inFlight.Add(1)
+	pending, running := s.queues[key]
+	s.queues[key] = append(pending, task)
+	s.mutex.Unlock()
+	if !running {
+		go s.run(key)
+	}
+}
+
+// close stops accepting events and waits until the queued events are processed, or the context is done. The
+// high-water marks of the processed events are persisted before returning.
+func (s *eventSequencer) close(ctx context.Context) error {
+	s.mutex.Lock()
+	s.closed = true
+	s.mutex.Unlock()
+	drained := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(drained)
+	}()
+	defer s.flush()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run processes the queued events of a resource until the queue is drained.
+func (s *eventSequencer) run(key string) {
+	for {
+		s.mutex.Lock()
+		pending := s.queues[key]
+		if len(pending) == 0 {
+			delete(s.queues, key)
+			s.mutex.Unlock()
+			return
+		}
+		task := pending[0]
+		s.queues[key] = pending[1:]
+		s.mutex.Unlock()
+		task()
+	}
+}
+
+// isProcessed returns true if the event is older than the high-water mark with the given key, or has already been
+// processed. The events without a timestamp are always processed.
+func (s *eventSequencer) isProcessed(key string, event msg.Event) bool {
+	if event.TimeStamp <= 0 {
+		return false
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	mark, exists := s.highWaterMarks[key]
+	if !exists {
+		return false
+	}
+	if event.TimeStamp < mark.TimeStamp {
+		return true
+	}
+	if event.TimeStamp == mark.TimeStamp {
+		for _, eventID := range mark.EventIDs {
+			if eventID != "" && eventID == event.EventID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// markProcessed moves the high-water mark with the given key to the given event and schedules persisting the
+// high-water marks.
+func (s *eventSequencer) markProcessed(key string, event msg.Event) {
+	if event.TimeStamp <= 0 {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	mark, exists := s.highWaterMarks[key]
+	if !exists || event.TimeStamp > mark.TimeStamp {
+		s.highWaterMarks[key] = &highWaterMark{TimeStamp: event.TimeStamp, EventIDs: []string{event.EventID}}
+	} else if event.TimeStamp == mark.TimeStamp {
+		mark.EventIDs = append(mark.EventIDs, event.EventID)
+	}
+	s.dirty = true
+	if !s.persistScheduled {
+		s.persistScheduled = true
+		time.AfterFunc(highWaterMarkPersistDelay, s.flush)
+	}
+}
+
+// flush prunes the high-water marks which are older than highWaterMarkRetention and persists the rest, if they
+// are changed after they were last persisted.
+func (s *eventSequencer) flush() {
+	s.persistMutex.Lock()
+	defer s.persistMutex.Unlock()
+	s.mutex.Lock()
+	s.persistScheduled = false
+	if !s.dirty {
+		s.mutex.Unlock()
+		return
+	}
+	s.dirty = false
+	oldest := s.now().Add(-highWaterMarkRetention).UnixMilli()
+	for key, mark := range s.highWaterMarks {
+		if mark.TimeStamp < oldest {
+			delete(s.highWaterMarks, key)
+		}
+	}
+	content, err := json.Marshal(s.highWaterMarks)
+	s.mutex.Unlock()
+	if err != nil {
+		logger.LoggerMessaging.Errorf("Error occurred while persisting the high-water marks of the events %v", err)
+		return
+	}
+	s.persist(content)
+}
+
+// getEventResource returns the key of the resource which the notification event is about, along with the common
+// attributes of the event. An empty key is returned for the events which are not ordered.
+func getEventResource(eventType string, data []byte) (string, msg.Event) {
+	var err error
+	if strings.Contains(eventType, apiLifeCycleChange) || strings.Contains(eventType, apiEventType) {
+		var apiEvent msg.APIEvent
+		if err = json.Unmarshal(data, &apiEvent); err == nil && apiEvent.UUID != "" {
+			return apiEventType + ":" + apiEvent.UUID, apiEvent.Event
+		}
+	} else if strings.Contains(eventType, applicationEventType) {
+		if strings.EqualFold(applicationRegistration, eventType) || strings.EqualFold(removeApplicationKeyMapping, eventType) {
+			var registrationEvent msg.ApplicationRegistrationEvent
+			if err = json.Unmarshal(data, &registrationEvent); err == nil && registrationEvent.ApplicationUUID != "" {
+				return strings.Join([]string{applicationRegistration, registrationEvent.ApplicationUUID,
+					registrationEvent.KeyType, registrationEvent.KeyManager}, ":"), registrationEvent.Event
+			}
+		} else {
+			var applicationEvent msg.ApplicationEvent
+			if err = json.Unmarshal(data, &applicationEvent); err == nil && applicationEvent.UUID != "" {
+				return applicationEventType + ":" + applicationEvent.UUID, applicationEvent.Event
+			}
+		}
+	} else if strings.Contains(eventType, subscriptionEventType) {
+		var subscriptionEvent msg.SubscriptionEvent
+		if err = json.Unmarshal(data, &subscriptionEvent); err == nil && subscriptionEvent.SubscriptionUUID != "" {
+			return subscriptionEventType + ":" + subscriptionEvent.SubscriptionUUID, subscriptionEvent.Event
+		}
+	} else if strings.Contains(eventType, policyEventType) {
+		var policyEvent msg.PolicyInfo
+		if err = json.Unmarshal(data, &policyEvent); err == nil && policyEvent.PolicyName != "" {
+			return strings.Join([]string{policyEventType, policyEvent.PolicyType, policyEvent.PolicyName,
+				policyEvent.TenantDomain}, ":"), policyEvent.Event
+		}
+	} else if strings.Contains(eventType, aiProviderEventType) {
+		var aiProviderEvent msg.AIProviderEvent
+		if err = json.Unmarshal(data, &aiProviderEvent); err == nil && aiProviderEvent.Name != "" {
+			return strings.Join([]string{aiProviderEventType, aiProviderEvent.Name, aiProviderEvent.APIVersion,
+				aiProviderEvent.TenantDomain}, ":"), aiProviderEvent.Event
+		}
+	}
+	if err != nil {
+		logger.LoggerMessaging.Debugf("Unable to resolve the resource of the %s event %v", eventType, err)
+	}
+	return "", msg.Event{}
+}
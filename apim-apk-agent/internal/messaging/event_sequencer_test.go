@@ -0,0 +1,172 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package messaging
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	msg "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/messaging"
+)
+
+// submitAndWait submits the API_UPDATE event and waits until it is acknowledged. It returns true if the event was
+// processed.
+func submitAndWait(t *testing.T, s *eventSequencer, key string, event msg.Event) bool {
+	return submitTypeAndWait(t, s, key, "API_UPDATE", event)
+}
+
+// submitTypeAndWait submits the event of the given type and waits until it is acknowledged. It returns true if the
+// event was processed.
+func submitTypeAndWait(t *testing.T, s *eventSequencer, key string, eventType string, event msg.Event) bool {
+	processed := false
+	done := make(chan struct{})
+	s.submit(key, eventType, event, func() { processed = true }, func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("event %s is not acknowledged", event.EventID)
+	}
+	return processed
+}
+
+func TestEventSequencerDropsStaleAndDuplicateEvents(t *testing.T) {
+	var persisted []byte
+	s := newEventSequencer(nil, func(content []byte) { persisted = content })
+	s.now = func() time.Time { return time.UnixMilli(30) }
+
+	assert.True(t, submitAndWait(t, s, "API:1", msg.Event{EventID: "e2", TimeStamp: 20}))
+	// An older event and a redelivered event are dropped
+	assert.False(t, submitAndWait(t, s, "API:1", msg.Event{EventID: "e1", TimeStamp: 10}))
+	assert.False(t, submitAndWait(t, s, "API:1", msg.Event{EventID: "e2", TimeStamp: 20}))
+	// Another event published within the same millisecond is processed
+	assert.True(t, submitAndWait(t, s, "API:1", msg.Event{EventID: "e3", TimeStamp: 20}))
+	// The events of the other resources and the events without a key are not affected
+	assert.True(t, submitAndWait(t, s, "API:2", msg.Event{EventID: "e1", TimeStamp: 10}))
+	assert.True(t, submitAndWait(t, s, "", msg.Event{EventID: "e1", TimeStamp: 10}))
+	assert.True(t, submitAndWait(t, s, "", msg.Event{EventID: "e1", TimeStamp: 10}))
+
+	// The high-water marks survive a restart
+	s.flush()
+	restarted := newEventSequencer(persisted, func([]byte) {})
+	assert.False(t, submitAndWait(t, restarted, "API:1", msg.Event{EventID: "e3", TimeStamp: 20}))
+	assert.False(t, submitAndWait(t, restarted, "API:2", msg.Event{EventID: "e0", TimeStamp: 5}))
+	assert.True(t, submitAndWait(t, restarted, "API:1", msg.Event{EventID: "e4", TimeStamp: 30}))
+}
+
+func TestEventSequencerDeduplicatesEventsPerType(t *testing.T) {
+	s := newEventSequencer(nil, func([]byte) {})
+	s.now = func() time.Time { return time.UnixMilli(30) }
+
+	assert.True(t, submitTypeAndWait(t, s, "API:1", "API_UPDATE", msg.Event{EventID: "e2", TimeStamp: 20}))
+	// An older event of another type of the resource is processed
+	assert.True(t, submitTypeAndWait(t, s, "API:1", "DEPLOY_API_IN_GATEWAY", msg.Event{EventID: "e1", TimeStamp: 10}))
+	assert.False(t, submitTypeAndWait(t, s, "API:1", "DEPLOY_API_IN_GATEWAY", msg.Event{EventID: "e0", TimeStamp: 5}))
+	assert.False(t, submitTypeAndWait(t, s, "API:1", "API_UPDATE", msg.Event{EventID: "e1", TimeStamp: 10}))
+}
+
+func TestEventSequencerPrunesOldHighWaterMarks(t *testing.T) {
+	persisted := 0
+	s := newEventSequencer(nil, func([]byte) { persisted++ })
+	now := time.UnixMilli(0).Add(highWaterMarkRetention)
+	s.now = func() time.Time { return now }
+
+	assert.True(t, submitAndWait(t, s, "API:1", msg.Event{EventID: "e1", TimeStamp: 10}))
+	assert.True(t, submitAndWait(t, s, "API:2", msg.Event{EventID: "e1", TimeStamp: 10}))
+	s.flush()
+	// The changes are persisted once and an unchanged state is not persisted again
+	s.flush()
+	assert.Equal(t, 1, persisted)
+	assert.Len(t, s.highWaterMarks, 2)
+
+	now = now.Add(15 * time.Millisecond)
+	assert.True(t, submitAndWait(t, s, "API:2", msg.Event{EventID: "e2", TimeStamp: 20}))
+	s.flush()
+	assert.Equal(t, 2, persisted)
+	assert.Contains(t, s.highWaterMarks, "API:2:API_UPDATE")
+	assert.NotContains(t, s.highWaterMarks, "API:1:API_UPDATE")
+}
+
+func TestEventSequencerSerialisesEventsOfResource(t *testing.T) {
+	s := newEventSequencer(nil, func([]byte) {})
+	var (
+		mutex   sync.Mutex
+		order   []int
+		running int
+		overlap bool
+		wg      sync.WaitGroup
+	)
+	for i := 1; i <= 20; i++ {
+		i := i
+		wg.Add(1)
+		s.submit("APPLICATION:1", "APPLICATION_UPDATE", msg.Event{TimeStamp: int64(i)}, func() {
+			mutex.Lock()
+			running++
+			overlap = overlap || running > 1
+			mutex.Unlock()
+			time.Sleep(time.Millisecond)
+			mutex.Lock()
+			running--
+			order = append(order, i)
+			mutex.Unlock()
+		}, wg.Done)
+	}
+	wg.Wait()
+	assert.False(t, overlap)
+	assert.Len(t, order, 20)
+	for i, processed := range order {
+		assert.Equal(t, i+1, processed)
+	}
+}
+
+func TestEventSequencerClose(t *testing.T) {
+	s := newEventSequencer(nil, func([]byte) {})
+	release := make(chan struct{})
+	acked := make(chan struct{})
+	s.submit("API:1", "API_UPDATE", msg.Event{EventID: "e1", TimeStamp: 10}, func() { <-release }, func() { close(acked) })
+
+	// The in-flight event is not processed before the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.close(ctx), context.DeadlineExceeded)
+
+	// The events submitted after closing are neither processed nor acknowledged
+	s.submit("API:2", "API_UPDATE", msg.Event{EventID: "e2", TimeStamp: 10}, func() { t.Error("event e2 is processed") },
+		func() { t.Error("event e2 is acknowledged") })
+
+	close(release)
+	assert.NoError(t, s.close(context.Background()))
+	<-acked
+}
+
+func TestGetEventResource(t *testing.T) {
+	key, event := getEventResource("DEPLOY_API_IN_GATEWAY",
+		[]byte(`{"uuid":"api-1","eventId":"e1","timeStamp":10,"type":"DEPLOY_API_IN_GATEWAY"}`))
+	assert.Equal(t, "API:api-1", key)
+	assert.Equal(t, "e1", event.EventID)
+	assert.Equal(t, int64(10), event.TimeStamp)
+
+	key, _ = getEventResource("POLICY_CREATE",
+		[]byte(`{"policyName":"Gold","policyType":"SUBSCRIPTION","tenantDomain":"carbon.super"}`))
+	assert.Equal(t, "POLICY:SUBSCRIPTION:Gold:carbon.super", key)
+
+	key, _ = getEventResource("HEALTH_CHECK", []byte(`{}`))
+	assert.Equal(t, "", key)
+}
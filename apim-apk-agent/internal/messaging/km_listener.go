@@ -27,8 +27,10 @@ import (
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/eventhub"
 	k8sclient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/synchronizer"
 	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/logging"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
 	msg "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/messaging"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -76,9 +78,18 @@ func handleKMConfiguration(c client.Client) {
 			return
 		}
 
+		if !belongsToTenant(notification.Event.PayloadData.TenantDomain) {
+			logger.LoggerMessaging.Debugf("Key Manager event for the Key Manager %s is dropped due to having non related tenantDomain : %s",
+				notification.Event.PayloadData.Name, notification.Event.PayloadData.TenantDomain)
+			d.Ack(false)
+			continue
+		}
 		if strings.EqualFold(keyManagerConfigEvent, notification.Event.PayloadData.EventType) {
 			if strings.EqualFold(actionDelete, notification.Event.PayloadData.Action) {
-				k8sclient.DeleteTokenIssuersCR(c, notification.Event.PayloadData.Name, notification.Event.PayloadData.TenantDomain)
+				if err := synchronizer.DeleteTokenIssuers(notification.Event.PayloadData.Name, notification.Event.PayloadData.TenantDomain, c); err != nil {
+					logger.LoggerMessaging.Errorf("Error while deleting the TokenIssuers of the Key Manager %s: %v", notification.Event.PayloadData.Name, err)
+				}
+				managementserver.DeleteKeyManager(notification.Event.PayloadData.Name, notification.Event.PayloadData.TenantDomain)
 			} else if decodedByte != nil {
 				logger.LoggerMessaging.Infof("decoded stream %s", string(decodedByte))
 				kmConfigMapErr := json.Unmarshal([]byte(string(decodedByte)), &keyManager)
@@ -95,13 +106,15 @@ func handleKMConfiguration(c client.Client) {
 					logger.LoggerMessaging.Infof("Key Managers received: %v", keyManager)
 					resolvedKeyManager := eventhub.MarshalKeyManager(&keyManager)
 					logger.LoggerMessaging.Infof("Resolved Key Managers received: %v", resolvedKeyManager)
-					if strings.EqualFold(actionAdd, notification.Event.PayloadData.Action) {
-						k8sclient.CreateAndUpdateTokenIssuersCR(resolvedKeyManager, c)
-					} else {
-						err = k8sclient.UpdateTokenIssuersCR(resolvedKeyManager, c)
-						if err != nil {
-							k8sclient.CreateAndUpdateTokenIssuersCR(resolvedKeyManager, c)
-						}
+					tokenIssuers, err := k8sclient.NewTokenIssuerCRs(resolvedKeyManager)
+					if err != nil {
+						logger.LoggerMessaging.Errorf("Key Manager %s is rejected: %v", resolvedKeyManager.Name, err)
+						d.Ack(false)
+						continue
+					}
+					managementserver.AddKeyManager(resolvedKeyManager)
+					if err := synchronizer.ApplyTokenIssuers(tokenIssuers, c); err != nil {
+						logger.LoggerMessaging.Errorf("Error while applying the TokenIssuers of the Key Manager %s: %v", resolvedKeyManager.Name, err)
 					}
 				}
 			}
@@ -19,6 +19,8 @@
 package messaging
 
 import (
+	"context"
+
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
 	msg "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/messaging"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,3 +33,16 @@ func ProcessEvents(config *config.Config, c client.Client) {
 	go handleNotification(c)
 	go handleKMConfiguration(c)
 }
+
+// StopEventProcessing stops accepting the events from the broker and waits until the events in progress are
+// processed, or the context is done. The events which are not processed are requeued by the broker once the
+// connection is closed.
+func StopEventProcessing(ctx context.Context) error {
+	msg.StopConsuming()
+	return getEventSequencer().close(ctx)
+}
+
+// CloseBrokerConnection closes the connection to the broker
+func CloseBrokerConnection() {
+	msg.CloseConnection()
+}
@@ -24,20 +24,22 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/google/uuid"
-	"github.com/wso2/apk/common-go-libs/constants"
+	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
+	dpv1alpha4 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha4"
 	event "github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/subscription"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
-	internalk8sClient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	k8sclient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/synchronizer"
 	internalutils "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/utils"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/audit"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/logging"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
 	msg "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/messaging"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -73,11 +75,6 @@ const (
 // var variables
 var (
 	ScopeList = make([]types.Scope, 0)
-	// timestamps needs to be maintained as it is not guranteed to receive them in order,
-	// hence older events should be discarded
-	apiListTimeStampMap          = make(map[string]int64, 0)
-	subsriptionsListTimeStampMap = make(map[string]int64, 0)
-	applicationListTimeStampMap  = make(map[string]int64, 0)
 )
 
 // handleNotification to process
@@ -91,17 +88,17 @@ func handleNotification(c client.Client) {
 		}
 		logger.LoggerMessaging.Infof("Event %s is received", notification.Event.PayloadData.EventType)
 		logger.LoggerMessaging.Infof("Event %s is received with payload %s", notification.Event.PayloadData.EventType, notification.Event.PayloadData.Event)
-		err := processNotificationEvent(conf, &notification, c)
+		err := processNotificationEvent(conf, &notification, c, func() { d.Ack(false) })
 		if err != nil {
 			continue
 		}
-		d.Ack(false)
 	}
 	logger.LoggerMessaging.Infof("handle: deliveries channel closed")
 }
 
-func processNotificationEvent(conf *config.Config, notification *msg.EventNotification, c client.Client) error {
-	var eventType string
+// processNotificationEvent queues the notification event to be processed after the earlier events of the same
+// resource, since it is not guaranteed to receive them in order. The event is acknowledged once it is processed.
+func processNotificationEvent(conf *config.Config, notification *msg.EventNotification, c client.Client, ack func()) error {
 	var decodedByte, err = base64.StdEncoding.DecodeString(notification.Event.PayloadData.Event)
 	if err != nil {
 		if _, ok := err.(base64.CorruptInputError); ok {
@@ -111,8 +108,24 @@ func processNotificationEvent(conf *config.Config, notification *msg.EventNotifi
 			"Hence dropping the event", err)
 		return err
 	}
+	eventType := notification.Event.PayloadData.EventType
+	resourceKey, event := getEventResource(eventType, decodedByte)
+	apiUUID := ""
+	if strings.HasPrefix(resourceKey, apiEventType+":") {
+		apiUUID = strings.TrimPrefix(resourceKey, apiEventType+":")
+	}
+	audit.NotificationReceived(event.EventID, eventType, apiUUID)
+	// The CRs applied while processing the event are recorded with the event
+	scopedClient := audit.WithScope(c, audit.Scope{EventID: event.EventID, EventType: eventType, APIUUID: apiUUID})
+	getEventSequencer().submit(resourceKey, eventType, event, func() {
+		dispatchNotificationEvent(conf, eventType, decodedByte, scopedClient)
+	}, ack)
+	return nil
+}
+
+// dispatchNotificationEvent hands over the decoded notification event to the handler of its type.
+func dispatchNotificationEvent(conf *config.Config, eventType string, decodedByte []byte, c client.Client) {
 	AgentMode := conf.Agent.Mode
-	eventType = notification.Event.PayloadData.EventType
 	if strings.Contains(eventType, apiLifeCycleChange) {
 		if AgentMode == "CPtoDP" {
 			handleLifeCycleEvents(decodedByte)
@@ -138,7 +151,6 @@ func processNotificationEvent(conf *config.Config, notification *msg.EventNotifi
 		handleAIProviderEvents(decodedByte, eventType, c)
 	}
 	// other events will ignore including HEALTH_CHECK event
-	return nil
 }
 
 // handleDefaultVersionUpdate will redeploy default versioned API.
@@ -165,11 +177,7 @@ func handleDefaultVersionUpdate(event msg.APIEvent) {
 
 // handleAPIEvents to process api related data
 func handleAPIEvents(data []byte, eventType string, conf *config.Config, c client.Client) {
-	var (
-		apiEvent         msg.APIEvent
-		currentTimeStamp int64 = apiEvent.Event.TimeStamp
-	)
-
+	var apiEvent msg.APIEvent
 	apiEventErr := json.Unmarshal([]byte(string(data)), &apiEvent)
 	if apiEventErr != nil {
 		logger.LoggerMessaging.ErrorC(logging.ErrorDetails{
@@ -199,19 +207,21 @@ func handleAPIEvents(data []byte, eventType string, conf *config.Config, c clien
 
 	logger.LoggerMessaging.Infof("API event data %v", apiEventObj)
 
-	//Per each revision, synchronization should happen.
+	//Per each revision, synchronization should happen. The API is fetched before the next event of the API is
+	// processed, hence an undeployment can not be overridden by the fetch of an earlier revision.
 	if strings.EqualFold(deployAPIToGateway, apiEvent.Event.Type) {
-		go internalutils.FetchAPIsOnEvent(conf, &apiEvent.UUID, c)
+		if _, err := internalutils.FetchAPIsOnEvent(conf, &apiEvent.UUID, c); err != nil {
+			logger.LoggerMessaging.Errorf("Error while fetching the API %s of the deploy event: %v", apiEvent.UUID, err)
+		}
 	}
 
-	for _, env := range apiEvent.GatewayLabels {
-		if isLaterEvent(apiListTimeStampMap, apiEvent.UUID+":"+env, currentTimeStamp) {
-			break
-		}
+	for range apiEvent.GatewayLabels {
 		// removeFromGateway event with multiple labels could only appear when the API is subjected
 		// to delete. Hence we could simply delete after checking against just one iteration.
 		if strings.EqualFold(removeAPIFromGateway, apiEvent.Event.Type) {
-			internalk8sClient.UndeployAPICR(apiEvent.UUID, c)
+			if err := sink.GetSink(c).UndeployAPI(apiEvent.UUID); err != nil {
+				logger.LoggerMessaging.Errorf("Error while undeploying the API %s: %v", apiEvent.UUID, err)
+			}
 			break
 		}
 		if strings.EqualFold(deployAPIToGateway, apiEvent.Event.Type) {
@@ -295,23 +305,11 @@ func handleApplicationEvents(data []byte, eventType string) {
 			EnvID:                 "Default",
 		}
 		if strings.EqualFold(applicationRegistration, eventType) {
-			event := event.Event{Type: constants.ApplicationKeyMappingCreated,
-				Uuid:                  uuid.New().String(),
-				TimeStamp:             applicationRegistrationEvent.TimeStamp,
-				ApplicationKeyMapping: &applicationKeyMappingEvent,
-			}
-			managementserver.AddApplicationKeyMapping(managementserver.ApplicationKeyMapping{ApplicationUUID: applicationKeyMappingEvent.ApplicationUUID, SecurityScheme: applicationKeyMappingEvent.SecurityScheme, ApplicationIdentifier: applicationKeyMappingEvent.ApplicationIdentifier, KeyType: applicationKeyMappingEvent.KeyType, Organization: applicationKeyMappingEvent.Organization, EnvID: applicationKeyMappingEvent.EnvID})
-			go utils.SendEvent(&event)
+			managementserver.AddApplicationKeyMapping(managementserver.ApplicationKeyMapping{ApplicationUUID: applicationKeyMappingEvent.ApplicationUUID, SecurityScheme: applicationKeyMappingEvent.SecurityScheme, ApplicationIdentifier: applicationKeyMappingEvent.ApplicationIdentifier, KeyType: applicationKeyMappingEvent.KeyType, Organization: applicationKeyMappingEvent.Organization, EnvID: applicationKeyMappingEvent.EnvID, Timestamp: applicationRegistrationEvent.TimeStamp})
 		} else if strings.EqualFold(removeApplicationKeyMapping, eventType) {
-			event := event.Event{Type: constants.ApplicationKeyMappingDeleted,
-				Uuid:                  uuid.New().String(),
-				TimeStamp:             applicationRegistrationEvent.TimeStamp,
-				ApplicationKeyMapping: &applicationKeyMappingEvent,
-			}
 			uuid := utils.GetUniqueIDOfApplicationKeyMapping(applicationKeyMappingEvent.ApplicationUUID, applicationKeyMappingEvent.KeyType, applicationKeyMappingEvent.SecurityScheme, applicationKeyMappingEvent.EnvID, applicationKeyMappingEvent.Organization)
 			logger.LoggerMessaging.Infof("Application Key Mapping event data %v", uuid)
 			managementserver.DeleteApplicationKeyMapping(uuid)
-			go utils.SendEvent(&event)
 		}
 	} else {
 		var applicationEvent msg.ApplicationEvent
@@ -329,10 +327,6 @@ func handleApplicationEvents(data []byte, eventType string) {
 
 		logger.LoggerMessaging.Infof("Application event data %v", applicationEvent)
 
-		if isLaterEvent(applicationListTimeStampMap, fmt.Sprint(applicationEvent.ApplicationID), applicationEvent.TimeStamp) {
-			return
-		}
-
 		applicationGrpcEvent := event.Application{Uuid: applicationEvent.UUID,
 			Name:         applicationEvent.ApplicationName,
 			Owner:        applicationEvent.Subscriber,
@@ -340,17 +334,11 @@ func handleApplicationEvents(data []byte, eventType string) {
 			Attributes:   marshalAppAttributes(applicationEvent.Attributes),
 		}
 		if applicationEvent.Event.Type == applicationCreate {
-			event := event.Event{Type: constants.ApplicationCreated, Uuid: uuid.New().String(), TimeStamp: applicationEvent.TimeStamp, Application: &applicationGrpcEvent}
-			managementserver.AddApplication(managementserver.Application{UUID: applicationGrpcEvent.Uuid, Name: applicationGrpcEvent.Name, Owner: applicationGrpcEvent.Owner, Organization: applicationGrpcEvent.Organization, Attributes: applicationGrpcEvent.Attributes})
-			utils.SendEvent(&event)
+			managementserver.AddApplication(managementserver.Application{UUID: applicationGrpcEvent.Uuid, Name: applicationGrpcEvent.Name, Owner: applicationGrpcEvent.Owner, Organization: applicationGrpcEvent.Organization, Attributes: applicationGrpcEvent.Attributes, TimeStamp: applicationEvent.TimeStamp})
 		} else if applicationEvent.Event.Type == applicationUpdate {
-			event := event.Event{Type: constants.ApplicationUpdated, Uuid: uuid.New().String(), TimeStamp: applicationEvent.TimeStamp, Application: &applicationGrpcEvent}
-			managementserver.UpdateApplication(applicationGrpcEvent.Uuid, managementserver.Application{UUID: applicationGrpcEvent.Uuid, Name: applicationGrpcEvent.Name, Owner: applicationGrpcEvent.Owner, Organization: applicationGrpcEvent.Organization, Attributes: applicationGrpcEvent.Attributes})
-			utils.SendEvent(&event)
+			managementserver.UpdateApplication(applicationGrpcEvent.Uuid, managementserver.Application{UUID: applicationGrpcEvent.Uuid, Name: applicationGrpcEvent.Name, Owner: applicationGrpcEvent.Owner, Organization: applicationGrpcEvent.Organization, Attributes: applicationGrpcEvent.Attributes, TimeStamp: applicationEvent.TimeStamp})
 		} else if applicationEvent.Event.Type == applicationDelete {
-			event := event.Event{Type: constants.ApplicationDeleted, Uuid: uuid.New().String(), TimeStamp: applicationEvent.TimeStamp, Application: &applicationGrpcEvent}
 			managementserver.DeleteApplication(applicationGrpcEvent.Uuid)
-			utils.SendEvent(&event)
 		} else {
 			logger.LoggerMessaging.Warnf("Application Event Type is not recognized for the Event under "+
 				"Application UUID %s", applicationEvent.UUID)
@@ -382,10 +370,6 @@ func handleSubscriptionEvents(data []byte, eventType string) {
 		return
 	}
 
-	if isLaterEvent(subsriptionsListTimeStampMap, fmt.Sprint(subscriptionEvent.SubscriptionID), subscriptionEvent.TimeStamp) {
-		return
-	}
-
 	subscription := event.Subscription{Uuid: subscriptionEvent.SubscriptionUUID,
 		SubStatus:     subscriptionEvent.SubscriptionState,
 		Organization:  subscriptionEvent.TenantDomain,
@@ -394,27 +378,14 @@ func handleSubscriptionEvents(data []byte, eventType string) {
 	}
 	applicationMapping := event.ApplicationMapping{Uuid: utils.GetUniqueIDOfApplicationMapping(subscriptionEvent.ApplicationUUID, subscriptionEvent.SubscriptionUUID), ApplicationRef: subscriptionEvent.ApplicationUUID, SubscriptionRef: subscriptionEvent.SubscriptionUUID, Organization: subscriptionEvent.TenantDomain}
 	if subscriptionEvent.Event.Type == subscriptionCreate {
-		subsEvent := event.Event{Uuid: uuid.New().String(), Type: constants.SubscriptionCreated, TimeStamp: subscriptionEvent.TimeStamp, Subscription: &subscription}
-		managementserver.AddSubscription(managementserver.Subscription{UUID: subscription.Uuid, SubStatus: subscription.SubStatus, Organization: subscription.Organization, RateLimit: subscription.RatelimitTier, SubscribedAPI: &managementserver.SubscribedAPI{Name: subscription.SubscribedApi.Name, Version: subscription.SubscribedApi.Version}})
-		go utils.SendEvent(&subsEvent)
-		applicationMappingEvent := event.Event{Uuid: utils.GetUniqueIDOfApplicationMapping(subscriptionEvent.ApplicationUUID, subscriptionEvent.SubscriptionUUID), Type: constants.ApplicationMappingCreated, TimeStamp: subscriptionEvent.TimeStamp, ApplicationMapping: &applicationMapping}
+		managementserver.AddSubscription(managementserver.Subscription{UUID: subscription.Uuid, SubStatus: subscription.SubStatus, Organization: subscription.Organization, RateLimit: subscription.RatelimitTier, SubscribedAPI: &managementserver.SubscribedAPI{Name: subscription.SubscribedApi.Name, Version: subscription.SubscribedApi.Version}, TimeStamp: subscriptionEvent.TimeStamp})
 		managementserver.AddApplicationMapping(managementserver.ApplicationMapping{UUID: applicationMapping.Uuid, ApplicationRef: applicationMapping.ApplicationRef, SubscriptionRef: applicationMapping.SubscriptionRef, Organization: applicationMapping.Organization})
-		go utils.SendEvent(&applicationMappingEvent)
 	} else if subscriptionEvent.Event.Type == subscriptionUpdate {
-		subsEvent := event.Event{Uuid: uuid.New().String(), Type: constants.SubscriptionUpdated, TimeStamp: subscriptionEvent.TimeStamp, Subscription: &subscription}
-		managementserver.UpdateSubscription(subscription.Uuid, managementserver.Subscription{UUID: subscription.Uuid, SubStatus: subscription.SubStatus, Organization: subscription.Organization, RateLimit: subscription.RatelimitTier, SubscribedAPI: &managementserver.SubscribedAPI{Name: subscription.SubscribedApi.Name, Version: subscription.SubscribedApi.Version}})
-		go utils.SendEvent(&subsEvent)
-		applicationMappingEvent := event.Event{Uuid: utils.GetUniqueIDOfApplicationMapping(subscriptionEvent.ApplicationUUID, subscriptionEvent.SubscriptionUUID), Type: constants.ApplicationMappingUpdated, TimeStamp: subscriptionEvent.TimeStamp, ApplicationMapping: &applicationMapping}
-		managementserver.UpdateApplicationMapping(applicationMappingEvent.Uuid, managementserver.ApplicationMapping{UUID: applicationMappingEvent.Uuid, ApplicationRef: applicationMapping.ApplicationRef, SubscriptionRef: applicationMapping.SubscriptionRef, Organization: applicationMapping.Organization})
-		go utils.SendEvent(&applicationMappingEvent)
-
+		managementserver.UpdateSubscription(subscription.Uuid, managementserver.Subscription{UUID: subscription.Uuid, SubStatus: subscription.SubStatus, Organization: subscription.Organization, RateLimit: subscription.RatelimitTier, SubscribedAPI: &managementserver.SubscribedAPI{Name: subscription.SubscribedApi.Name, Version: subscription.SubscribedApi.Version}, TimeStamp: subscriptionEvent.TimeStamp})
+		managementserver.UpdateApplicationMapping(applicationMapping.Uuid, managementserver.ApplicationMapping{UUID: applicationMapping.Uuid, ApplicationRef: applicationMapping.ApplicationRef, SubscriptionRef: applicationMapping.SubscriptionRef, Organization: applicationMapping.Organization})
 	} else if subscriptionEvent.Event.Type == subscriptionDelete {
-		subsEvent := event.Event{Uuid: uuid.New().String(), Type: constants.SubscriptionDeleted, TimeStamp: subscriptionEvent.TimeStamp, Subscription: &subscription}
 		managementserver.DeleteSubscription(subscription.Uuid)
-		go utils.SendEvent(&subsEvent)
-		applicationMappingEvent := event.Event{Uuid: utils.GetUniqueIDOfApplicationMapping(subscriptionEvent.ApplicationUUID, subscriptionEvent.SubscriptionUUID), Type: constants.ApplicationMappingDeleted, TimeStamp: subscriptionEvent.TimeStamp, ApplicationMapping: &applicationMapping}
-		managementserver.DeleteApplicationMapping(applicationMappingEvent.Uuid)
-		go utils.SendEvent(&applicationMappingEvent)
+		managementserver.DeleteApplicationMapping(applicationMapping.Uuid)
 	}
 }
 
@@ -426,6 +397,11 @@ func handleAIProviderEvents(data []byte, eventType string, c client.Client) {
 		logger.LoggerMessaging.Errorf("Error occurred while unmarshalling AI Provider event data %v", aiProviderEventErr)
 		return
 	}
+	if !belongsToTenant(aiProviderEvent.Event.TenantDomain) {
+		logger.LoggerMessaging.Debugf("AI Provider event for the AI Provider %s is dropped due to having non related tenantDomain : %s",
+			aiProviderEvent.Name, aiProviderEvent.Event.TenantDomain)
+		return
+	}
 
 	if strings.EqualFold(aiProviderCreate, eventType) {
 		logger.LoggerMessaging.Infof("Create for AI Provider: %s for tenant: %s", aiProviderEvent.Name, aiProviderEvent.Event.TenantDomain)
@@ -440,7 +416,7 @@ func handleAIProviderEvents(data []byte, eventType string, c client.Client) {
 	} else if strings.EqualFold(aiProviderDelete, eventType) {
 		logger.LoggerMessaging.Infof("Deletion for AI Provider: %s for tenant: %s", aiProviderEvent.Name, aiProviderEvent.Event.TenantDomain)
 		aiProvider := managementserver.GetAIProvider(aiProviderEvent.ID)
-		k8sclient.DeleteAIProviderCR(aiProvider.ID, c)
+		sink.GetSink(c).Delete(&dpv1alpha4.AIProvider{ObjectMeta: metav1.ObjectMeta{Name: aiProvider.ID}})
 		managementserver.DeleteAIProvider(aiProviderEvent.ID)
 		aiProviders := managementserver.GetAllAIProviders()
 		logger.LoggerMessaging.Debugf("AI Providers Internal Map: %v", aiProviders)
@@ -455,6 +431,11 @@ func handlePolicyEvents(data []byte, eventType string, c client.Client) {
 		logger.LoggerMessaging.Errorf("Error occurred while unmarshalling Throttling Policy event data %v", policyEventErr)
 		return
 	}
+	if !belongsToTenant(policyEvent.TenantDomain) {
+		logger.LoggerMessaging.Debugf("Policy event for the Policy %s is dropped due to having non related tenantDomain : %s",
+			policyEvent.PolicyName, policyEvent.TenantDomain)
+		return
+	}
 	// TODO: Handle policy events
 	if strings.EqualFold(eventType, policyCreate) {
 		if strings.EqualFold(policyEvent.PolicyType, "API") {
@@ -489,9 +470,15 @@ func handlePolicyEvents(data []byte, eventType string, c client.Client) {
 		} else if strings.EqualFold(policyEvent.PolicyType, "SUBSCRIPTION") {
 			logger.LoggerMessaging.Infof("Policy: %s for policy type: %s", policyEvent.PolicyName, policyEvent.PolicyType)
 			managementserver.DeleteSubscriptionPolicy(policyEvent.PolicyName, policyEvent.TenantDomain)
-			crName := k8sclient.PrepareSubscritionPolicyCRName(policyEvent.PolicyName, policyEvent.TenantDomain)
-			k8sclient.UnDeploySubscriptionRateLimitPolicyCR(crName, c)
-			k8sclient.UndeploySubscriptionAIRateLimitPolicyCR(crName, c)
+			conf, _ := config.ReadConfigs()
+			crMeta := metav1.ObjectMeta{Name: k8sclient.PrepareSubscritionPolicyCRName(policyEvent.PolicyName, policyEvent.TenantDomain),
+				Namespace: conf.DataPlane.Namespace}
+			if err := sink.GetSink(c).Delete(&dpv1alpha3.RateLimitPolicy{ObjectMeta: crMeta}); err != nil {
+				logger.LoggerMessaging.Errorf("Error while deleting the RateLimitPolicy of the policy %s: %v", policyEvent.PolicyName, err)
+			}
+			if err := sink.GetSink(c).Delete(&dpv1alpha3.AIRateLimitPolicy{ObjectMeta: crMeta}); err != nil {
+				logger.LoggerMessaging.Errorf("Error while deleting the AIRateLimitPolicy of the policy %s: %v", policyEvent.PolicyName, err)
+			}
 			ratelimitPolicies := managementserver.GetAllRateLimitPolicies()
 			logger.LoggerMessaging.Infof("Rate Limit Policies Internal Map: %v", ratelimitPolicies)
 		}
@@ -549,24 +536,14 @@ func handlePolicyEvents(data []byte, eventType string, c client.Client) {
 	}
 }
 
-func isLaterEvent(timeStampMap map[string]int64, mapKey string, currentTimeStamp int64) bool {
-	if timeStamp, ok := timeStampMap[mapKey]; ok {
-		if timeStamp > currentTimeStamp {
-			return true
-		}
-	}
-	timeStampMap[mapKey] = currentTimeStamp
-	return false
-}
-
 func isDefaultVersionUpdate(event msg.APIEvent) bool {
 	return strings.EqualFold(apiUpdate, event.Event.Type) && strings.EqualFold("DEFAULT_VERSION", event.Action)
 }
 
+// belongsToTenant returns true if the organization of an event is served by the agent, according to the
+// agent.organizations configurations.
 func belongsToTenant(tenantDomain string) bool {
-	// TODO : enable this once the events are fixed in apim
-	// return config.GetControlPlaneConnectedTenantDomain() == tenantDomain
-	return true
+	return utils.IsOrganizationAllowed(tenantDomain)
 }
 
 func parseNotificationJSONEvent(data []byte, notification *msg.EventNotification) error {
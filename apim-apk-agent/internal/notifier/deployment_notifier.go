@@ -19,11 +19,11 @@ package notifier
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
@@ -40,6 +40,18 @@ const (
 	contentTypeHeader    string = "Content-Type"
 )
 
+// StartRevisionAckOutbox starts sending the revision acknowledgements left in the outbox when the agent stopped
+func StartRevisionAckOutbox() {
+	getRevisionOutbox()
+}
+
+// WaitForPendingAcks sends the revision acknowledgements in the outbox, and waits until the control plane confirms
+// them or the context is done. The acknowledgements which are not confirmed are sent after the restart, if the
+// outbox is persisted.
+func WaitForPendingAcks(ctx context.Context) error {
+	return getRevisionOutbox().drain(ctx)
+}
+
 // UpdateDeployedRevisions create the DeployedAPIRevision object
 func UpdateDeployedRevisions(apiID string, revisionID int, envs []string, vhost string) *DeployedAPIRevision {
 	revisions := &DeployedAPIRevision{
@@ -57,7 +69,7 @@ func UpdateDeployedRevisions(apiID string, revisionID int, envs []string, vhost
 	return revisions
 }
 
-// SendRevisionUpdateAck sends succeeded revision deployment acknowledgement to the control plane
+// SendRevisionUpdateAck queues the succeeded revision deployment acknowledgements to be sent to the control plane
 func SendRevisionUpdateAck(deployedRevisionList []*DeployedAPIRevision) {
 	conf, _ := config.ReadConfigs()
 	cpConfigs := conf.ControlPlane
@@ -65,108 +77,107 @@ func SendRevisionUpdateAck(deployedRevisionList []*DeployedAPIRevision) {
 	if len(deployedRevisionList) < 1 || !cpConfigs.Enabled || !cpConfigs.SendRevisionUpdate {
 		return
 	}
-
-	logger.LoggerNotifier.Debugf("Revision deployed message is sending to Control plane")
-
-	revisionEP := cpConfigs.ServiceURL
-	if strings.HasSuffix(revisionEP, "/") {
-		revisionEP += deployedRevisionEP
-	} else {
-		revisionEP += "/" + deployedRevisionEP
-	}
-
-	jsonValue, _ := json.Marshal(deployedRevisionList)
-
-	// Setting authorization header
-	basicAuth := authBasic + auth.GetBasicAuth(cpConfigs.Username, cpConfigs.Password)
-
-	logger.LoggerNotifier.Debugf("Revision deployed message sending to Control plane: %v", string(jsonValue))
-
-	// Adding 3 retries for revision update sending
-	retries := 0
-	for retries < 3 {
-		retries++
-
-		req, _ := http.NewRequest("PATCH", revisionEP, bytes.NewBuffer(jsonValue))
-		req.Header.Set(authHeader, basicAuth)
-		req.Header.Set(contentTypeHeader, "application/json")
-		resp, err := tlsutils.InvokeControlPlane(req, cpConfigs.SkipSSLVerification)
-
-		success := true
-		if err != nil {
-			logger.LoggerNotifier.ErrorC(logging.ErrorDetails{
-				Message:   fmt.Sprintf("Error response from %v for attempt %v : %v", revisionEP, retries, err.Error()),
-				Severity:  logging.MAJOR,
-				ErrorCode: 2100,
-			})
-			success = false
-		}
-		if resp != nil && resp.StatusCode != http.StatusOK {
-			logger.LoggerNotifier.ErrorC(logging.ErrorDetails{
-				Message:   fmt.Sprintf("Error response status code %v from %v for attempt %v", resp.StatusCode, revisionEP, retries),
-				Severity:  logging.MINOR,
-				ErrorCode: 2101,
-			})
-			success = false
-		}
-		if success {
-			logger.LoggerNotifier.Infof("Revision deployed message sent to Control plane for attempt %v", retries)
-			break
+	for _, deployedRevision := range deployedRevisionList {
+		for _, envInfo := range deployedRevision.EnvInfo {
+			getRevisionOutbox().enqueue(&revisionAck{Deployed: &DeployedAPIRevision{
+				APIID:      deployedRevision.APIID,
+				RevisionID: deployedRevision.RevisionID,
+				EnvInfo:    []DeployedEnvInfo{envInfo},
+			}})
 		}
 	}
 }
 
-// SendRevisionUndeployAck - send the undeployed revision acknowledgement to control plane
+// SendRevisionUndeployAck - queue the undeployed revision acknowledgement to be sent to the control plane
 func SendRevisionUndeployAck(apiUUID string, revisionUUID string, environment string) {
 	conf, _ := config.ReadConfigs()
 	cpConfigs := conf.ControlPlane
 	if apiUUID == "" || revisionUUID == "" || environment == "" || !cpConfigs.Enabled || !cpConfigs.SendRevisionUpdate {
 		return
 	}
-	revisionEP := cpConfigs.ServiceURL
-	if strings.HasSuffix(revisionEP, "/") {
-		revisionEP += unDeployedRevisionEP
-	} else {
-		revisionEP += "/" + unDeployedRevisionEP
-	}
-
-	removedRevision := UnDeployedAPIRevision{
+	getRevisionOutbox().enqueue(&revisionAck{Undeployed: &UnDeployedAPIRevision{
 		APIUUID:      apiUUID,
 		RevisionUUID: revisionUUID,
 		Environment:  environment,
+	}})
+}
+
+// postDeployedRevisions sends the deployed revisions to the control plane in a single request
+func postDeployedRevisions(ctx context.Context, deployedRevisionList []*DeployedAPIRevision) error {
+	logger.LoggerNotifier.Debugf("Revision deployed message is sending to Control plane")
+	jsonValue, _ := json.Marshal(deployedRevisionList)
+	logger.LoggerNotifier.Debugf("Revision deployed message sending to Control plane: %v", string(jsonValue))
+	if err := invokeRevisionEndpoint(ctx, http.MethodPatch, deployedRevisionEP, jsonValue); err != nil {
+		return err
 	}
+	logger.LoggerNotifier.Infof("Revision deployed message sent to Control plane for %d API revisions", len(deployedRevisionList))
+	return nil
+}
 
+// postUndeployedRevision sends the undeployed revision to the control plane
+func postUndeployedRevision(ctx context.Context, removedRevision UnDeployedAPIRevision) error {
 	jsonValue, _ := json.Marshal(removedRevision)
+	if err := invokeRevisionEndpoint(ctx, http.MethodPost, unDeployedRevisionEP, jsonValue); err != nil {
+		return err
+	}
+	logger.LoggerNotifier.Infof("Revision un-deployed message sent to Control plane for the API %s", removedRevision.APIUUID)
+	return nil
+}
+
+// invokeRevisionEndpoint sends the payload to the revision endpoint of the control plane. An error is returned if
+// the control plane does not confirm it.
+func invokeRevisionEndpoint(ctx context.Context, method string, endpoint string, payload []byte) error {
+	conf, _ := config.ReadConfigs()
+	cpConfigs := conf.ControlPlane
+	revisionEP := cpConfigs.ServiceURL
+	if strings.HasSuffix(revisionEP, "/") {
+		revisionEP += endpoint
+	} else {
+		revisionEP += "/" + endpoint
+	}
+
+	// Setting authorization header
 	basicAuth := authBasic + auth.GetBasicAuth(cpConfigs.Username, cpConfigs.Password)
-	retries := 0
-	for retries < 3 {
-		retries++
-		req, _ := http.NewRequest("POST", revisionEP, bytes.NewBuffer(jsonValue))
-		req.Header.Set(authHeader, basicAuth)
-		req.Header.Set(contentTypeHeader, "application/json")
-		resp, err := tlsutils.InvokeControlPlane(req, cpConfigs.SkipSSLVerification)
-
-		success := true
-		if err != nil {
-			logger.LoggerNotifier.ErrorC(logging.ErrorDetails{
-				Message:   fmt.Sprintf("Error response from %s for attempt %d : %v", revisionEP, retries, err.Error()),
-				Severity:  logging.MAJOR,
-				ErrorCode: 2100,
-			})
-			success = false
-		}
-		if resp != nil && resp.StatusCode != http.StatusOK {
-			logger.LoggerNotifier.ErrorC(logging.ErrorDetails{
-				Message:   fmt.Sprintf("Error response status code %v from %s for attempt %d", resp.StatusCode, revisionEP, retries),
-				Severity:  logging.MINOR,
-				ErrorCode: 2101,
-			})
-			success = false
-		}
-		if success {
-			logger.LoggerNotifier.Infof("Revision un-deployed message sent to Control plane for attempt %d", retries)
-			break
-		}
-		time.Sleep(2 * time.Second)
+	req, err := http.NewRequestWithContext(ctx, method, revisionEP, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(authHeader, basicAuth)
+	req.Header.Set(contentTypeHeader, "application/json")
+	resp, err := tlsutils.InvokeControlPlane(req, cpConfigs.SkipSSLVerification)
+	if err != nil {
+		logger.LoggerNotifier.ErrorC(logging.ErrorDetails{
+			Message:   fmt.Sprintf("Error response from %v : %v", revisionEP, err.Error()),
+			Severity:  logging.MAJOR,
+			ErrorCode: 2100,
+		})
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		logger.LoggerNotifier.ErrorC(logging.ErrorDetails{
+			Message:   fmt.Sprintf("Error response status code %v from %v", resp.StatusCode, revisionEP),
+			Severity:  logging.MINOR,
+			ErrorCode: 2101,
+		})
+		return &revisionEndpointError{statusCode: resp.StatusCode, endpoint: revisionEP}
 	}
+	return nil
+}
+
+// revisionEndpointError is returned when the control plane responds to a revision acknowledgement with an error
+type revisionEndpointError struct {
+	statusCode int
+	endpoint   string
+}
+
+func (e *revisionEndpointError) Error() string {
+	return fmt.Sprintf("status code %d from %s", e.statusCode, e.endpoint)
+}
+
+// isRejected returns true if the control plane rejected the acknowledgement with a client error, hence sending it
+// again would not succeed. The request timeouts and rate limiting are not rejections.
+func (e *revisionEndpointError) isRejected() bool {
+	return e.statusCode >= 400 && e.statusCode < 500 && e.statusCode != http.StatusRequestTimeout &&
+		e.statusCode != http.StatusTooManyRequests
 }
@@ -0,0 +1,401 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/audit"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/metrics"
+)
+
+const (
+	ackActionDeploy   = "deploy"
+	ackActionUndeploy = "undeploy"
+)
+
+var (
+	onceOutboxInit sync.Once
+	outbox         *revisionOutbox
+)
+
+// revisionAck is a revision deployment acknowledgement in the outbox. Either Deployed, with a single environment,
+// or Undeployed is set.
+type revisionAck struct {
+	Deployed    *DeployedAPIRevision   `json:"deployed,omitempty"`
+	Undeployed  *UnDeployedAPIRevision `json:"undeployed,omitempty"`
+	Attempts    int                    `json:"attempts"`
+	LastError   string                 `json:"lastError,omitempty"`
+	QueuedAt    time.Time              `json:"queuedAt"`
+	NextAttempt time.Time              `json:"nextAttempt"`
+	// sequence identifies the ack, so that an ack which replaced it while it was being sent is kept
+	sequence uint64
+}
+
+// key returns the API and the environment of the ack, by which the acks are coalesced
+func (a *revisionAck) key() string {
+	if a.Deployed != nil {
+		return a.Deployed.APIID + ":" + a.Deployed.EnvInfo[0].Name
+	}
+	return a.Undeployed.APIUUID + ":" + a.Undeployed.Environment
+}
+
+// revisionOutbox holds the revision deployment acknowledgements until the control plane confirms them. A queued
+// ack replaces the pending ack of the same API and environment, since only the latest state of the environment
+// matters. The pending acks are sent in batches, and the failed ones are retried with an exponential backoff. The
+// acks rejected by the control plane, or not confirmed within maxAttempts, are dropped.
+type revisionOutbox struct {
+	mutex          sync.Mutex
+	flushMutex     sync.Mutex
+	acks           map[string]*revisionAck
+	sequence       uint64
+	wakeup         chan struct{}
+	persist        func([]byte)
+	sendDeployed   func(context.Context, []*DeployedAPIRevision) error
+	sendUndeployed func(context.Context, UnDeployedAPIRevision) error
+	batchInterval  time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxAttempts    int
+	// version is incremented whenever the acks are changed. persistMutex serialises the writes of the outbox, which
+	// are done without holding the mutex, and persistedVersion skips a state older than the one already written.
+	version          uint64
+	persistedVersion uint64
+	persistMutex     sync.Mutex
+}
+
+// getRevisionOutbox returns the outbox of the revision deployment acknowledgements, which is persisted with the
+// state of the agent. The acks left in the outbox when the agent stopped are sent once it starts.
+func getRevisionOutbox() *revisionOutbox {
+	onceOutboxInit.Do(func() {
+		conf, _ := config.ReadConfigs()
+		outbox = newRevisionOutbox(snapshot.LoadState(snapshot.RevisionAcks), func(content []byte) {
+			snapshot.SaveState(snapshot.RevisionAcks, content)
+		}, postDeployedRevisions, postUndeployedRevision)
+		outbox.batchInterval = conf.ControlPlane.RevisionAck.BatchInterval * time.Second
+		outbox.initialBackoff = conf.ControlPlane.RevisionAck.InitialBackoff * time.Second
+		outbox.maxBackoff = conf.ControlPlane.RevisionAck.MaxBackoff * time.Second
+		outbox.maxAttempts = conf.ControlPlane.RevisionAck.MaxAttempts
+		managementserver.SetRevisionAckProvider(outbox.pending)
+		go outbox.run()
+	})
+	return outbox
+}
+
+// newRevisionOutbox creates an outbox with the acks persisted earlier, which sends the acks with the given functions
+func newRevisionOutbox(persisted []byte, persist func([]byte),
+	sendDeployed func(context.Context, []*DeployedAPIRevision) error,
+	sendUndeployed func(context.Context, UnDeployedAPIRevision) error) *revisionOutbox {
+	o := &revisionOutbox{
+		acks:           make(map[string]*revisionAck),
+		wakeup:         make(chan struct{}, 1),
+		persist:        persist,
+		sendDeployed:   sendDeployed,
+		sendUndeployed: sendUndeployed,
+	}
+	if len(persisted) > 0 {
+		var acks []*revisionAck
+		if err := json.Unmarshal(persisted, &acks); err != nil {
+			logger.LoggerNotifier.Errorf("Error occurred while loading the revision acknowledgements %v", err)
+		}
+		for _, ack := range acks {
+			if (ack.Deployed == nil || len(ack.Deployed.EnvInfo) != 1) && ack.Undeployed == nil {
+				continue
+			}
+			o.sequence++
+			ack.sequence = o.sequence
+			o.acks[ack.key()] = ack
+		}
+	}
+	metrics.RevisionAcksPending.Set(float64(len(o.acks)))
+	return o
+}
+
+// enqueue adds the ack to the outbox, replacing the pending ack of the same API and environment
+func (o *revisionOutbox) enqueue(ack *revisionAck) {
+	now := time.Now()
+	ack.QueuedAt = now
+	ack.NextAttempt = now
+	o.mutex.Lock()
+	o.sequence++
+	ack.sequence = o.sequence
+	o.acks[ack.key()] = ack
+	content, version := o.changedLocked()
+	o.mutex.Unlock()
+	o.write(content, version)
+	select {
+	case o.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// run sends the pending acks once they are due. The acks queued within the batch interval are sent together.
+func (o *revisionOutbox) run() {
+	for {
+		timer := time.NewTimer(o.untilNextAttempt())
+		select {
+		case <-o.wakeup:
+			timer.Stop()
+			time.Sleep(o.batchInterval)
+		case <-timer.C:
+		}
+		o.flush(context.Background(), false)
+	}
+}
+
+// untilNextAttempt returns the time until the earliest pending ack is due
+func (o *revisionOutbox) untilNextAttempt() time.Duration {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	next := time.Duration(-1)
+	for _, ack := range o.acks {
+		if wait := time.Until(ack.NextAttempt); next < 0 || wait < next {
+			next = wait
+		}
+	}
+	if next < 0 {
+		// Nothing is pending, hence wait for an ack to be queued
+		return time.Hour
+	}
+	return next
+}
+
+// flush sends the pending acks which are due, or all the pending acks if forced. The deployed revisions are sent
+// in a single request. If the control plane rejects the request, the revisions are sent one by one, so that a
+// rejected revision does not fail the others.
+func (o *revisionOutbox) flush(ctx context.Context, force bool) {
+	o.flushMutex.Lock()
+	defer o.flushMutex.Unlock()
+	now := time.Now()
+	var deployedAcks, undeployedAcks []revisionAck
+	o.mutex.Lock()
+	for _, ack := range o.acks {
+		if !force && ack.NextAttempt.After(now) {
+			continue
+		}
+		if ack.Deployed != nil {
+			deployedAcks = append(deployedAcks, *ack)
+		} else {
+			undeployedAcks = append(undeployedAcks, *ack)
+		}
+	}
+	o.mutex.Unlock()
+
+	if len(deployedAcks) > 0 {
+		deployedRevisions, acksOfRevisions := mergeDeployedRevisions(deployedAcks)
+		err := o.sendDeployed(ctx, deployedRevisions)
+		var endpointErr *revisionEndpointError
+		if err == nil || len(deployedRevisions) == 1 || !errors.As(err, &endpointErr) {
+			o.complete(deployedAcks, err)
+		} else {
+			for i, deployedRevision := range deployedRevisions {
+				o.complete(acksOfRevisions[i], o.sendDeployed(ctx, []*DeployedAPIRevision{deployedRevision}))
+			}
+		}
+	}
+	for _, ack := range undeployedAcks {
+		err := o.sendUndeployed(ctx, *ack.Undeployed)
+		o.complete([]revisionAck{ack}, err)
+	}
+}
+
+// complete removes the sent acks from the outbox, or schedules them to be retried if the control plane did not
+// confirm them. The acks which were rejected by the control plane, or reached the maximum number of attempts, are
+// dropped. The acks which were replaced while being sent are left in the outbox.
+func (o *revisionOutbox) complete(sent []revisionAck, err error) {
+	o.mutex.Lock()
+	var endpointErr *revisionEndpointError
+	rejected := errors.As(err, &endpointErr) && endpointErr.isRejected()
+	for _, sentAck := range sent {
+		ack, exists := o.acks[sentAck.key()]
+		if !exists || ack.sequence != sentAck.sequence {
+			continue
+		}
+		if err == nil {
+			delete(o.acks, sentAck.key())
+			metrics.RevisionAcksSent.Inc()
+			if sentAck.Deployed != nil {
+				audit.RevisionAckSent(audit.ActionDeployAckSent, sentAck.Deployed.APIID,
+					sentAck.Deployed.EnvInfo[0].Name, strconv.Itoa(sentAck.Deployed.RevisionID))
+			} else {
+				audit.RevisionAckSent(audit.ActionUndeployAckSent, sentAck.Undeployed.APIUUID,
+					sentAck.Undeployed.Environment, sentAck.Undeployed.RevisionUUID)
+			}
+			continue
+		}
+		ack.Attempts++
+		ack.LastError = err.Error()
+		if rejected || (o.maxAttempts > 0 && ack.Attempts >= o.maxAttempts) {
+			delete(o.acks, sentAck.key())
+			o.drop(ack)
+			continue
+		}
+		ack.NextAttempt = time.Now().Add(o.backoff(ack.Attempts))
+	}
+	if err != nil {
+		metrics.RevisionAckFailures.Inc()
+		if !rejected {
+			logger.LoggerNotifier.Warnf("Revision acknowledgements are not confirmed by the control plane, hence they will be retried: %v", err)
+		}
+	}
+	content, version := o.changedLocked()
+	o.mutex.Unlock()
+	o.write(content, version)
+}
+
+// drop records an ack which is removed from the outbox without being confirmed by the control plane
+func (o *revisionOutbox) drop(ack *revisionAck) {
+	metrics.RevisionAcksDropped.Inc()
+	if ack.Deployed != nil {
+		logger.LoggerNotifier.Errorf("Revision deployed acknowledgement of the API %s revision %d in %s is dropped after %d attempts: %s",
+			ack.Deployed.APIID, ack.Deployed.RevisionID, ack.Deployed.EnvInfo[0].Name, ack.Attempts, ack.LastError)
+		audit.RevisionAckDropped(audit.ActionDeployAckDropped, ack.Deployed.APIID, ack.Deployed.EnvInfo[0].Name,
+			strconv.Itoa(ack.Deployed.RevisionID))
+		return
+	}
+	logger.LoggerNotifier.Errorf("Revision un-deployed acknowledgement of the API %s revision %s in %s is dropped after %d attempts: %s",
+		ack.Undeployed.APIUUID, ack.Undeployed.RevisionUUID, ack.Undeployed.Environment, ack.Attempts, ack.LastError)
+	audit.RevisionAckDropped(audit.ActionUndeployAckDropped, ack.Undeployed.APIUUID, ack.Undeployed.Environment,
+		ack.Undeployed.RevisionUUID)
+}
+
+// backoff returns the time to wait before the given attempt, doubling from the initial backoff up to the maximum
+func (o *revisionOutbox) backoff(attempts int) time.Duration {
+	backoff := o.initialBackoff
+	for i := 1; i < attempts && backoff < o.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > o.maxBackoff {
+		backoff = o.maxBackoff
+	}
+	return backoff
+}
+
+// drain sends all the pending acks, without waiting for their backoff, until the outbox is empty or the context is
+// done. The acks which are not confirmed remain persisted to be sent after the restart.
+func (o *revisionOutbox) drain(ctx context.Context) error {
+	for {
+		o.flush(ctx, true)
+		if o.size() == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.initialBackoff):
+		}
+	}
+}
+
+func (o *revisionOutbox) size() int {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return len(o.acks)
+}
+
+// pending returns the acks which are not confirmed by the control plane yet, the oldest first
+func (o *revisionOutbox) pending() []managementserver.RevisionAck {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	pendingAcks := make([]managementserver.RevisionAck, 0, len(o.acks))
+	for _, ack := range o.acks {
+		pendingAck := managementserver.RevisionAck{
+			Attempts:    ack.Attempts,
+			LastError:   ack.LastError,
+			QueuedAt:    ack.QueuedAt,
+			NextAttempt: ack.NextAttempt,
+		}
+		if ack.Deployed != nil {
+			pendingAck.APIID = ack.Deployed.APIID
+			pendingAck.Environment = ack.Deployed.EnvInfo[0].Name
+			pendingAck.Action = ackActionDeploy
+			pendingAck.Revision = strconv.Itoa(ack.Deployed.RevisionID)
+		} else {
+			pendingAck.APIID = ack.Undeployed.APIUUID
+			pendingAck.Environment = ack.Undeployed.Environment
+			pendingAck.Action = ackActionUndeploy
+			pendingAck.Revision = ack.Undeployed.RevisionUUID
+		}
+		pendingAcks = append(pendingAcks, pendingAck)
+	}
+	sort.Slice(pendingAcks, func(i, j int) bool {
+		return pendingAcks[i].QueuedAt.Before(pendingAcks[j].QueuedAt)
+	})
+	return pendingAcks
+}
+
+// changedLocked updates the metrics after the pending acks are changed, and returns the content to be persisted
+// along with its version. The mutex must be held by the caller. The content is nil if it can not be marshalled.
+func (o *revisionOutbox) changedLocked() ([]byte, uint64) {
+	metrics.RevisionAcksPending.Set(float64(len(o.acks)))
+	o.version++
+	acks := make([]*revisionAck, 0, len(o.acks))
+	for _, ack := range o.acks {
+		acks = append(acks, ack)
+	}
+	content, err := json.Marshal(acks)
+	if err != nil {
+		logger.LoggerNotifier.Errorf("Error occurred while persisting the revision acknowledgements %v", err)
+		return nil, o.version
+	}
+	return content, o.version
+}
+
+// write persists the content of the given version of the pending acks, unless a later version is already
+// persisted. It must be called without holding the mutex, so that the acks can be queued meanwhile.
+func (o *revisionOutbox) write(content []byte, version uint64) {
+	o.persistMutex.Lock()
+	defer o.persistMutex.Unlock()
+	if content == nil || version <= o.persistedVersion {
+		return
+	}
+	o.persist(content)
+	o.persistedVersion = version
+}
+
+// mergeDeployedRevisions merges the deployed acks of the same API revision into a single entry with all the
+// environments. The acks merged into each entry are returned along with the entries, in the same order.
+func mergeDeployedRevisions(acks []revisionAck) ([]*DeployedAPIRevision, [][]revisionAck) {
+	merged := make(map[string]int)
+	var deployedRevisions []*DeployedAPIRevision
+	var acksOfRevisions [][]revisionAck
+	for _, ack := range acks {
+		revisionKey := ack.Deployed.APIID + ":" + strconv.Itoa(ack.Deployed.RevisionID)
+		index, exists := merged[revisionKey]
+		if !exists {
+			index = len(deployedRevisions)
+			merged[revisionKey] = index
+			deployedRevisions = append(deployedRevisions, &DeployedAPIRevision{APIID: ack.Deployed.APIID,
+				RevisionID: ack.Deployed.RevisionID, EnvInfo: []DeployedEnvInfo{}})
+			acksOfRevisions = append(acksOfRevisions, nil)
+		}
+		deployedRevisions[index].EnvInfo = append(deployedRevisions[index].EnvInfo, ack.Deployed.EnvInfo...)
+		acksOfRevisions[index] = append(acksOfRevisions[index], ack)
+	}
+	return deployedRevisions, acksOfRevisions
+}
@@ -0,0 +1,167 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func deployedAck(apiID string, revisionID int, env string) *revisionAck {
+	return &revisionAck{Deployed: &DeployedAPIRevision{APIID: apiID, RevisionID: revisionID,
+		EnvInfo: []DeployedEnvInfo{{Name: env, VHost: "gw.wso2.com"}}}}
+}
+
+func TestRevisionOutboxCoalescesAndBatchesAcks(t *testing.T) {
+	var batches [][]*DeployedAPIRevision
+	var undeployed []UnDeployedAPIRevision
+	o := newRevisionOutbox(nil, func([]byte) {},
+		func(_ context.Context, revisions []*DeployedAPIRevision) error {
+			batches = append(batches, revisions)
+			return nil
+		},
+		func(_ context.Context, revision UnDeployedAPIRevision) error {
+			undeployed = append(undeployed, revision)
+			return nil
+		})
+
+	o.enqueue(deployedAck("api1", 1, "Default"))
+	// The later ack of the same API and environment replaces the earlier one
+	o.enqueue(deployedAck("api1", 2, "Default"))
+	o.enqueue(deployedAck("api1", 2, "Production"))
+	o.enqueue(deployedAck("api2", 1, "Default"))
+	o.enqueue(&revisionAck{Undeployed: &UnDeployedAPIRevision{APIUUID: "api3", RevisionUUID: "r1", Environment: "Default"}})
+	assert.Len(t, o.pending(), 4)
+
+	o.flush(context.Background(), false)
+	assert.Len(t, batches, 1)
+	assert.Len(t, batches[0], 2)
+	for _, revision := range batches[0] {
+		if revision.APIID == "api1" {
+			assert.Equal(t, 2, revision.RevisionID)
+			assert.Len(t, revision.EnvInfo, 2)
+		}
+	}
+	assert.Len(t, undeployed, 1)
+	assert.Empty(t, o.pending())
+}
+
+func TestRevisionOutboxRetriesWithBackoff(t *testing.T) {
+	var persisted []byte
+	failures := 1
+	o := newRevisionOutbox(nil, func(content []byte) { persisted = content },
+		func(context.Context, []*DeployedAPIRevision) error {
+			if failures > 0 {
+				failures--
+				return errors.New("control plane is unavailable")
+			}
+			return nil
+		}, nil)
+	o.initialBackoff = time.Hour
+	o.maxBackoff = 4 * time.Hour
+	assert.Equal(t, time.Hour, o.backoff(1))
+	assert.Equal(t, 4*time.Hour, o.backoff(3))
+	assert.Equal(t, 4*time.Hour, o.backoff(10))
+
+	o.enqueue(deployedAck("api1", 1, "Default"))
+	o.flush(context.Background(), false)
+	pending := o.pending()
+	assert.Len(t, pending, 1)
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Equal(t, "control plane is unavailable", pending[0].LastError)
+	assert.True(t, pending[0].NextAttempt.After(time.Now().Add(30*time.Minute)))
+
+	// The ack is not sent again before the backoff, and survives a restart
+	o.flush(context.Background(), false)
+	assert.Equal(t, 0, failures)
+	assert.Len(t, o.pending(), 1)
+	restarted := newRevisionOutbox(persisted, func([]byte) {},
+		func(context.Context, []*DeployedAPIRevision) error { return nil }, nil)
+	assert.Len(t, restarted.pending(), 1)
+	assert.Equal(t, "api1", restarted.pending()[0].APIID)
+
+	// Draining sends the ack regardless of the backoff
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, o.drain(ctx))
+	assert.Empty(t, o.pending())
+}
+
+func TestRevisionOutboxPersistsWithoutHoldingTheLock(t *testing.T) {
+	var o *revisionOutbox
+	var persistedSizes []int
+	// The acks can be read while they are being persisted
+	o = newRevisionOutbox(nil, func([]byte) { persistedSizes = append(persistedSizes, len(o.pending())) },
+		func(context.Context, []*DeployedAPIRevision) error { return nil }, nil)
+
+	done := make(chan struct{})
+	go func() {
+		o.enqueue(deployedAck("api1", 1, "Default"))
+		o.flush(context.Background(), false)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the outbox is persisted while holding the lock")
+	}
+	assert.Equal(t, []int{1, 0}, persistedSizes)
+
+	// A state older than the persisted one is not written
+	o.write([]byte("[]"), 1)
+	assert.Len(t, persistedSizes, 2)
+}
+
+func TestRevisionOutboxSplitsRejectedBatchesAndDropsAcks(t *testing.T) {
+	var sent [][]*DeployedAPIRevision
+	o := newRevisionOutbox(nil, func([]byte) {},
+		func(_ context.Context, revisions []*DeployedAPIRevision) error {
+			sent = append(sent, revisions)
+			for _, revision := range revisions {
+				if revision.APIID == "api2" {
+					return &revisionEndpointError{statusCode: 404, endpoint: "deployed-revisions"}
+				}
+				if revision.APIID == "api3" {
+					return &revisionEndpointError{statusCode: 503, endpoint: "deployed-revisions"}
+				}
+			}
+			return nil
+		}, nil)
+	o.initialBackoff = time.Hour
+	o.maxBackoff = time.Hour
+	o.maxAttempts = 2
+
+	o.enqueue(deployedAck("api1", 1, "Default"))
+	o.enqueue(deployedAck("api2", 1, "Default"))
+	o.enqueue(deployedAck("api3", 1, "Default"))
+	o.flush(context.Background(), false)
+	// The failed batch is sent again one revision at a time
+	assert.Len(t, sent, 4)
+	// The confirmed ack is removed, the rejected ack is dropped and the unavailable one is retried
+	pending := o.pending()
+	assert.Len(t, pending, 1)
+	assert.Equal(t, "api3", pending[0].APIID)
+
+	// The ack is dropped once it reaches the maximum number of attempts
+	o.flush(context.Background(), true)
+	assert.Empty(t, o.pending())
+}
@@ -0,0 +1,363 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package sink
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/transformer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	apisDir      = "apis"
+	resourcesDir = "resources"
+	yamlExt      = ".yaml"
+)
+
+// GitSink writes the generated CRs to a git working tree, so that they can be deployed by a GitOps tool.
+//
+// The CRs of an API are written to apis/<apiUUID>/<kind>-<name>.yaml and the directory is rewritten on each
+// revision. The CRs which do not belong to an API are written to resources/<kind>/<name>.yaml. A CR of an API which
+// is applied or deleted on its own, such as a RateLimitPolicy updated with its control plane policy, is rewritten
+// or removed in the directory of its API.
+//
+// Secrets are never written, as they would be committed and pushed to the remote in plaintext. They have to be
+// provisioned in the cluster out of band, for example with SealedSecrets or ExternalSecrets.
+type GitSink struct {
+	// Path is the location of the git working tree
+	Path string
+	// CommitEnabled commits each change to the working tree
+	CommitEnabled bool
+	AuthorName    string
+	AuthorEmail   string
+	// PushEnabled pushes each commit to the Remote/Branch
+	PushEnabled bool
+	Remote      string
+	Branch      string
+
+	scheme *runtime.Scheme
+	mutex  sync.Mutex
+}
+
+// NewGitSink returns a sink which writes the CRs to the given git working tree. The scheme is used to
+// resolve the apiVersion and kind of the CRs which do not carry them.
+func NewGitSink(path string, scheme *runtime.Scheme) *GitSink {
+	return &GitSink{Path: path, scheme: scheme}
+}
+
+// DeployAPI writes all the CRs of the given API revision to apis/<apiUUID> and commits them.
+func (s *GitSink) DeployAPI(k8sArtifact transformer.K8sArtifacts) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	apiUUID := k8sArtifact.API.ObjectMeta.Labels[apiUUIDLabel]
+	if apiUUID == "" {
+		apiUUID = k8sArtifact.API.Name
+	}
+	revisionID := k8sArtifact.API.ObjectMeta.Labels[revisionIDLabel]
+
+	objects := []client.Object{&k8sArtifact.API}
+	for _, configMap := range k8sArtifact.ConfigMaps {
+		objects = append(objects, configMap)
+	}
+	for _, authPolicy := range k8sArtifact.Authentication {
+		objects = append(objects, authPolicy)
+	}
+	for _, interceptorService := range k8sArtifact.InterceptorServices {
+		objects = append(objects, interceptorService)
+	}
+	if k8sArtifact.BackendJWT != nil {
+		objects = append(objects, k8sArtifact.BackendJWT)
+	}
+	for _, scope := range k8sArtifact.Scopes {
+		objects = append(objects, scope)
+	}
+	for _, rateLimitPolicy := range k8sArtifact.RateLimitPolicies {
+		objects = append(objects, rateLimitPolicy)
+	}
+	for _, aiRateLimitPolicy := range k8sArtifact.AIRateLimitPolicies {
+		objects = append(objects, aiRateLimitPolicy)
+	}
+	for _, secret := range k8sArtifact.Secrets {
+		logger.LoggerSink.Warnf("Secret %s of API %s is not written to the git working tree. It has to be provisioned "+
+			"in the cluster out of band", secret.Name, apiUUID)
+	}
+	for _, apiPolicy := range k8sArtifact.APIPolicies {
+		objects = append(objects, apiPolicy)
+	}
+	for _, httpRoute := range k8sArtifact.HTTPRoutes {
+		objects = append(objects, httpRoute)
+	}
+	for _, gqlRoute := range k8sArtifact.GQLRoutes {
+		objects = append(objects, gqlRoute)
+	}
+	for _, backend := range k8sArtifact.Backends {
+		objects = append(objects, backend)
+	}
+
+	if err := s.initWorkingTree(); err != nil {
+		return err
+	}
+	apiDir := filepath.Join(s.Path, apisDir, apiUUID)
+	// The directory is rewritten so that the CRs removed from the revision are removed from the tree as well.
+	if err := os.RemoveAll(apiDir); err != nil {
+		logger.LoggerSink.Errorf("Error while cleaning the directory of API %s: %v", apiUUID, err)
+		return err
+	}
+	for _, obj := range objects {
+		kind, content, err := s.marshal(obj)
+		if err != nil {
+			logger.LoggerSink.Errorf("Error while marshalling the CR %s of API %s: %v", obj.GetName(), apiUUID, err)
+			return err
+		}
+		if err := writeFile(filepath.Join(apiDir, strings.ToLower(kind)+"-"+obj.GetName()+yamlExt), content); err != nil {
+			logger.LoggerSink.Errorf("Error while writing the CR %s of API %s: %v", obj.GetName(), apiUUID, err)
+			return err
+		}
+	}
+	logger.LoggerSink.Infof("CRs of API %s revision %s written to %s", apiUUID, revisionID, apiDir)
+	return s.commit(fmt.Sprintf("Deploy API %s revision %s", apiUUID, revisionID))
+}
+
+// UndeployAPI removes the directory of the given API and commits the removal.
+func (s *GitSink) UndeployAPI(apiUUID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.initWorkingTree(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(s.Path, apisDir, apiUUID)); err != nil {
+		logger.LoggerSink.Errorf("Error while removing the directory of API %s: %v", apiUUID, err)
+		return err
+	}
+	logger.LoggerSink.Infof("CRs of API %s removed from %s", apiUUID, s.Path)
+	return s.commit(fmt.Sprintf("Undeploy API %s", apiUUID))
+}
+
+// PruneAPIs removes the directories of the APIs which are not in the given list of API UUIDs.
+func (s *GitSink) PruneAPIs(apiUUIDs []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.initWorkingTree(); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(filepath.Join(s.Path, apisDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		logger.LoggerSink.Errorf("Error while reading the API directories: %v", err)
+		return err
+	}
+	activeAPIs := make(map[string]bool, len(apiUUIDs))
+	for _, apiUUID := range apiUUIDs {
+		activeAPIs[apiUUID] = true
+	}
+	removedAPIs := make([]string, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() || activeAPIs[entry.Name()] {
+			continue
+		}
+		logger.LoggerSink.Infof("API %s is not found in the control plane. Hence removing it from %s", entry.Name(), s.Path)
+		if err := os.RemoveAll(filepath.Join(s.Path, apisDir, entry.Name())); err != nil {
+			logger.LoggerSink.Errorf("Error while removing the directory of API %s: %v", entry.Name(), err)
+			return err
+		}
+		removedAPIs = append(removedAPIs, entry.Name())
+	}
+	if len(removedAPIs) == 0 {
+		return nil
+	}
+	return s.commit(fmt.Sprintf("Undeploy APIs %s", strings.Join(removedAPIs, ", ")))
+}
+
+// Apply writes the given CR to resources/<kind>/<name>.yaml, or to the directory of its API if it belongs to an
+// API, and commits it.
+func (s *GitSink) Apply(obj client.Object) error {
+	if isSecret(obj) {
+		logger.LoggerSink.Warnf("Secret %s is not written to the git working tree. It has to be provisioned in the "+
+			"cluster out of band", obj.GetName())
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.initWorkingTree(); err != nil {
+		return err
+	}
+	kind, content, err := s.marshal(obj)
+	if err != nil {
+		logger.LoggerSink.Errorf("Error while marshalling the CR %s: %v", obj.GetName(), err)
+		return err
+	}
+	if err := writeFile(s.locate(kind, obj.GetName()), content); err != nil {
+		logger.LoggerSink.Errorf("Error while writing the CR %s: %v", obj.GetName(), err)
+		return err
+	}
+	return s.commit(fmt.Sprintf("Apply %s %s", kind, obj.GetName()))
+}
+
+// Delete removes the file of the given CR and commits the removal.
+func (s *GitSink) Delete(obj client.Object) error {
+	if isSecret(obj) {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.initWorkingTree(); err != nil {
+		return err
+	}
+	_, kind := s.withKind(obj)
+	if err := os.Remove(s.locate(kind, obj.GetName())); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		logger.LoggerSink.Errorf("Error while removing the CR %s: %v", obj.GetName(), err)
+		return err
+	}
+	return s.commit(fmt.Sprintf("Delete %s %s", kind, obj.GetName()))
+}
+
+func (s *GitSink) resourcePath(kind string, name string) string {
+	return filepath.Join(s.Path, resourcesDir, strings.ToLower(kind), name+yamlExt)
+}
+
+// locate returns the path of the file of the CR in the directory of the API it belongs to, or the path under
+// resources/<kind> if the CR does not belong to an API.
+func (s *GitSink) locate(kind string, name string) string {
+	matches, err := filepath.Glob(filepath.Join(s.Path, apisDir, "*", strings.ToLower(kind)+"-"+name+yamlExt))
+	if err == nil && len(matches) > 0 {
+		return matches[0]
+	}
+	return s.resourcePath(kind, name)
+}
+
+// marshal returns the kind and the YAML representation of the given CR.
+func (s *GitSink) marshal(obj client.Object) (string, []byte, error) {
+	obj, kind := s.withKind(obj)
+	content, err := yaml.Marshal(obj)
+	return kind, content, err
+}
+
+// withKind returns a copy of the given CR with the apiVersion and kind resolved from the scheme, if the CR
+// does not carry them, along with the kind. The metadata populated by the Kubernetes API server, which the CRs
+// read from the cluster carry, is cleared.
+func (s *GitSink) withKind(obj client.Object) (client.Object, string) {
+	obj = obj.DeepCopyObject().(client.Object)
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetManagedFields(nil)
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" && s.scheme != nil {
+		if resolvedGVK, err := apiutil.GVKForObject(obj, s.scheme); err == nil {
+			gvk = resolvedGVK
+			obj.GetObjectKind().SetGroupVersionKind(gvk)
+		}
+	}
+	if gvk.Kind == "" {
+		return obj, reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	}
+	return obj, gvk.Kind
+}
+
+// initWorkingTree creates the working tree and initializes the git repository if it does not exist.
+func (s *GitSink) initWorkingTree() error {
+	if err := os.MkdirAll(s.Path, 0755); err != nil {
+		logger.LoggerSink.Errorf("Error while creating the git working tree %s: %v", s.Path, err)
+		return err
+	}
+	if !s.CommitEnabled {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(s.Path, ".git")); err == nil {
+		return nil
+	}
+	logger.LoggerSink.Infof("Initializing git repository in %s", s.Path)
+	_, err := s.git("init")
+	return err
+}
+
+// commit commits all the changes in the working tree with the given message and pushes the commit if enabled.
+func (s *GitSink) commit(message string) error {
+	if !s.CommitEnabled {
+		return nil
+	}
+	status, err := s.git("status", "--porcelain")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(status) == "" {
+		logger.LoggerSink.Debugf("No changes to commit for: %s", message)
+		return nil
+	}
+	if _, err := s.git("add", "-A"); err != nil {
+		return err
+	}
+	if _, err := s.git("-c", "user.name="+s.AuthorName, "-c", "user.email="+s.AuthorEmail, "commit", "-m", message); err != nil {
+		return err
+	}
+	logger.LoggerSink.Infof("Committed to the git working tree: %s", message)
+	if s.PushEnabled {
+		if _, err := s.git("push", s.Remote, "HEAD:"+s.Branch); err != nil {
+			return err
+		}
+		logger.LoggerSink.Infof("Pushed the commit to %s/%s", s.Remote, s.Branch)
+	}
+	return nil
+}
+
+func (s *GitSink) git(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", s.Path}, args...)...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		logger.LoggerSink.Errorf("Error while running git %s: %v %s", strings.Join(args, " "), err, string(output))
+		return string(output), err
+	}
+	return string(output), nil
+}
+
+// isSecret returns true if the given CR is a Secret, which is never written to the git working tree.
+func isSecret(obj client.Object) bool {
+	_, ok := obj.(*corev1.Secret)
+	return ok
+}
+
+func writeFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0644)
+}
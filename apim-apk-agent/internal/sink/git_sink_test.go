@@ -0,0 +1,162 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package sink
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/transformer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func newTestGitSink(t *testing.T) *GitSink {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, gwapiv1.AddToScheme(scheme))
+	gitSink := NewGitSink(t.TempDir(), scheme)
+	gitSink.CommitEnabled = true
+	gitSink.AuthorName = "apim-apk-agent"
+	gitSink.AuthorEmail = "apim-apk-agent@wso2.com"
+	return gitSink
+}
+
+func newTestArtifact(apiUUID string, revisionID string, withConfigMap bool) transformer.K8sArtifacts {
+	k8sArtifact := transformer.K8sArtifacts{
+		HTTPRoutes: map[string]*gwapiv1.HTTPRoute{
+			"route-1": {ObjectMeta: metav1.ObjectMeta{Name: "route-1", Namespace: "apk"}},
+		},
+		ConfigMaps: map[string]*corev1.ConfigMap{},
+	}
+	k8sArtifact.API.Name = "api-1"
+	k8sArtifact.API.Namespace = "apk"
+	k8sArtifact.API.Labels = map[string]string{apiUUIDLabel: apiUUID, revisionIDLabel: revisionID}
+	if withConfigMap {
+		k8sArtifact.ConfigMaps["cert-1"] = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cert-1", Namespace: "apk"},
+			Data: map[string]string{"cert": "value"}}
+	}
+	return k8sArtifact
+}
+
+func lastCommitMessage(t *testing.T, path string) string {
+	output, err := exec.Command("git", "-C", path, "log", "-1", "--format=%s").Output()
+	assert.NoError(t, err)
+	return string(output)
+}
+
+func TestGitSinkDeployAPI(t *testing.T) {
+	gitSink := newTestGitSink(t)
+	apiDir := filepath.Join(gitSink.Path, apisDir, "uuid-1")
+
+	assert.NoError(t, gitSink.DeployAPI(newTestArtifact("uuid-1", "1", true)))
+	assert.FileExists(t, filepath.Join(apiDir, "api-api-1.yaml"))
+	assert.FileExists(t, filepath.Join(apiDir, "httproute-route-1.yaml"))
+	content, err := os.ReadFile(filepath.Join(apiDir, "configmap-cert-1.yaml"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "kind: ConfigMap")
+	assert.Contains(t, string(content), "apiVersion: v1")
+	assert.Equal(t, "Deploy API uuid-1 revision 1\n", lastCommitMessage(t, gitSink.Path))
+
+	// The next revision does not have the ConfigMap, hence it should be removed from the tree.
+	assert.NoError(t, gitSink.DeployAPI(newTestArtifact("uuid-1", "2", false)))
+	assert.NoFileExists(t, filepath.Join(apiDir, "configmap-cert-1.yaml"))
+	assert.Equal(t, "Deploy API uuid-1 revision 2\n", lastCommitMessage(t, gitSink.Path))
+
+	// Deploying the same revision again should not create an empty commit.
+	assert.NoError(t, gitSink.DeployAPI(newTestArtifact("uuid-1", "2", false)))
+	assert.Equal(t, "Deploy API uuid-1 revision 2\n", lastCommitMessage(t, gitSink.Path))
+
+	assert.NoError(t, gitSink.UndeployAPI("uuid-1"))
+	assert.NoDirExists(t, apiDir)
+	assert.Equal(t, "Undeploy API uuid-1\n", lastCommitMessage(t, gitSink.Path))
+}
+
+func TestGitSinkPruneAPIs(t *testing.T) {
+	gitSink := newTestGitSink(t)
+
+	assert.NoError(t, gitSink.DeployAPI(newTestArtifact("uuid-1", "1", false)))
+	assert.NoError(t, gitSink.DeployAPI(newTestArtifact("uuid-2", "1", false)))
+	assert.NoError(t, gitSink.PruneAPIs([]string{"uuid-2"}))
+	assert.NoDirExists(t, filepath.Join(gitSink.Path, apisDir, "uuid-1"))
+	assert.DirExists(t, filepath.Join(gitSink.Path, apisDir, "uuid-2"))
+	assert.Equal(t, "Undeploy APIs uuid-1\n", lastCommitMessage(t, gitSink.Path))
+}
+
+func TestGitSinkApplyCROfAPI(t *testing.T) {
+	gitSink := newTestGitSink(t)
+	assert.NoError(t, gitSink.DeployAPI(newTestArtifact("uuid-1", "1", true)))
+
+	// A CR of an API read back from the cluster is rewritten in the directory of the API without the server metadata.
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cert-1", Namespace: "apk", ResourceVersion: "42",
+		UID: "uid-1"}, Data: map[string]string{"cert": "updated"}}
+	assert.NoError(t, gitSink.Apply(configMap))
+	assert.NoFileExists(t, filepath.Join(gitSink.Path, resourcesDir, "configmap", "cert-1.yaml"))
+	content, err := os.ReadFile(filepath.Join(gitSink.Path, apisDir, "uuid-1", "configmap-cert-1.yaml"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "cert: updated")
+	assert.NotContains(t, string(content), "resourceVersion")
+	assert.NotContains(t, string(content), "uid")
+
+	assert.NoError(t, gitSink.Delete(configMap))
+	assert.NoFileExists(t, filepath.Join(gitSink.Path, apisDir, "uuid-1", "configmap-cert-1.yaml"))
+
+	// A CR which does not belong to an API is written to the resources directory.
+	configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config-1", Namespace: "apk"}}
+	assert.NoError(t, gitSink.Apply(configMap))
+	assert.FileExists(t, filepath.Join(gitSink.Path, resourcesDir, "configmap", "config-1.yaml"))
+}
+
+func TestGitSinkDoesNotWriteSecrets(t *testing.T) {
+	gitSink := newTestGitSink(t)
+	k8sArtifact := newTestArtifact("uuid-1", "1", false)
+	k8sArtifact.Secrets = map[string]*corev1.Secret{
+		"backend-1": {ObjectMeta: metav1.ObjectMeta{Name: "backend-1", Namespace: "apk"},
+			StringData: map[string]string{"password": "s3cret"}},
+	}
+
+	assert.NoError(t, gitSink.DeployAPI(k8sArtifact))
+	assert.NoError(t, gitSink.Apply(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret-1", Namespace: "apk"},
+		StringData: map[string]string{"password": "s3cret"}}))
+	err := filepath.WalkDir(gitSink.Path, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "kind: Secret", "Secret written to %s", path)
+		assert.NotContains(t, string(content), "s3cret", "Secret data written to %s", path)
+		return nil
+	})
+	assert.NoError(t, err)
+}
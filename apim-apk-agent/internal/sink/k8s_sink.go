@@ -0,0 +1,168 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package sink
+
+import (
+	"context"
+
+	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
+	dpv1alpha4 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha4"
+	internalk8sClient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/transformer"
+	k8error "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// K8sSink applies the generated CRs to the Kubernetes cluster.
+type K8sSink struct {
+	k8sClient client.Client
+}
+
+// NewK8sSink returns a sink which applies the CRs using the given Kubernetes client.
+func NewK8sSink(k8sClient client.Client) *K8sSink {
+	return &K8sSink{k8sClient: k8sClient}
+}
+
+// DeployAPI applies all the CRs of the given API revision to the Kubernetes cluster.
+func (s *K8sSink) DeployAPI(k8sArtifact transformer.K8sArtifacts) error {
+	for _, configMaps := range k8sArtifact.ConfigMaps {
+		internalk8sClient.DeployConfigMapCR(configMaps, s.k8sClient)
+	}
+	for _, authPolicies := range k8sArtifact.Authentication {
+		internalk8sClient.DeployAuthenticationCR(authPolicies, s.k8sClient)
+	}
+	for _, interceptorServices := range k8sArtifact.InterceptorServices {
+		internalk8sClient.DeployInterceptorServicesCR(interceptorServices, s.k8sClient)
+	}
+	if k8sArtifact.BackendJWT != nil {
+		internalk8sClient.DeployBackendJWTCR(k8sArtifact.BackendJWT, s.k8sClient)
+	}
+	for _, scopes := range k8sArtifact.Scopes {
+		internalk8sClient.DeployScopeCR(scopes, s.k8sClient)
+	}
+	for _, rateLimitPolicy := range k8sArtifact.RateLimitPolicies {
+		internalk8sClient.DeployRateLimitPolicyCR(rateLimitPolicy, s.k8sClient)
+	}
+	for _, aiRateLimitPolicy := range k8sArtifact.AIRateLimitPolicies {
+		internalk8sClient.DeployAIRateLimitPolicyCR(aiRateLimitPolicy, s.k8sClient)
+	}
+	for _, secrets := range k8sArtifact.Secrets {
+		internalk8sClient.DeploySecretCR(secrets, s.k8sClient)
+	}
+	for _, apiPolicies := range k8sArtifact.APIPolicies {
+		internalk8sClient.DeployAPIPolicyCR(apiPolicies, s.k8sClient)
+	}
+	for _, httpRoutes := range k8sArtifact.HTTPRoutes {
+		internalk8sClient.DeployHTTPRouteCR(httpRoutes, s.k8sClient)
+	}
+	for _, gqlRoutes := range k8sArtifact.GQLRoutes {
+		internalk8sClient.DeployGQLRouteCR(gqlRoutes, s.k8sClient)
+	}
+	for _, backends := range k8sArtifact.Backends {
+		internalk8sClient.DeployBackendCR(backends, s.k8sClient)
+	}
+	internalk8sClient.DeployAPICR(&k8sArtifact.API, s.k8sClient)
+	return nil
+}
+
+// UndeployAPI removes the API CRs of the given API from the Kubernetes cluster.
+func (s *K8sSink) UndeployAPI(apiUUID string) error {
+	internalk8sClient.UndeployAPICR(apiUUID, s.k8sClient)
+	return nil
+}
+
+// PruneAPIs removes the API CRs in the Kubernetes cluster which are not in the given list of API UUIDs.
+// System APIs are never removed.
+func (s *K8sSink) PruneAPIs(apiUUIDs []string) error {
+	k8sAPIS, _, err := internalk8sClient.RetrieveAllAPISFromK8s(s.k8sClient, "")
+	if err != nil {
+		logger.LoggerSink.Errorf("Error occurred while fetching APIs from K8s %v", err)
+	}
+	removeApis := make([]dpv1alpha3.API, 0)
+	for _, k8sAPI := range k8sAPIS {
+		found := false
+		for _, api := range apiUUIDs {
+			apiUUID, exist := k8sAPI.ObjectMeta.Labels[apiUUIDLabel]
+			if exist {
+				if apiUUID == api {
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			logger.LoggerSink.Infof("API %s is not found in the control plane. Hence removing it from the K8s", k8sAPI.Name)
+			removeApis = append(removeApis, k8sAPI)
+		}
+	}
+	for _, removeAPI := range removeApis {
+		if !removeAPI.Spec.SystemAPI {
+			logger.LoggerSink.Infof("Undeploying API %s from K8s", removeAPI.Name)
+			internalk8sClient.UndeployK8sAPICR(s.k8sClient, removeAPI)
+		}
+	}
+	return nil
+}
+
+// Apply creates or updates the given CR in the Kubernetes cluster.
+func (s *K8sSink) Apply(obj client.Object) error {
+	switch cr := obj.(type) {
+	case *dpv1alpha4.AIProvider:
+		internalk8sClient.DeployAIProviderCR(cr, s.k8sClient)
+		return nil
+	}
+	existing := obj.DeepCopyObject().(client.Object)
+	if err := s.k8sClient.Get(context.Background(), client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !k8error.IsNotFound(err) {
+			logger.LoggerSink.Errorf("Unable to get CR %s: %v", obj.GetName(), err)
+			return err
+		}
+		if err := s.k8sClient.Create(context.Background(), obj); err != nil {
+			logger.LoggerSink.Errorf("Unable to create CR %s: %v", obj.GetName(), err)
+			return err
+		}
+		logger.LoggerSink.Infof("CR created: %s", obj.GetName())
+		return nil
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	if err := s.k8sClient.Update(context.Background(), obj); err != nil {
+		logger.LoggerSink.Errorf("Unable to update CR %s: %v", obj.GetName(), err)
+		return err
+	}
+	logger.LoggerSink.Infof("CR updated: %s", obj.GetName())
+	return nil
+}
+
+// Delete removes the given CR from the Kubernetes cluster.
+func (s *K8sSink) Delete(obj client.Object) error {
+	switch cr := obj.(type) {
+	case *dpv1alpha4.AIProvider:
+		internalk8sClient.DeleteAIProviderCR(cr.Name, s.k8sClient)
+		return nil
+	case *dpv1alpha3.AIRateLimitPolicy:
+		internalk8sClient.DeleteAIRatelimitPolicy(cr.Name, s.k8sClient)
+		return nil
+	}
+	if err := s.k8sClient.Delete(context.Background(), obj); err != nil && !k8error.IsNotFound(err) {
+		logger.LoggerSink.Errorf("Unable to delete CR %s: %v", obj.GetName(), err)
+		return err
+	}
+	logger.LoggerSink.Infof("CR deleted: %s", obj.GetName())
+	return nil
+}
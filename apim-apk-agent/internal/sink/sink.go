@@ -0,0 +1,84 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+// Package sink contains the destinations to which the CRs generated by the agent are written.
+// The CRs are either applied to the Kubernetes cluster or written to a git working tree
+// which is then deployed by a GitOps tool.
+package sink
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/transformer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// K8sSinkType applies the generated CRs to the Kubernetes cluster
+	K8sSinkType = "k8s"
+	// GitSinkType writes the generated CRs to a git working tree
+	GitSinkType = "git"
+
+	apiUUIDLabel    = "apiUUID"
+	revisionIDLabel = "revisionID"
+)
+
+var (
+	onceGitSinkInit sync.Once
+	gitSink         *GitSink
+)
+
+// Sink is the destination to which the CRs generated by the agent are written.
+type Sink interface {
+	// DeployAPI writes all the CRs of the given API revision.
+	DeployAPI(k8sArtifact transformer.K8sArtifacts) error
+	// UndeployAPI removes all the CRs of the API with the given UUID.
+	UndeployAPI(apiUUID string) error
+	// PruneAPIs removes the APIs which are not in the given list of API UUIDs.
+	PruneAPIs(apiUUIDs []string) error
+	// Apply writes a CR which does not belong to an API.
+	Apply(obj client.Object) error
+	// Delete removes a CR which does not belong to an API.
+	Delete(obj client.Object) error
+}
+
+// GetSink returns the sink configured under dataPlane.sink. The Kubernetes client is used
+// to apply the CRs in the k8s mode and to resolve the kinds of the CRs in the git mode.
+func GetSink(k8sClient client.Client) Sink {
+	conf, errReadConfig := config.ReadConfigs()
+	if errReadConfig != nil {
+		logger.LoggerSink.Errorf("Error reading configs: %v", errReadConfig)
+	}
+	if conf != nil && strings.EqualFold(conf.DataPlane.Sink.Type, GitSinkType) {
+		onceGitSinkInit.Do(func() {
+			gitConf := conf.DataPlane.Sink.Git
+			gitSink = NewGitSink(gitConf.Path, k8sClient.Scheme())
+			gitSink.CommitEnabled = gitConf.CommitEnabled
+			gitSink.AuthorName = gitConf.AuthorName
+			gitSink.AuthorEmail = gitConf.AuthorEmail
+			gitSink.PushEnabled = gitConf.PushEnabled
+			gitSink.Remote = gitConf.Remote
+			gitSink.Branch = gitConf.Branch
+			logger.LoggerSink.Infof("Generated CRs will be written to the git working tree: %s", gitConf.Path)
+		})
+		return gitSink
+	}
+	return NewK8sSink(k8sClient)
+}
@@ -0,0 +1,249 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+// Package snapshot persists the last known good data fetched from the control plane, so that the agent
+// can start with it when the control plane is unreachable, and reconcile once it is reachable again. It also
+// persists the state of the agent which has to survive a restart regardless of the snapshots.
+package snapshot
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+)
+
+const (
+	// APIs is the snapshot of the API archive fetched from the runtime artifacts endpoint
+	APIs = "apis.zip"
+	// KeyManagers is the snapshot of the key managers
+	KeyManagers = "keymanagers.json"
+	// RateLimitPolicies is the snapshot of the API and operation rate limit policies
+	RateLimitPolicies = "ratelimitpolicies.json"
+	// SubscriptionPolicies is the snapshot of the subscription rate limit policies
+	SubscriptionPolicies = "subscriptionpolicies.json"
+	// AIProviders is the snapshot of the AI providers
+	AIProviders = "aiproviders.json"
+	// EventHighWaterMarks is the state of the latest processed control plane notification event of each resource
+	EventHighWaterMarks = "eventhighwatermarks.json"
+	// RevisionAcks is the state of the outbox of the revision deployment acknowledgements not confirmed by the
+	// control plane
+	RevisionAcks = "revisionacks.json"
+
+	jsonExtension = ".json"
+)
+
+var (
+	onceStoreInit sync.Once
+	store         *Store
+
+	onceStateStoreInit sync.Once
+	stateStore         *Store
+
+	// synced holds the names of the snapshots which were saved or loaded since the agent started
+	synced      = make(map[string]bool)
+	syncedMutex sync.Mutex
+)
+
+// Store is a directory which holds one file per snapshot.
+type Store struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewStore returns the store of the snapshots in the given directory.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Save replaces the snapshot with the given name. The content is written to a temporary file which is then
+// renamed, hence a partially written snapshot is never loaded.
+func (s *Store) Save(name string, content []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := os.MkdirAll(s.path, 0755); err != nil {
+		return err
+	}
+	tempFile, err := os.CreateTemp(s.path, "."+name+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err := tempFile.Write(content); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), filepath.Join(s.path, name))
+}
+
+// Load returns the content of the snapshot with the given name. The second return value is false if the
+// snapshot does not exist.
+func (s *Store) Load(name string) ([]byte, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	content, err := os.ReadFile(filepath.Join(s.path, name))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
+
+// Delete removes the snapshot with the given name if it exists.
+func (s *Store) Delete(name string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := os.Remove(filepath.Join(s.path, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// getStore returns the store configured under agent.snapshot, or nil if the snapshots are disabled.
+func getStore() *Store {
+	conf, errReadConfig := config.ReadConfigs()
+	if errReadConfig != nil {
+		logger.LoggerSnapshot.Errorf("Error reading configs: %v", errReadConfig)
+	}
+	if conf == nil || !conf.Agent.Snapshot.Enabled {
+		return nil
+	}
+	onceStoreInit.Do(func() {
+		store = NewStore(conf.Agent.Snapshot.Path)
+		logger.LoggerSnapshot.Infof("The data fetched from the control plane will be persisted to: %s", conf.Agent.Snapshot.Path)
+	})
+	return store
+}
+
+// getStateStore returns the store of the state of the agent configured under agent.state.
+func getStateStore() *Store {
+	onceStateStoreInit.Do(func() {
+		conf, errReadConfig := config.ReadConfigs()
+		if errReadConfig != nil {
+			logger.LoggerSnapshot.Errorf("Error reading configs: %v", errReadConfig)
+		}
+		stateStore = NewStore(conf.Agent.State.Path)
+		logger.LoggerSnapshot.Infof("The state of the agent will be persisted to: %s", conf.Agent.State.Path)
+	})
+	return stateStore
+}
+
+// SaveState persists the state of the agent with the given name. Unlike the snapshots, the state is always persisted.
+func SaveState(name string, content []byte) {
+	if err := getStateStore().Save(name, content); err != nil {
+		logger.LoggerSnapshot.Errorf("Error while persisting the state %s: %v", name, err)
+		return
+	}
+	logger.LoggerSnapshot.Debugf("State %s is persisted", name)
+}
+
+// LoadState returns the persisted state of the agent with the given name, or nil if it is not persisted.
+func LoadState(name string) []byte {
+	content, found, err := getStateStore().Load(name)
+	if err != nil {
+		logger.LoggerSnapshot.Errorf("Error while loading the state %s: %v", name, err)
+		return nil
+	}
+	if found {
+		logger.LoggerSnapshot.Infof("Loading the state %s persisted before the restart", name)
+	}
+	return content
+}
+
+// Save persists the data fetched from the control plane under the given name. It does nothing if the
+// snapshots are disabled.
+func Save(name string, content []byte) {
+	markSynced(name)
+	store := getStore()
+	if store == nil {
+		return
+	}
+	if err := store.Save(name, content); err != nil {
+		logger.LoggerSnapshot.Errorf("Error while persisting the snapshot %s: %v", name, err)
+		return
+	}
+	logger.LoggerSnapshot.Debugf("Snapshot %s is persisted", name)
+}
+
+// Delete removes the persisted data with the given name, when the control plane no longer has any data of it.
+func Delete(name string) {
+	markSynced(name)
+	store := getStore()
+	if store == nil {
+		return
+	}
+	if err := store.Delete(name); err != nil {
+		logger.LoggerSnapshot.Errorf("Error while removing the snapshot %s: %v", name, err)
+	}
+}
+
+// LoadOnce returns the last persisted data with the given name, if it has neither been saved nor loaded since
+// the agent started. Hence the snapshot is only used until the data is fetched from the control plane for the
+// first time, and never overrides the newer data received afterwards. The second return value is false if the
+// snapshot is not used.
+func LoadOnce(name string) ([]byte, bool) {
+	if !markSynced(name) {
+		return nil, false
+	}
+	store := getStore()
+	if store == nil {
+		return nil, false
+	}
+	content, found, err := store.Load(name)
+	if err != nil {
+		logger.LoggerSnapshot.Errorf("Error while loading the snapshot %s: %v", name, err)
+		return nil, false
+	}
+	if !found {
+		logger.LoggerSnapshot.Infof("Snapshot %s is not available", name)
+		return nil, false
+	}
+	logger.LoggerSnapshot.Infof("Loading the snapshot %s persisted before the restart", name)
+	return content, true
+}
+
+// markSynced records that the snapshot with the given name is in sync. It returns false if it was already recorded.
+func markSynced(name string) bool {
+	syncedMutex.Lock()
+	defer syncedMutex.Unlock()
+	if synced[name] {
+		return false
+	}
+	synced[name] = true
+	return true
+}
+
+// IsControlPlaneUnavailable returns true if a control plane request failed with the given status code because the
+// control plane could not be reached or responded with a server error, in which case the snapshot can be loaded.
+// The status code is 0 if no response was received. A client error is not covered, as it is caused by the
+// configuration of the agent, which the snapshot would hide.
+func IsControlPlaneUnavailable(statusCode int) bool {
+	return statusCode == 0 || statusCode >= http.StatusInternalServerError
+}
+
+// EndpointName returns the name of the snapshot of the given control plane internal data endpoint.
+func EndpointName(endpoint string) string {
+	return endpoint + jsonExtension
+}
@@ -0,0 +1,67 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreSaveAndLoad(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "snapshot"))
+
+	_, found, err := store.Load(EndpointName("subscriptions"))
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	assert.NoError(t, store.Save(EndpointName("subscriptions"), []byte(`{"list":[]}`)))
+	assert.NoError(t, store.Save(EndpointName("subscriptions"), []byte(`{"list":[{"subscriptionId":1}]}`)))
+	content, found, err := store.Load(EndpointName("subscriptions"))
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, `{"list":[{"subscriptionId":1}]}`, string(content))
+
+	// The temporary files are not left behind
+	files, err := os.ReadDir(store.path)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "subscriptions.json", files[0].Name())
+
+	assert.NoError(t, store.Delete(EndpointName("subscriptions")))
+	assert.NoError(t, store.Delete(EndpointName("subscriptions")))
+	_, found, err = store.Load(EndpointName("subscriptions"))
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestMarkSynced(t *testing.T) {
+	assert.True(t, markSynced("test.json"))
+	assert.False(t, markSynced("test.json"))
+	_, found := LoadOnce("test.json")
+	assert.False(t, found)
+}
+
+func TestIsControlPlaneUnavailable(t *testing.T) {
+	assert.True(t, IsControlPlaneUnavailable(0))
+	assert.True(t, IsControlPlaneUnavailable(503))
+	assert.False(t, IsControlPlaneUnavailable(401))
+	assert.False(t, IsControlPlaneUnavailable(404))
+}
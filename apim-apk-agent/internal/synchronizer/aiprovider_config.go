@@ -0,0 +1,164 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package synchronizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dpv1alpha4 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha4"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+)
+
+// Attribute names of the metadata of an AI provider configuration
+const (
+	modelAttribute                = "model"
+	requestModelAttribute         = "requestModel"
+	responseModelAttribute        = "responseModel"
+	promptTokenCountAttribute     = "promptTokenCount"
+	completionTokenCountAttribute = "completionTokenCount"
+	totalTokenCountAttribute      = "totalTokenCount"
+)
+
+// aiProviderModelDetails holds the details of the AIProvider CR which are resolved from the configuration of
+// the AI provider.
+type aiProviderModelDetails struct {
+	supportedModels []string
+	requestModel    dpv1alpha4.ValueDetails
+	responseModel   dpv1alpha4.ValueDetails
+	rateLimitFields dpv1alpha4.RateLimitFields
+}
+
+// resolveAIProviderConfig returns the configuration of the AI provider received from the control plane, overridden
+// by the override file of the provider in the given directory, if there is one.
+func resolveAIProviderConfig(aiProvider *eventhubTypes.AIProvider, overridesPath string) (eventhubTypes.Config, error) {
+	var providerConfig eventhubTypes.Config
+	if err := json.Unmarshal([]byte(aiProvider.Configurations), &providerConfig); err != nil {
+		return providerConfig, fmt.Errorf("invalid configurations of the AI provider %s:%s: %v",
+			aiProvider.Name, aiProvider.APIVersion, err)
+	}
+	override, overrideFile, err := loadAIProviderOverride(aiProvider, overridesPath)
+	if err != nil {
+		return providerConfig, err
+	}
+	if override != nil {
+		logger.LoggerSynchronizer.Infof("Configurations of the AI provider %s:%s are overridden by %s",
+			aiProvider.Name, aiProvider.APIVersion, overrideFile)
+		providerConfig = mergeAIProviderConfig(providerConfig, *override)
+	}
+	return providerConfig, nil
+}
+
+// loadAIProviderOverride reads the override file of the AI provider. The file specific to the API version takes
+// precedence over the one of the provider name.
+func loadAIProviderOverride(aiProvider *eventhubTypes.AIProvider, overridesPath string) (*eventhubTypes.Config, string, error) {
+	if overridesPath == "" {
+		return nil, "", nil
+	}
+	for _, fileName := range []string{aiProvider.Name + "-" + aiProvider.APIVersion + ".json", aiProvider.Name + ".json"} {
+		overrideFile := filepath.Join(overridesPath, filepath.Base(fileName))
+		content, err := os.ReadFile(overrideFile)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, overrideFile, fmt.Errorf("unable to read the AI provider override file %s: %v", overrideFile, err)
+		}
+		var override eventhubTypes.Config
+		if err := json.Unmarshal(content, &override); err != nil {
+			return nil, overrideFile, fmt.Errorf("invalid AI provider override file %s: %v", overrideFile, err)
+		}
+		return &override, overrideFile, nil
+	}
+	return nil, "", nil
+}
+
+// mergeAIProviderConfig overrides the configuration with the attributes set in the override. The metadata
+// fields are overridden by their attribute names.
+func mergeAIProviderConfig(providerConfig eventhubTypes.Config, override eventhubTypes.Config) eventhubTypes.Config {
+	merged := providerConfig
+	if override.AuthHeader != "" {
+		merged.AuthHeader = override.AuthHeader
+	}
+	if len(override.Models) > 0 {
+		merged.Models = override.Models
+	}
+	merged.Metadata = append([]eventhubTypes.Fields{}, providerConfig.Metadata...)
+	for _, overrideField := range override.Metadata {
+		overridden := false
+		for i, field := range merged.Metadata {
+			if field.AttributeName == overrideField.AttributeName {
+				merged.Metadata[i] = overrideField
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged.Metadata = append(merged.Metadata, overrideField)
+		}
+	}
+	if len(override.Additional) > 0 {
+		merged.Additional = make(map[string]json.RawMessage, len(providerConfig.Additional)+len(override.Additional))
+		for name, value := range providerConfig.Additional {
+			merged.Additional[name] = value
+		}
+		for name, value := range override.Additional {
+			merged.Additional[name] = value
+		}
+	}
+	return merged
+}
+
+// getAIProviderModelDetails resolves the supported models, the model extraction and the token count sources from
+// the configuration. The "model" attribute is used for both the request and the response models, unless the
+// "requestModel" or "responseModel" attribute is set.
+func getAIProviderModelDetails(providerConfig eventhubTypes.Config) aiProviderModelDetails {
+	details := aiProviderModelDetails{supportedModels: providerConfig.Models}
+	if details.supportedModels == nil {
+		details.supportedModels = []string{}
+	}
+	var requestModel, responseModel *dpv1alpha4.ValueDetails
+	for _, field := range providerConfig.Metadata {
+		valueDetails := dpv1alpha4.ValueDetails{In: field.InputSource, Value: field.AttributeIdentifier}
+		switch field.AttributeName {
+		case modelAttribute:
+			details.requestModel = valueDetails
+			details.responseModel = valueDetails
+		case requestModelAttribute:
+			requestModel = &valueDetails
+		case responseModelAttribute:
+			responseModel = &valueDetails
+		case promptTokenCountAttribute:
+			details.rateLimitFields.PromptTokens = valueDetails
+		case completionTokenCountAttribute:
+			details.rateLimitFields.CompletionToken = valueDetails
+		case totalTokenCountAttribute:
+			details.rateLimitFields.TotalToken = valueDetails
+		}
+	}
+	if requestModel != nil {
+		details.requestModel = *requestModel
+	}
+	if responseModel != nil {
+		details.responseModel = *responseModel
+	}
+	return details
+}
@@ -0,0 +1,81 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package synchronizer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	dpv1alpha4 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha4"
+	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+)
+
+const testAIProviderConfigurations = `{"connectorType":"openAi","authHeader":"Authorization","models":["gpt-4o","gpt-4o-mini"],
+	"metadata":[{"attributeName":"model","inputSource":"payload","attributeIdentifier":"$.model","required":true},
+	{"attributeName":"promptTokenCount","inputSource":"payload","attributeIdentifier":"$.usage.prompt_tokens"},
+	{"attributeName":"completionTokenCount","inputSource":"payload","attributeIdentifier":"$.usage.completion_tokens"},
+	{"attributeName":"totalTokenCount","inputSource":"payload","attributeIdentifier":"$.usage.total_tokens"}]}`
+
+func TestResolveAIProviderConfig(t *testing.T) {
+	aiProvider := &eventhubTypes.AIProvider{Name: "OpenAI", APIVersion: "v1", Configurations: testAIProviderConfigurations}
+
+	providerConfig, err := resolveAIProviderConfig(aiProvider, t.TempDir())
+	assert.NoError(t, err)
+	details := getAIProviderModelDetails(providerConfig)
+	assert.Equal(t, []string{"gpt-4o", "gpt-4o-mini"}, details.supportedModels)
+	assert.Equal(t, dpv1alpha4.ValueDetails{In: "payload", Value: "$.model"}, details.requestModel)
+	assert.Equal(t, dpv1alpha4.ValueDetails{In: "payload", Value: "$.model"}, details.responseModel)
+	assert.Equal(t, dpv1alpha4.ValueDetails{In: "payload", Value: "$.usage.total_tokens"}, details.rateLimitFields.TotalToken)
+
+	// The unknown attributes are preserved
+	content, err := json.Marshal(providerConfig)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `"connectorType":"openAi"`)
+	assert.Contains(t, string(content), `"required":true`)
+
+	overridesPath := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(overridesPath, "OpenAI.json"), []byte(`{"models":["gpt-4.1"],
+		"metadata":[{"attributeName":"responseModel","inputSource":"header","attributeIdentifier":"x-model"}]}`), 0600))
+	providerConfig, err = resolveAIProviderConfig(aiProvider, overridesPath)
+	assert.NoError(t, err)
+	details = getAIProviderModelDetails(providerConfig)
+	assert.Equal(t, []string{"gpt-4.1"}, details.supportedModels)
+	assert.Equal(t, dpv1alpha4.ValueDetails{In: "payload", Value: "$.model"}, details.requestModel)
+	assert.Equal(t, dpv1alpha4.ValueDetails{In: "header", Value: "x-model"}, details.responseModel)
+
+	// The override file of the API version takes precedence
+	assert.NoError(t, os.WriteFile(filepath.Join(overridesPath, "OpenAI-v1.json"), []byte(`{"models":["gpt-5"]}`), 0600))
+	providerConfig, err = resolveAIProviderConfig(aiProvider, overridesPath)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gpt-5"}, getAIProviderModelDetails(providerConfig).supportedModels)
+}
+
+func TestResolveAIProviderConfigRejectsInvalidConfigurations(t *testing.T) {
+	_, err := resolveAIProviderConfig(&eventhubTypes.AIProvider{Name: "OpenAI", Configurations: "{invalid"}, "")
+	assert.Error(t, err)
+	_, err = resolveAIProviderConfig(&eventhubTypes.AIProvider{Name: "OpenAI", Configurations: ""}, "")
+	assert.Error(t, err)
+
+	overridesPath := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(overridesPath, "OpenAI.json"), []byte(`{"models":`), 0600))
+	_, err = resolveAIProviderConfig(&eventhubTypes.AIProvider{Name: "OpenAI", Configurations: testAIProviderConfigurations}, overridesPath)
+	assert.Error(t, err)
+}
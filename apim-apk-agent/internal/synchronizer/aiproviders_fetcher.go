@@ -31,16 +31,20 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	dpv1alpha4 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha4"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
 	k8sclient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
 	pkgAuth "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/auth"
 	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
 	sync "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/synchronizer"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -120,7 +124,7 @@ func FetchAIProvidersOnEvent(aiProviderName string, aiProviderVersion string, or
 	var errorMsg string
 	if err != nil {
 		errorMsg = "Error occurred while calling the REST API: " + aiProviderEndpoint
-		go retryRLPFetchData(conf, errorMsg, err, c)
+		go retryAIProvidersFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 	responseBytes, err := io.ReadAll(resp.Body)
@@ -128,61 +132,108 @@ func FetchAIProvidersOnEvent(aiProviderName string, aiProviderVersion string, or
 
 	if err != nil {
 		errorMsg = "Error occurred while reading the response received for: " + aiProviderEndpoint
-		go retryRLPFetchData(conf, errorMsg, err, c)
+		go retryAIProvidersFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var aiProviderList eventhubTypes.AIProviderList
-		err := json.Unmarshal(responseBytes, &aiProviderList)
-		if err != nil {
+		if err := applyAIProviders(responseBytes, c, cleanupDeletedProviders); err != nil {
 			logger.LoggerSynchronizer.Errorf("Error occurred while unmarshelling AI Provider event data %v", err)
 			return
 		}
-		logger.LoggerSynchronizer.Debugf("AI Providers received: %v", aiProviderList.AIProviders)
-		var aiProviders []eventhubTypes.AIProvider = aiProviderList.AIProviders
+		if len(queryParamMap) == 0 {
+			snapshot.Save(snapshot.AIProviders, responseBytes)
+		}
+	} else {
+		errorMsg = "Failed to fetch data! " + aiProviderEndpoint + " responded with " +
+			strconv.Itoa(resp.StatusCode)
+		go retryAIProvidersFetchData(conf, errorMsg, err, resp.StatusCode, c)
+	}
 
-		if cleanupDeletedProviders {
-			aiProvidersFromK8, _, errK8 := k8sclient.RetrieveAllAIProvidersFromK8s(c, "")
-			if errK8 == nil {
-				for _, aiP := range aiProvidersFromK8 {
-					if cpName, exists := aiP.ObjectMeta.Labels["CPName"]; exists {
-						found := false
-						for _, aiProviderFromCP := range aiProviders {
-							if aiProviderFromCP.Name == cpName {
-								found = true
-								break
-							}
-						}
-						if !found {
-							// Delete the airatelimitpolicy
-							k8sclient.DeleteAIProviderCR(aiP.Name, c)
+}
+
+// applyAIProviders adds the AI providers in the response of the control plane to the internal map and deploys
+// the AI provider CRs. The CRs of the deleted providers are removed if cleanupDeletedProviders is true.
+func applyAIProviders(responseBytes []byte, c client.Client, cleanupDeletedProviders bool) error {
+	var aiProviderList eventhubTypes.AIProviderList
+	err := json.Unmarshal(responseBytes, &aiProviderList)
+	if err != nil {
+		return err
+	}
+	logger.LoggerSynchronizer.Debugf("AI Providers received: %v", aiProviderList.AIProviders)
+	var aiProviders []eventhubTypes.AIProvider
+	for _, aiProvider := range aiProviderList.AIProviders {
+		if utils.IsOrganizationAllowed(aiProvider.Organization) {
+			aiProviders = append(aiProviders, aiProvider)
+		} else {
+			logger.LoggerSynchronizer.Debugf("AI Provider %s is skipped since the organization %s is not served by the agent", aiProvider.Name, aiProvider.Organization)
+		}
+	}
+
+	if cleanupDeletedProviders {
+		aiProvidersFromK8, _, errK8 := k8sclient.RetrieveAllAIProvidersFromK8s(c, "")
+		if errK8 == nil {
+			for _, aiP := range aiProvidersFromK8 {
+				if cpName, exists := aiP.ObjectMeta.Labels["CPName"]; exists {
+					found := false
+					for _, aiProviderFromCP := range aiProviders {
+						if aiProviderFromCP.Name == cpName {
+							found = true
+							break
 						}
 					}
+					if !found {
+						// Delete the airatelimitpolicy
+						sink.GetSink(c).Delete(&aiP)
+					}
 				}
-			} else {
-				logger.LoggerSynchronizer.Errorf("Error while fetching aiproviders for cleaning up outdataed crs. Error: %+v", errK8)
 			}
+		} else {
+			logger.LoggerSynchronizer.Errorf("Error while fetching aiproviders for cleaning up outdataed crs. Error: %+v", errK8)
 		}
-		for _, aiProvider := range aiProviders {
-			managementserver.AddAIProvider(aiProvider)
-			logger.LoggerSynchronizer.Debugf("AI Provider added to internal map: %v", aiProvider)
-			// Generate the AI Provider CR
-			crAIProvider := createAIProvider(&aiProvider)
-			// Deploy the AI Provider CR
-			k8sclient.DeployAIProviderCR(&crAIProvider, c)
-			logger.LoggerSynchronizer.Infof("AI Provider CR Deployed Successfully: %v", crAIProvider)
+	}
+	for _, aiProvider := range aiProviders {
+		// Generate the AI Provider CR
+		crAIProvider, err := createAIProvider(&aiProvider)
+		if err != nil {
+			logger.LoggerSynchronizer.Errorf("AI Provider %s:%s of the organization %s is rejected: %v",
+				aiProvider.Name, aiProvider.APIVersion, aiProvider.Organization, err)
+			continue
 		}
-	} else {
-		errorMsg = "Failed to fetch data! " + aiProviderEndpoint + " responded with " +
-			strconv.Itoa(resp.StatusCode)
-		go retryRLPFetchData(conf, errorMsg, err, c)
+		managementserver.AddAIProvider(aiProvider)
+		logger.LoggerSynchronizer.Debugf("AI Provider added to internal map: %v", aiProvider)
+		// Deploy the AI Provider CR
+		sink.GetSink(c).Apply(&crAIProvider)
+		logger.LoggerSynchronizer.Infof("AI Provider CR Deployed Successfully: %v", crAIProvider)
 	}
+	return nil
+}
 
+// retryAIProvidersFetchData fetches all the AI providers again after the retry interval. The AI providers are
+// loaded from the snapshot meanwhile, if they have not been fetched since the startup.
+func retryAIProvidersFetchData(conf *config.Config, errorMessage string, err error, statusCode int, c client.Client) {
+	// The snapshot is loaded only when the control plane is unavailable, not when it rejects the request
+	if snapshot.IsControlPlaneUnavailable(statusCode) {
+		if content, found := snapshot.LoadOnce(snapshot.AIProviders); found {
+			if err := applyAIProviders(content, c, false); err != nil {
+				logger.LoggerSynchronizer.Errorf("Error occurred while loading the AI Providers from the snapshot %v", err)
+			}
+		}
+	}
+	logger.LoggerSynchronizer.Debugf("Time Duration for retrying: %v",
+		conf.ControlPlane.RetryInterval*time.Second)
+	time.Sleep(conf.ControlPlane.RetryInterval * time.Second)
+	FetchAIProvidersOnEvent("", "", "", c, false)
+	retryAttempt++
+	if retryAttempt >= retryCount {
+		logger.LoggerSynchronizer.Errorf(errorMessage, err)
+		return
+	}
 }
 
-// createAIProvider creates the AI provider CR
-func createAIProvider(aiProvider *eventhubTypes.AIProvider) dpv1alpha4.AIProvider {
+// createAIProvider creates the AI provider CR. An error is returned if the configurations of the AI provider
+// can not be resolved.
+func createAIProvider(aiProvider *eventhubTypes.AIProvider) (dpv1alpha4.AIProvider, error) {
 	conf, _ := config.ReadConfigs()
 	sha1ValueofAIProviderName := GetSha1Value(aiProvider.Name)
 	sha1ValueOfOrganization := GetSha1Value(aiProvider.Organization)
@@ -191,35 +242,14 @@ func createAIProvider(aiProvider *eventhubTypes.AIProvider) dpv1alpha4.AIProvide
 		"InitiateFrom": "CP",
 		"CPName":       aiProvider.Name,
 	}
-	var modelInputSource string
-	var modelAttributeIdentifier string
-	var promptTokenCountInputSource string
-	var promptTokenCountAttributeIdentifier string
-	var completionTokenCountInputSource string
-	var completionTokenCountAttributeIdentifier string
-	var totalTokenCountInputSource string
-	var totalTokenCountAttributeIdentifier string
-
-	var config eventhubTypes.Config
-	err := json.Unmarshal([]byte(aiProvider.Configurations), &config)
+	providerConfig, err := resolveAIProviderConfig(aiProvider, conf.DataPlane.AIProvider.OverridesPath)
 	if err != nil {
-		logger.LoggerSynchronizer.Errorf("Error unmarshalling configurations metadata in AI Provider: %v", err)
+		return dpv1alpha4.AIProvider{}, err
 	}
-
-	for _, field := range config.Metadata {
-		if field.AttributeName == "model" {
-			modelInputSource = field.InputSource
-			modelAttributeIdentifier = field.AttributeIdentifier
-		} else if field.AttributeName == "promptTokenCount" {
-			promptTokenCountInputSource = field.InputSource
-			promptTokenCountAttributeIdentifier = field.AttributeIdentifier
-		} else if field.AttributeName == "completionTokenCount" {
-			completionTokenCountInputSource = field.InputSource
-			completionTokenCountAttributeIdentifier = field.AttributeIdentifier
-		} else if field.AttributeName == "totalTokenCount" {
-			totalTokenCountInputSource = field.InputSource
-			totalTokenCountAttributeIdentifier = field.AttributeIdentifier
-		}
+	modelDetails := getAIProviderModelDetails(providerConfig)
+	if len(modelDetails.supportedModels) == 0 {
+		logger.LoggerSynchronizer.Warnf("No supported models are configured for the AI Provider %s:%s",
+			aiProvider.Name, aiProvider.APIVersion)
 	}
 
 	crAIProvider := dpv1alpha4.AIProvider{
@@ -232,32 +262,13 @@ func createAIProvider(aiProvider *eventhubTypes.AIProvider) dpv1alpha4.AIProvide
 			ProviderName:       aiProvider.Name,
 			ProviderAPIVersion: aiProvider.APIVersion,
 			Organization:       aiProvider.Organization,
-			RequestModel: dpv1alpha4.ValueDetails{
-				In:    modelInputSource,
-				Value: modelAttributeIdentifier,
-			},
-			ResponseModel: dpv1alpha4.ValueDetails{
-				In:    modelInputSource,
-				Value: modelAttributeIdentifier,
-			},
-			SupportedModels: []string{"gtp-4.5", "gtp-4o", "gtp-3.5"},
-			RateLimitFields: dpv1alpha4.RateLimitFields{
-				PromptTokens: dpv1alpha4.ValueDetails{
-					In:    promptTokenCountInputSource,
-					Value: promptTokenCountAttributeIdentifier,
-				},
-				CompletionToken: dpv1alpha4.ValueDetails{
-					In:    completionTokenCountInputSource,
-					Value: completionTokenCountAttributeIdentifier,
-				},
-				TotalToken: dpv1alpha4.ValueDetails{
-					In:    totalTokenCountInputSource,
-					Value: totalTokenCountAttributeIdentifier,
-				},
-			},
+			RequestModel:       modelDetails.requestModel,
+			ResponseModel:      modelDetails.responseModel,
+			SupportedModels:    modelDetails.supportedModels,
+			RateLimitFields:    modelDetails.rateLimitFields,
 		},
 	}
-	return crAIProvider
+	return crAIProvider, nil
 }
 
 // GetSha1Value returns the SHA1 value of the input string
@@ -38,10 +38,14 @@ import (
 	k8sclient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/logging"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
 	pkgAuth "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/auth"
 	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
 	sync "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/synchronizer"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -111,34 +115,75 @@ func FetchKeyManagersOnStartUp(c client.Client) {
 	var errorMsg string
 	if err != nil {
 		errorMsg = "Error occurred while calling the REST API: " + keyManagersEndpoint
-		go retryFetchData(conf, errorMsg, err, c)
+		go retryFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 	responseBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		errorMsg = "Error occurred while reading the response received for: " + keyManagersEndpoint
-		go retryFetchData(conf, errorMsg, err, c)
+		go retryFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var keyManagers []eventhubTypes.KeyManager
-		err := json.Unmarshal(responseBytes, &keyManagers)
-		if err != nil {
+		if err := applyKeyManagers(responseBytes, c); err != nil {
 			logger.LoggerSynchronizer.Errorf("Error occurred while unmarshelling Key Managers event data %v", err)
 			return
 		}
-		logger.LoggerSynchronizer.Infof("Key Managers received: %v", keyManagers)
-		resolvedKeyManagers := eventhub.MarshalKeyManagers(&keyManagers)
-		applyAllKeymanagerConfifuration(c, resolvedKeyManagers)
+		snapshot.Save(snapshot.KeyManagers, responseBytes)
 	} else {
 		errorMsg = "Failed to fetch data! " + keyManagersEndpoint + " responded with " +
 			strconv.Itoa(resp.StatusCode)
-		go retryFetchData(conf, errorMsg, err, c)
+		go retryFetchData(conf, errorMsg, err, resp.StatusCode, c)
 	}
 }
 
-func retryFetchData(conf *config.Config, errorMessage string, err error, c client.Client) {
+// applyKeyManagers adds the key managers in the response of the control plane to the internal map and applies
+// the token issuer CRs of them.
+func applyKeyManagers(responseBytes []byte, c client.Client) error {
+	var keyManagers []eventhubTypes.KeyManager
+	err := json.Unmarshal(responseBytes, &keyManagers)
+	if err != nil {
+		return err
+	}
+	logger.LoggerSynchronizer.Infof("Key Managers received: %v", keyManagers)
+	allowedKeyManagers := make([]eventhubTypes.KeyManager, 0, len(keyManagers))
+	for _, keyManager := range keyManagers {
+		if utils.IsOrganizationAllowed(keyManager.Organization) {
+			allowedKeyManagers = append(allowedKeyManagers, keyManager)
+		} else {
+			logger.LoggerSynchronizer.Debugf("Key Manager %s is skipped since the organization %s is not served by the agent", keyManager.Name, keyManager.Organization)
+		}
+	}
+	resolvedKeyManagers := make([]eventhubTypes.ResolvedKeyManager, 0, len(allowedKeyManagers))
+	tokenIssuers := make([]*dpv1alpha2.TokenIssuer, 0, len(allowedKeyManagers))
+	rejectedKeyManagers := make(map[string]bool)
+	for _, resolvedKeyManager := range eventhub.MarshalKeyManagers(&allowedKeyManagers) {
+		keyManagerTokenIssuers, err := k8sclient.NewTokenIssuerCRs(resolvedKeyManager)
+		if err != nil {
+			logger.LoggerSynchronizer.Errorf("Key Manager %s is rejected: %v", resolvedKeyManager.Name, err)
+			rejectedKeyManagers[resolvedKeyManager.UUID] = true
+			continue
+		}
+		resolvedKeyManagers = append(resolvedKeyManagers, resolvedKeyManager)
+		tokenIssuers = append(tokenIssuers, keyManagerTokenIssuers...)
+	}
+	managementserver.AddAllKeyManagers(resolvedKeyManagers)
+	if err := applyAllKeymanagerConfifuration(c, tokenIssuers, rejectedKeyManagers); err != nil {
+		logger.LoggerSynchronizer.Errorf("Error occurred while applying the TokenIssuers of the Key Managers %v", err)
+	}
+	return nil
+}
+
+func retryFetchData(conf *config.Config, errorMessage string, err error, statusCode int, c client.Client) {
+	// The snapshot is loaded only when the control plane is unavailable, not when it rejects the request
+	if snapshot.IsControlPlaneUnavailable(statusCode) {
+		if content, found := snapshot.LoadOnce(snapshot.KeyManagers); found {
+			if err := applyKeyManagers(content, c); err != nil {
+				logger.LoggerSynchronizer.Errorf("Error occurred while loading the Key Managers from the snapshot %v", err)
+			}
+		}
+	}
 	logger.LoggerSynchronizer.Debugf("Time Duration for retrying: %v",
 		conf.ControlPlane.RetryInterval*time.Second)
 	time.Sleep(conf.ControlPlane.RetryInterval * time.Second)
@@ -149,58 +194,60 @@ func retryFetchData(conf *config.Config, errorMessage string, err error, c clien
 		return
 	}
 }
-func applyAllKeymanagerConfifuration(c client.Client, resolvedKeyManagers []eventhubTypes.ResolvedKeyManager) error {
+
+// applyAllKeymanagerConfifuration applies the given TokenIssuer CRs and deletes the TokenIssuer CRs of the key managers
+// which are removed from the control plane. The TokenIssuer CR of a rejected key manager is kept, hence the tokens
+// issued by it are accepted with the last valid configuration until it is fixed.
+func applyAllKeymanagerConfifuration(c client.Client, tokenIssuers []*dpv1alpha2.TokenIssuer, rejectedKeyManagers map[string]bool) error {
 	tokenIssuersFromK8s, _, err := retrieveAllTokenIssuers(c, "")
 	if err != nil {
 		return err
 	}
-	clonedTokenIssuerListFromK8s := make([]dpv1alpha2.TokenIssuer, len(tokenIssuersFromK8s))
-	copy(clonedTokenIssuerListFromK8s, tokenIssuersFromK8s)
-	clonedTokenIssuers := make([]eventhubTypes.ResolvedKeyManager, len(resolvedKeyManagers))
-	copy(clonedTokenIssuers, resolvedKeyManagers)
-	newTokenissuers := make([]eventhubTypes.ResolvedKeyManager, 0)
-	sameTokenissuers := make([]eventhubTypes.ResolvedKeyManager, 0)
-	for _, tokenIssuer := range clonedTokenIssuers {
-		found := false
-		unFilteredTokenIssuersFRomK8s := make([]dpv1alpha2.TokenIssuer, 0)
-		for _, tokenIssuersFromK8s := range clonedTokenIssuerListFromK8s {
-			if tokenIssuer.UUID == tokenIssuersFromK8s.Name {
-				sameTokenissuers = append(sameTokenissuers, tokenIssuer)
-				found = true
-				break
-			}
-			unFilteredTokenIssuersFRomK8s = append(unFilteredTokenIssuersFRomK8s, tokenIssuersFromK8s)
-		}
-		clonedTokenIssuerListFromK8s = unFilteredTokenIssuersFRomK8s
-		if !found {
-			newTokenissuers = append(newTokenissuers, tokenIssuer)
-		}
+	if err := ApplyTokenIssuers(tokenIssuers, c); err != nil {
+		return err
 	}
-	for _, tokenIssuer := range newTokenissuers {
-		err := k8sclient.CreateAndUpdateTokenIssuersCR(tokenIssuer, c)
-		if err != nil {
+	appliedTokenIssuers := make(map[string]bool, len(tokenIssuers))
+	for _, tokenIssuer := range tokenIssuers {
+		appliedTokenIssuers[tokenIssuer.Name] = true
+		logger.LoggerSynchronizer.Debugf("Token Issuer applied: %v", tokenIssuer.Name)
+	}
+	for i, tokenIssuer := range tokenIssuersFromK8s {
+		if appliedTokenIssuers[tokenIssuer.Name] || rejectedKeyManagers[tokenIssuer.Name] ||
+			k8sclient.IsInternalKeyTokenIssuer(tokenIssuer.Name) {
+			continue
+		}
+		if err := sink.GetSink(c).Delete(&tokenIssuersFromK8s[i]); err != nil {
 			return err
 		}
-		logger.LoggerSynchronizer.Debugf("Token Issuer created: %v", tokenIssuer)
-
+		logger.LoggerSynchronizer.Debugf("Token Issuer deleted: %v", tokenIssuer.Name)
 	}
-	for _, tokenIssuer := range sameTokenissuers {
-		err := k8sclient.UpdateTokenIssuersCR(tokenIssuer, c)
-		if err != nil {
+	return nil
+}
+
+// ApplyTokenIssuers writes the given TokenIssuer CRs to the configured sink.
+func ApplyTokenIssuers(tokenIssuers []*dpv1alpha2.TokenIssuer, c client.Client) error {
+	for _, tokenIssuer := range tokenIssuers {
+		if err := sink.GetSink(c).Apply(tokenIssuer); err != nil {
 			return err
 		}
-		logger.LoggerSynchronizer.Debugf("Token Issuer updated: %v", tokenIssuer)
 	}
-	logger.LoggerSynchronizer.Debugf("Deleted Token Issuers from K8s: %v", clonedTokenIssuerListFromK8s)
-	for _, tokenissuer := range clonedTokenIssuerListFromK8s {
-		err := k8sclient.DeleteTokenIssuerCR(c, tokenissuer)
-		if err != nil {
+	return nil
+}
+
+// DeleteTokenIssuers removes the TokenIssuer CRs of the given key manager from the configured sink.
+func DeleteTokenIssuers(keyManagerName string, tenantDomain string, c client.Client) error {
+	tokenIssuers, err := k8sclient.RetrieveTokenIssuersOfKeyManager(c, keyManagerName, tenantDomain)
+	if err != nil {
+		return err
+	}
+	for i := range tokenIssuers {
+		if err := sink.GetSink(c).Delete(&tokenIssuers[i]); err != nil {
 			return err
 		}
-		logger.LoggerSynchronizer.Debugf("Token Issuer deleted: %v", tokenissuer)
 	}
 	return nil
 }
+
 func retrieveAllTokenIssuers(c client.Client, nextToken string) ([]dpv1alpha2.TokenIssuer, string, error) {
 	conf, _ := config.ReadConfigs()
 	tokenIssuerList := dpv1alpha2.TokenIssuerList{}
@@ -34,11 +34,14 @@ import (
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
 	k8sclient "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
 	pkgAuth "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/auth"
 	eventhubTypes "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
 	sync "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/synchronizer"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -119,7 +122,7 @@ func FetchRateLimitPoliciesOnEvent(ratelimitName string, organization string, c
 	var errorMsg string
 	if err != nil {
 		errorMsg = "Error occurred while calling the REST API: " + policiesEndpoint
-		go retryRLPFetchData(conf, errorMsg, err, c)
+		go retryRLPFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 	responseBytes, err := ioutil.ReadAll(resp.Body)
@@ -127,37 +130,22 @@ func FetchRateLimitPoliciesOnEvent(ratelimitName string, organization string, c
 
 	if err != nil {
 		errorMsg = "Error occurred while reading the response received for: " + policiesEndpoint
-		go retryRLPFetchData(conf, errorMsg, err, c)
+		go retryRLPFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var rateLimitPolicyList eventhubTypes.RateLimitPolicyList
-		err := json.Unmarshal(responseBytes, &rateLimitPolicyList)
-		if err != nil {
+		if err := applyRateLimitPolicies(responseBytes, c); err != nil {
 			logger.LoggerSynchronizer.Errorf("Error occurred while unmarshelling RateLimit Policies event data %v", err)
 			return
 		}
-		logger.LoggerSynchronizer.Debugf("Policies received: %v", rateLimitPolicyList.List)
-		var rateLimitPolicies []eventhubTypes.RateLimitPolicy = rateLimitPolicyList.List
-		for _, policy := range rateLimitPolicies {
-			if policy.DefaultLimit.RequestCount.TimeUnit == "min" {
-				policy.DefaultLimit.RequestCount.TimeUnit = "Minute"
-			} else if policy.DefaultLimit.RequestCount.TimeUnit == "hour" {
-				policy.DefaultLimit.RequestCount.TimeUnit = "Hour"
-			} else if policy.DefaultLimit.RequestCount.TimeUnit == "day" {
-				policy.DefaultLimit.RequestCount.TimeUnit = "Day"
-			}
-			managementserver.AddRateLimitPolicy(policy)
-			logger.LoggerSynchronizer.Infof("RateLimit Policy added to internal map: %v", policy)
-			// Update the exisitng rate limit policies with current policy
-			k8sclient.UpdateRateLimitPolicyCR(policy, c)
-
+		if ratelimitName == "" && organization == "" {
+			snapshot.Save(snapshot.RateLimitPolicies, responseBytes)
 		}
 	} else {
 		errorMsg = "Failed to fetch data! " + policiesEndpoint + " responded with " +
 			strconv.Itoa(resp.StatusCode)
-		go retryRLPFetchData(conf, errorMsg, err, c)
+		go retryRLPFetchData(conf, errorMsg, err, resp.StatusCode, c)
 	}
 }
 
@@ -221,7 +209,7 @@ func FetchSubscriptionRateLimitPoliciesOnEvent(ratelimitName string, organizatio
 	var errorMsg string
 	if err != nil {
 		errorMsg = "Error occurred while calling the REST API: " + policiesEndpoint
-		go retrySubscriptionRLPFetchData(conf, errorMsg, err, c)
+		go retrySubscriptionRLPFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 	responseBytes, err := ioutil.ReadAll(resp.Body)
@@ -229,115 +217,34 @@ func FetchSubscriptionRateLimitPoliciesOnEvent(ratelimitName string, organizatio
 
 	if err != nil {
 		errorMsg = "Error occurred while reading the response received for: " + policiesEndpoint
-		go retrySubscriptionRLPFetchData(conf, errorMsg, err, c)
+		go retrySubscriptionRLPFetchData(conf, errorMsg, err, 0, c)
 		return
 	}
 
 	if resp.StatusCode == http.StatusOK {
-		var rateLimitPolicyList eventhubTypes.SubscriptionPolicyList
-		err := json.Unmarshal(responseBytes, &rateLimitPolicyList)
-		if err != nil {
+		if err := applySubscriptionRateLimitPolicies(responseBytes, c, cleanupDeletedPolicies); err != nil {
 			logger.LoggerSynchronizer.Errorf("Error occurred while unmarshelling Subscription RateLimit Policies event data %v", err)
 			return
 		}
-		logger.LoggerSynchronizer.Debugf("Policies received: %v", rateLimitPolicyList.List)
-		var rateLimitPolicies []eventhubTypes.SubscriptionPolicy = rateLimitPolicyList.List
-		if cleanupDeletedPolicies {
-			// This logic is executed once at the startup time so no need to worry about the nested for loops for performance.
-			// Fetch all AiRatelimitPolicies
-			airls, _, retrieveAllAIRLErr := k8sclient.RetrieveAllAIRatelimitPoliciesSFromK8s(c, "")
-			rls, _, retrieveAllRLErr := k8sclient.RetrieveAllRatelimitPoliciesSFromK8s(c, "")
-			if retrieveAllAIRLErr == nil {
-				for _, airl := range airls {
-					if cpName, exists := airl.ObjectMeta.Labels["CPName"]; exists {
-						found := false
-						for _, policy := range rateLimitPolicies {
-							if policy.Name == cpName {
-								found = true
-								break
-							}
-						}
-						if !found {
-							// Delete the airatelimitpolicy
-							k8sclient.UndeploySubscriptionAIRateLimitPolicyCR(airl.Name, c)
-						}
-					}
-				}
-			} else {
-				logger.LoggerSynchronizer.Errorf("Error while fetching airatelimitpolicies for cleaning up outdataed crs. Error: %+v", retrieveAllAIRLErr)
-			}
-			if retrieveAllRLErr == nil {
-				for _, rl := range rls {
-					if cpName, exists := rl.ObjectMeta.Labels["CPName"]; exists {
-						found := false
-						for _, policy := range rateLimitPolicies {
-							if policy.Name == cpName {
-								found = true
-								break
-							}
-						}
-						if !found {
-							// Delete the airatelimitpolicy
-							k8sclient.UnDeploySubscriptionRateLimitPolicyCR(rl.Name, c)
-						}
-					}
-				}
-			} else {
-				logger.LoggerSynchronizer.Errorf("Error while fetching ratelimitpolicies for cleaning up outdataed crs. Error: %+v", retrieveAllRLErr)
-			}
-		}
-
-		for _, policy := range rateLimitPolicies {
-			if policy.QuotaType == "aiApiQuota" {
-				if policy.DefaultLimit.AiAPIQuota != nil {
-					switch policy.DefaultLimit.AiAPIQuota.TimeUnit {
-					case "min":
-						policy.DefaultLimit.AiAPIQuota.TimeUnit = "Minute"
-					case "hours":
-						policy.DefaultLimit.AiAPIQuota.TimeUnit = "Hour"
-					case "days":
-						policy.DefaultLimit.AiAPIQuota.TimeUnit = "Day"
-					default:
-						logger.LoggerSynchronizer.Errorf("Unsupported timeunit %s", policy.DefaultLimit.AiAPIQuota.TimeUnit)
-						continue
-					}
-					if policy.DefaultLimit.AiAPIQuota.PromptTokenCount == nil && policy.DefaultLimit.AiAPIQuota.TotalTokenCount != nil {
-						policy.DefaultLimit.AiAPIQuota.PromptTokenCount = policy.DefaultLimit.AiAPIQuota.TotalTokenCount
-					}
-					if policy.DefaultLimit.AiAPIQuota.CompletionTokenCount == nil && policy.DefaultLimit.AiAPIQuota.TotalTokenCount != nil {
-						policy.DefaultLimit.AiAPIQuota.CompletionTokenCount = policy.DefaultLimit.AiAPIQuota.TotalTokenCount
-					}
-					if policy.DefaultLimit.AiAPIQuota.TotalTokenCount == nil && policy.DefaultLimit.AiAPIQuota.PromptTokenCount != nil && policy.DefaultLimit.AiAPIQuota.CompletionTokenCount != nil {
-						total := *policy.DefaultLimit.AiAPIQuota.PromptTokenCount + *policy.DefaultLimit.AiAPIQuota.CompletionTokenCount
-						policy.DefaultLimit.AiAPIQuota.TotalTokenCount = &total
-					}
-					managementserver.AddSubscriptionPolicy(policy)
-					k8sclient.DeployAIRateLimitPolicyFromCPPolicy(policy, c)
-				} else {
-					logger.LoggerSynchronizer.Errorf("AIQuota type response recieved but no data found. %+v", policy.DefaultLimit)
-				}
-			} else {
-				if policy.DefaultLimit.RequestCount.TimeUnit == "min" {
-					policy.DefaultLimit.RequestCount.TimeUnit = "Minute"
-				} else if policy.DefaultLimit.RequestCount.TimeUnit == "hours" {
-					policy.DefaultLimit.RequestCount.TimeUnit = "Hour"
-				} else if policy.DefaultLimit.RequestCount.TimeUnit == "days" {
-					policy.DefaultLimit.RequestCount.TimeUnit = "Day"
-				}
-				managementserver.AddSubscriptionPolicy(policy)
-				logger.LoggerSynchronizer.Infof("RateLimit Policy added to internal map: %v", policy)
-				// Update the exisitng rate limit policies with current policy
-				k8sclient.DeploySubscriptionRateLimitPolicyCR(policy, c)
-			}
+		if ratelimitName == "" && organization == "" {
+			snapshot.Save(snapshot.SubscriptionPolicies, responseBytes)
 		}
 	} else {
 		errorMsg = "Failed to fetch data! " + policiesEndpoint + " responded with " +
 			strconv.Itoa(resp.StatusCode)
-		go retrySubscriptionRLPFetchData(conf, errorMsg, err, c)
+		go retrySubscriptionRLPFetchData(conf, errorMsg, err, resp.StatusCode, c)
 	}
 }
 
-func retryRLPFetchData(conf *config.Config, errorMessage string, err error, c client.Client) {
+func retryRLPFetchData(conf *config.Config, errorMessage string, err error, statusCode int, c client.Client) {
+	// The snapshot is loaded only when the control plane is unavailable, not when it rejects the request
+	if snapshot.IsControlPlaneUnavailable(statusCode) {
+		if content, found := snapshot.LoadOnce(snapshot.RateLimitPolicies); found {
+			if err := applyRateLimitPolicies(content, c); err != nil {
+				logger.LoggerSynchronizer.Errorf("Error occurred while loading the RateLimit Policies from the snapshot %v", err)
+			}
+		}
+	}
 	logger.LoggerSynchronizer.Debugf("Time Duration for retrying: %v",
 		conf.ControlPlane.RetryInterval*time.Second)
 	time.Sleep(conf.ControlPlane.RetryInterval * time.Second)
@@ -349,7 +256,15 @@ func retryRLPFetchData(conf *config.Config, errorMessage string, err error, c cl
 	}
 }
 
-func retrySubscriptionRLPFetchData(conf *config.Config, errorMessage string, err error, c client.Client) {
+func retrySubscriptionRLPFetchData(conf *config.Config, errorMessage string, err error, statusCode int, c client.Client) {
+	// The snapshot is loaded only when the control plane is unavailable, not when it rejects the request
+	if snapshot.IsControlPlaneUnavailable(statusCode) {
+		if content, found := snapshot.LoadOnce(snapshot.SubscriptionPolicies); found {
+			if err := applySubscriptionRateLimitPolicies(content, c, false); err != nil {
+				logger.LoggerSynchronizer.Errorf("Error occurred while loading the Subscription RateLimit Policies from the snapshot %v", err)
+			}
+		}
+	}
 	logger.LoggerSynchronizer.Debugf("Time Duration for retrying: %v",
 		conf.ControlPlane.RetryInterval*time.Second)
 	time.Sleep(conf.ControlPlane.RetryInterval * time.Second)
@@ -360,3 +275,177 @@ func retrySubscriptionRLPFetchData(conf *config.Config, errorMessage string, err
 		return
 	}
 }
+
+// applyRateLimitPolicies adds the rate limit policies in the response of the control plane to the internal map
+// and updates the rate limit policy CRs.
+func applyRateLimitPolicies(responseBytes []byte, c client.Client) error {
+	var rateLimitPolicyList eventhubTypes.RateLimitPolicyList
+	err := json.Unmarshal(responseBytes, &rateLimitPolicyList)
+	if err != nil {
+		return err
+	}
+	logger.LoggerSynchronizer.Debugf("Policies received: %v", rateLimitPolicyList.List)
+	var rateLimitPolicies []eventhubTypes.RateLimitPolicy = rateLimitPolicyList.List
+	for _, policy := range rateLimitPolicies {
+		if !utils.IsOrganizationAllowed(policy.TenantDomain) {
+			logger.LoggerSynchronizer.Debugf("RateLimit Policy %s is skipped since the organization %s is not served by the agent", policy.Name, policy.TenantDomain)
+			continue
+		}
+		if policy.DefaultLimit.RequestCount.TimeUnit == "min" {
+			policy.DefaultLimit.RequestCount.TimeUnit = "Minute"
+		} else if policy.DefaultLimit.RequestCount.TimeUnit == "hour" {
+			policy.DefaultLimit.RequestCount.TimeUnit = "Hour"
+		} else if policy.DefaultLimit.RequestCount.TimeUnit == "day" {
+			policy.DefaultLimit.RequestCount.TimeUnit = "Day"
+		}
+		managementserver.AddRateLimitPolicy(policy)
+		logger.LoggerSynchronizer.Infof("RateLimit Policy added to internal map: %v", policy)
+		// Update the exisitng rate limit policies with current policy
+		rateLimitPolicyCRs, err := k8sclient.PrepareUpdatedRateLimitPolicyCRs(policy, c)
+		if err != nil {
+			logger.LoggerSynchronizer.Errorf("Error while retrieving the RateLimitPolicies of the policy %s: %v", policy.Name, err)
+			continue
+		}
+		for i := range rateLimitPolicyCRs {
+			if err := sink.GetSink(c).Apply(&rateLimitPolicyCRs[i]); err != nil {
+				logger.LoggerSynchronizer.Errorf("Error while updating the RateLimitPolicy %s: %v", rateLimitPolicyCRs[i].Name, err)
+			}
+		}
+
+	}
+	return nil
+}
+
+// applySubscriptionRateLimitPolicies adds the subscription rate limit policies in the response of the control plane
+// to the internal map and deploys the rate limit policy CRs. The CRs of the deleted policies are removed if
+// cleanupDeletedPolicies is true.
+func applySubscriptionRateLimitPolicies(responseBytes []byte, c client.Client, cleanupDeletedPolicies bool) error {
+	var rateLimitPolicyList eventhubTypes.SubscriptionPolicyList
+	err := json.Unmarshal(responseBytes, &rateLimitPolicyList)
+	if err != nil {
+		return err
+	}
+	logger.LoggerSynchronizer.Debugf("Policies received: %v", rateLimitPolicyList.List)
+	var rateLimitPolicies []eventhubTypes.SubscriptionPolicy
+	for _, policy := range rateLimitPolicyList.List {
+		if utils.IsOrganizationAllowed(policy.TenantDomain) {
+			rateLimitPolicies = append(rateLimitPolicies, policy)
+		} else {
+			logger.LoggerSynchronizer.Debugf("Subscription RateLimit Policy %s is skipped since the organization %s is not served by the agent", policy.Name, policy.TenantDomain)
+		}
+	}
+	if cleanupDeletedPolicies {
+		// This logic is executed once at the startup time so no need to worry about the nested for loops for performance.
+		// Fetch all AiRatelimitPolicies
+		airls, _, retrieveAllAIRLErr := k8sclient.RetrieveAllAIRatelimitPoliciesSFromK8s(c, "")
+		rls, _, retrieveAllRLErr := k8sclient.RetrieveAllRatelimitPoliciesSFromK8s(c, "")
+		if retrieveAllAIRLErr == nil {
+			for i, airl := range airls {
+				if cpName, exists := airl.ObjectMeta.Labels["CPName"]; exists {
+					found := false
+					for _, policy := range rateLimitPolicies {
+						if policy.Name == cpName {
+							found = true
+							break
+						}
+					}
+					if !found {
+						// Delete the airatelimitpolicy
+						if err := sink.GetSink(c).Delete(&airls[i]); err != nil {
+							logger.LoggerSynchronizer.Errorf("Error while deleting the AIRateLimitPolicy %s: %v", airl.Name, err)
+						}
+					}
+				}
+			}
+		} else {
+			logger.LoggerSynchronizer.Errorf("Error while fetching airatelimitpolicies for cleaning up outdataed crs. Error: %+v", retrieveAllAIRLErr)
+		}
+		if retrieveAllRLErr == nil {
+			for i, rl := range rls {
+				if cpName, exists := rl.ObjectMeta.Labels["CPName"]; exists {
+					found := false
+					for _, policy := range rateLimitPolicies {
+						if policy.Name == cpName {
+							found = true
+							break
+						}
+					}
+					if !found {
+						// Delete the ratelimitpolicy
+						if err := sink.GetSink(c).Delete(&rls[i]); err != nil {
+							logger.LoggerSynchronizer.Errorf("Error while deleting the RateLimitPolicy %s: %v", rl.Name, err)
+						}
+					}
+				}
+			}
+		} else {
+			logger.LoggerSynchronizer.Errorf("Error while fetching ratelimitpolicies for cleaning up outdataed crs. Error: %+v", retrieveAllRLErr)
+		}
+	}
+
+	for _, policy := range rateLimitPolicies {
+		if policy.QuotaType == "aiApiQuota" {
+			if policy.DefaultLimit.AiAPIQuota != nil {
+				switch policy.DefaultLimit.AiAPIQuota.TimeUnit {
+				case "min":
+					policy.DefaultLimit.AiAPIQuota.TimeUnit = "Minute"
+				case "hours":
+					policy.DefaultLimit.AiAPIQuota.TimeUnit = "Hour"
+				case "days":
+					policy.DefaultLimit.AiAPIQuota.TimeUnit = "Day"
+				default:
+					logger.LoggerSynchronizer.Errorf("Unsupported timeunit %s", policy.DefaultLimit.AiAPIQuota.TimeUnit)
+					continue
+				}
+				if policy.DefaultLimit.AiAPIQuota.PromptTokenCount == nil && policy.DefaultLimit.AiAPIQuota.TotalTokenCount != nil {
+					policy.DefaultLimit.AiAPIQuota.PromptTokenCount = policy.DefaultLimit.AiAPIQuota.TotalTokenCount
+				}
+				if policy.DefaultLimit.AiAPIQuota.CompletionTokenCount == nil && policy.DefaultLimit.AiAPIQuota.TotalTokenCount != nil {
+					policy.DefaultLimit.AiAPIQuota.CompletionTokenCount = policy.DefaultLimit.AiAPIQuota.TotalTokenCount
+				}
+				if policy.DefaultLimit.AiAPIQuota.TotalTokenCount == nil && policy.DefaultLimit.AiAPIQuota.PromptTokenCount != nil && policy.DefaultLimit.AiAPIQuota.CompletionTokenCount != nil {
+					total := *policy.DefaultLimit.AiAPIQuota.PromptTokenCount + *policy.DefaultLimit.AiAPIQuota.CompletionTokenCount
+					policy.DefaultLimit.AiAPIQuota.TotalTokenCount = &total
+				}
+				managementserver.AddSubscriptionPolicy(policy)
+				if isSyncedFromDataPlane(policy, c) {
+					continue
+				}
+				if err := sink.GetSink(c).Apply(k8sclient.NewAIRateLimitPolicyCRFromCPPolicy(policy)); err != nil {
+					logger.LoggerSynchronizer.Errorf("Error while deploying the AIRateLimitPolicy of the policy %s: %v", policy.Name, err)
+				}
+			} else {
+				logger.LoggerSynchronizer.Errorf("AIQuota type response recieved but no data found. %+v", policy.DefaultLimit)
+			}
+		} else {
+			if policy.DefaultLimit.RequestCount.TimeUnit == "min" {
+				policy.DefaultLimit.RequestCount.TimeUnit = "Minute"
+			} else if policy.DefaultLimit.RequestCount.TimeUnit == "hours" {
+				policy.DefaultLimit.RequestCount.TimeUnit = "Hour"
+			} else if policy.DefaultLimit.RequestCount.TimeUnit == "days" {
+				policy.DefaultLimit.RequestCount.TimeUnit = "Day"
+			}
+			managementserver.AddSubscriptionPolicy(policy)
+			logger.LoggerSynchronizer.Infof("RateLimit Policy added to internal map: %v", policy)
+			if isSyncedFromDataPlane(policy, c) {
+				continue
+			}
+			// Update the exisitng rate limit policies with current policy
+			if err := sink.GetSink(c).Apply(k8sclient.NewSubscriptionRateLimitPolicyCR(policy)); err != nil {
+				logger.LoggerSynchronizer.Errorf("Error while deploying the RateLimitPolicy of the policy %s: %v", policy.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isSyncedFromDataPlane returns true if the subscription policy is created in the control plane by syncing a CR of
+// the data plane, in which case the CR already enforces it.
+func isSyncedFromDataPlane(policy eventhubTypes.SubscriptionPolicy, c client.Client) bool {
+	conf, _ := config.ReadConfigs()
+	if conf.Agent.Mode == "CPtoDP" || !conf.DataPlane.PolicySync.Enabled || !k8sclient.IsDataPlaneRateLimitPolicy(c, policy.Name) {
+		return false
+	}
+	logger.LoggerSynchronizer.Debugf("CR of the subscription policy %s is not created, since it is synced from the data plane", policy.Name)
+	return true
+}
@@ -26,23 +26,34 @@ package synchronizer
 import (
 	"crypto/sha1"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"archive/zip"
 	"bytes"
 	"strings"
 
+	dpv1alpha3 "github.com/wso2/apk/common-go-libs/apis/dp/v1alpha3"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/sink"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/internal/snapshot"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/logging"
 	sync "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/synchronizer"
 	transformer "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/transformer"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	k8sclientUtil "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/k8sClient"
 	mapperUtil "github.com/wso2/product-apim-tooling/apim-apk-agent/internal/mapper"
 )
 
+// ErrControlPlaneUnreachable is returned when the control plane does not respond, or responds with a server error.
+var ErrControlPlaneUnreachable = errors.New("control plane is unreachable")
+
+// maxAPIFetchRetries is the number of times fetching the API of an event is retried before giving up
+const maxAPIFetchRetries = 10
+
 func init() {
 	conf, _ := config.ReadConfigs()
 	sync.InitializeWorkerPool(conf.ControlPlane.RequestWorkerPool.PoolSize, conf.ControlPlane.RequestWorkerPool.QueueSizePerPool,
@@ -54,7 +65,6 @@ func init() {
 // FetchAPIsOnEvent  will fetch API from control plane during the API Notification Event
 func FetchAPIsOnEvent(conf *config.Config, apiUUID *string, k8sClient client.Client) (*[]string, error) {
 	// Populate data from config.
-	apis := make([]string, 0)
 	envs := conf.ControlPlane.EnvironmentLabels
 
 	// Create a channel for the byte slice (response from the APIs from control plane)
@@ -67,90 +77,40 @@ func FetchAPIsOnEvent(conf *config.Config, apiUUID *string, k8sClient client.Cli
 		GetAPI(c, nil, envs, sync.RuntimeArtifactEndpoint, true)
 	}
 	data := <-c
+	// Retry an API of an event until the control plane responds, up to maxAPIFetchRetries times. The error is
+	// returned when all the APIs are fetched, or the retries are exhausted, so that the caller can fall back to the
+	// snapshot and reconcile later.
+	for retries := 0; apiUUID != nil && isRetryableResponse(data) && retries < maxAPIFetchRetries; retries++ {
+		logger.LoggerUtils.ErrorC(logging.ErrorDetails{
+			Message: fmt.Sprintf("Error occurred while fetching data from control plane: %v ..retrying (%d/%d)..",
+				data.Err, retries+1, maxAPIFetchRetries),
+			Severity:  logging.MINOR,
+			ErrorCode: 1107,
+		})
+		//health.SetControlPlaneRestAPIStatus(false)
+		go sync.RetryFetchingAPIs(c, data, sync.RuntimeArtifactEndpoint, true)
+		data = <-c
+	}
 	logger.LoggerUtils.Debugf("Receiving data for an API: %v", apiUUID)
 	if data.Resp != nil {
 		if data.Found {
-			// Reading the root zip
-			zipReader, err := zip.NewReader(bytes.NewReader(data.Resp), int64(len(data.Resp)))
-
-			// apiFiles represents zipped API files fetched from API Manager
-			apiFiles := make(map[string]*zip.File)
-			// Read the .zip files within the root apis.zip and add apis to apiFiles array.
-			for _, file := range zipReader.File {
-				apiFiles[file.Name] = file
-				logger.LoggerUtils.Debugf("API file found: " + file.Name)
-				// Todo: Read the apis.zip and extract the api.zip,deployments.json
-			}
-			if err != nil {
-				logger.LoggerUtils.Errorf("Error while reading zip: %v", err)
-				return nil, err
-			}
-			deploymentJSON, exists := apiFiles["deployments.json"]
-			if !exists {
-				logger.LoggerUtils.Errorf("deployments.json not found")
-				return nil, err
+			apis, err := DeployAPIsFromArchive(conf, data.Resp, k8sClient)
+			if err == nil && apiUUID == nil {
+				snapshot.Save(snapshot.APIs, data.Resp)
 			}
-			deploymentJSONBytes, err := transformer.ReadContent(deploymentJSON)
-			if err != nil {
-				logger.LoggerUtils.Errorf("Error while decoding the API Project Artifact: %v", err)
-				return nil, err
-			}
-			deploymentDescriptor, err := transformer.ProcessDeploymentDescriptor(deploymentJSONBytes)
-			if err != nil {
-				logger.LoggerUtils.Errorf("Error while decoding the API Project Artifact: %v", err)
-				return nil, err
-			}
-			apiDeployments := deploymentDescriptor.Data.Deployments
-			if apiDeployments != nil {
-				for _, apiDeployment := range *apiDeployments {
-					apiZip, exists := apiFiles[apiDeployment.APIFile]
-					if exists {
-						artifact, decodingError := transformer.DecodeAPIArtifact(apiZip)
-						if decodingError != nil {
-							logger.LoggerUtils.Errorf("Error while decoding the API Project Artifact: %v", decodingError)
-							return nil, err
-						}
-
-						apkConf, apiUUID, revisionID, configuredRateLimitPoliciesMap, endpointSecurityData, api, prodAIRL, sandAIRL, apkErr := transformer.GenerateAPKConf(artifact.APIJson, artifact.CertArtifact, apiDeployment.OrganizationID)
-						if prodAIRL == nil {
-							// Try to delete production AI ratelimit for this api
-							k8sclientUtil.DeleteAIRatelimitPolicy(generateSHA1HexHash(api.Name, api.Version, "production"), k8sClient)
-						}
-						if sandAIRL == nil {
-							// Try to delete production AI ratelimit for this api
-							k8sclientUtil.DeleteAIRatelimitPolicy(generateSHA1HexHash(api.Name, api.Version, "sandbox"), k8sClient)
-						}
-						if apkErr != nil {
-							logger.LoggerUtils.Errorf("Error while generating APK-Conf: %v", apkErr)
-							return nil, err
-						}
-						logger.LoggerUtils.Debugf("APK Conf: %v", apkConf)
-						certContainer := transformer.CertContainer{
-							ClientCertObj:   artifact.CertMeta,
-							EndpointCertObj: artifact.EndpointCertMeta,
-							SecretData:      endpointSecurityData,
-						}
-						k8ResourceEndpoint := conf.DataPlane.K8ResourceEndpoint
-						crResponse, err := transformer.GenerateCRs(apkConf, artifact.Schema, certContainer, k8ResourceEndpoint, apiDeployment.OrganizationID)
-						if err != nil {
-							logger.LoggerUtils.Errorf("Error occured in receiving the updated CRDs: %v", err)
-							return nil, err
-						}
-						transformer.UpdateCRS(crResponse, apiDeployment.Environments, apiDeployment.OrganizationID, apiUUID, fmt.Sprint(revisionID), "namespace", configuredRateLimitPoliciesMap)
-						mapperUtil.MapAndCreateCR(*crResponse, k8sClient)
-						apis = append(apis, apiUUID)
-						logger.LoggerUtils.Info("API applied successfully.\n")
-					}
-				}
-				return &apis, nil
-			}
-		} else {
-			logger.LoggerUtils.Info("API not found.")
-			return &apis, nil
+			return apis, err
 		}
+		logger.LoggerUtils.Info("API not found.")
+		if apiUUID == nil {
+			snapshot.Delete(snapshot.APIs)
+		}
+		return &[]string{}, nil
 	} else if data.ErrorCode == 204 {
 		logger.LoggerUtils.Infof("No API Artifacts are available in the control plane for the envionments :%s",
 			strings.Join(envs, ", "))
+		if apiUUID == nil {
+			snapshot.Delete(snapshot.APIs)
+		}
 		return &[]string{}, nil
 	} else if data.ErrorCode >= 400 && data.ErrorCode < 500 {
 		logger.LoggerUtils.ErrorC(logging.ErrorDetails{
@@ -159,18 +119,103 @@ func FetchAPIsOnEvent(conf *config.Config, apiUUID *string, k8sClient client.Cli
 			ErrorCode: 1106,
 		})
 		return nil, data.Err
-	} else {
-		// Keep the iteration still until all the envrionment response properly.
-		logger.LoggerUtils.ErrorC(logging.ErrorDetails{
-			Message:   fmt.Sprintf("Error occurred while fetching data from control plane: %v ..retrying..", data.Err),
-			Severity:  logging.MINOR,
-			ErrorCode: 1107,
-		})
-		//health.SetControlPlaneRestAPIStatus(false)
-		sync.RetryFetchingAPIs(c, data, sync.RuntimeArtifactEndpoint, true)
 	}
-	logger.LoggerUtils.Info("Fetching API for an event is completed...")
-	return nil, nil
+	logger.LoggerUtils.ErrorC(logging.ErrorDetails{
+		Message:   fmt.Sprintf("Error occurred while fetching data from control plane: %v", data.Err),
+		Severity:  logging.MINOR,
+		ErrorCode: 1107,
+	})
+	return nil, fmt.Errorf("%w: %v", ErrControlPlaneUnreachable, data.Err)
+}
+
+// isRetryableResponse returns true if the control plane did not respond, or responded with a server error.
+func isRetryableResponse(data sync.SyncAPIResponse) bool {
+	return data.Resp == nil && data.ErrorCode != 204 && (data.ErrorCode < 400 || data.ErrorCode >= 500)
+}
+
+// DeployAPIsFromArchive deploys the APIs in the given root zip fetched from the runtime artifacts endpoint and
+// returns the UUIDs of the deployed APIs.
+func DeployAPIsFromArchive(conf *config.Config, archive []byte, k8sClient client.Client) (*[]string, error) {
+	apis := make([]string, 0)
+	// Reading the root zip
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		logger.LoggerUtils.Errorf("Error while reading zip: %v", err)
+		return nil, err
+	}
+
+	// apiFiles represents zipped API files fetched from API Manager
+	apiFiles := make(map[string]*zip.File)
+	// Read the .zip files within the root apis.zip and add apis to apiFiles array.
+	for _, file := range zipReader.File {
+		apiFiles[file.Name] = file
+		logger.LoggerUtils.Debugf("API file found: " + file.Name)
+		// Todo: Read the apis.zip and extract the api.zip,deployments.json
+	}
+	deploymentJSON, exists := apiFiles["deployments.json"]
+	if !exists {
+		logger.LoggerUtils.Errorf("deployments.json not found")
+		return nil, fmt.Errorf("deployments.json not found")
+	}
+	deploymentJSONBytes, err := transformer.ReadContent(deploymentJSON)
+	if err != nil {
+		logger.LoggerUtils.Errorf("Error while decoding the API Project Artifact: %v", err)
+		return nil, err
+	}
+	deploymentDescriptor, err := transformer.ProcessDeploymentDescriptor(deploymentJSONBytes)
+	if err != nil {
+		logger.LoggerUtils.Errorf("Error while decoding the API Project Artifact: %v", err)
+		return nil, err
+	}
+	apiDeployments := deploymentDescriptor.Data.Deployments
+	if apiDeployments == nil {
+		return &apis, nil
+	}
+	for _, apiDeployment := range *apiDeployments {
+		if !utils.IsOrganizationAllowed(apiDeployment.OrganizationID) {
+			logger.LoggerUtils.Debugf("API %s is skipped since the organization %s is not served by the agent", apiDeployment.APIFile, apiDeployment.OrganizationID)
+			continue
+		}
+		apiZip, exists := apiFiles[apiDeployment.APIFile]
+		if exists {
+			artifact, decodingError := transformer.DecodeAPIArtifact(apiZip)
+			if decodingError != nil {
+				logger.LoggerUtils.Errorf("Error while decoding the API Project Artifact: %v", decodingError)
+				return nil, decodingError
+			}
+
+			apkConf, apiUUID, revisionID, configuredRateLimitPoliciesMap, endpointSecurityData, api, prodAIRL, sandAIRL, apkErr := transformer.GenerateAPKConf(artifact.APIJson, artifact.CertArtifact, apiDeployment.OrganizationID)
+			if prodAIRL == nil {
+				// Try to delete production AI ratelimit for this api
+				sink.GetSink(k8sClient).Delete(&dpv1alpha3.AIRateLimitPolicy{ObjectMeta: metav1.ObjectMeta{Name: generateSHA1HexHash(api.Name, api.Version, "production")}})
+			}
+			if sandAIRL == nil {
+				// Try to delete production AI ratelimit for this api
+				sink.GetSink(k8sClient).Delete(&dpv1alpha3.AIRateLimitPolicy{ObjectMeta: metav1.ObjectMeta{Name: generateSHA1HexHash(api.Name, api.Version, "sandbox")}})
+			}
+			if apkErr != nil {
+				logger.LoggerUtils.Errorf("Error while generating APK-Conf: %v", apkErr)
+				return nil, apkErr
+			}
+			logger.LoggerUtils.Debugf("APK Conf: %v", apkConf)
+			certContainer := transformer.CertContainer{
+				ClientCertObj:   artifact.CertMeta,
+				EndpointCertObj: artifact.EndpointCertMeta,
+				SecretData:      endpointSecurityData,
+			}
+			k8ResourceEndpoint := conf.DataPlane.K8ResourceEndpoint
+			crResponse, err := transformer.GenerateCRs(apkConf, artifact.Schema, certContainer, k8ResourceEndpoint, apiDeployment.OrganizationID)
+			if err != nil {
+				logger.LoggerUtils.Errorf("Error occured in receiving the updated CRDs: %v", err)
+				return nil, err
+			}
+			transformer.UpdateCRS(crResponse, apiDeployment.Environments, apiDeployment.OrganizationID, apiUUID, fmt.Sprint(revisionID), "namespace", configuredRateLimitPoliciesMap)
+			mapperUtil.MapAndCreateCR(*crResponse, k8sClient)
+			apis = append(apis, apiUUID)
+			logger.LoggerUtils.Info("API applied successfully.\n")
+		}
+	}
+	return &apis, nil
 }
 
 // generateSHA1HexHash hashes the concatenated strings and returns the SHA-1 hash in base16 (hex) encoding.
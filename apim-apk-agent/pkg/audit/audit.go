@@ -0,0 +1,296 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+// Package audit records the notifications received from the control plane, the CRs the agent applies to the data
+// plane and the revision acknowledgements it sends, so that a change of the data plane can be traced back to the
+// event which caused it.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+)
+
+// Actions of the audit records
+const (
+	ActionNotificationReceived = "NOTIFICATION_RECEIVED"
+	ActionCRCreated            = "CR_CREATED"
+	ActionCRUpdated            = "CR_UPDATED"
+	ActionCRDeleted            = "CR_DELETED"
+	ActionDeployAckSent        = "DEPLOY_ACK_SENT"
+	ActionUndeployAckSent      = "UNDEPLOY_ACK_SENT"
+	ActionDeployAckDropped     = "DEPLOY_ACK_DROPPED"
+	ActionUndeployAckDropped   = "UNDEPLOY_ACK_DROPPED"
+)
+
+const (
+	fileOutput       = "file"
+	recordFilePrefix = "audit-"
+	recordFileSuffix = ".log"
+	dateLayout       = "2006-01-02"
+)
+
+var (
+	onceAuditLogInit sync.Once
+	auditLogInstance *auditLog
+)
+
+// Record is an entry of the audit log
+type Record struct {
+	Time            time.Time `json:"time"`
+	Action          string    `json:"action"`
+	EventID         string    `json:"eventId,omitempty"`
+	EventType       string    `json:"eventType,omitempty"`
+	APIUUID         string    `json:"apiUuid,omitempty"`
+	Kind            string    `json:"kind,omitempty"`
+	Namespace       string    `json:"namespace,omitempty"`
+	Name            string    `json:"name,omitempty"`
+	ResourceVersion string    `json:"resourceVersion,omitempty"`
+	Environment     string    `json:"environment,omitempty"`
+	Revision        string    `json:"revision,omitempty"`
+}
+
+// Query filters the audit records. The empty fields match any record.
+type Query struct {
+	EventID string
+	APIUUID string
+	Action  string
+	Since   time.Time
+	// Limit is the maximum number of the latest matching records returned
+	Limit int
+}
+
+func (q Query) matches(record Record) bool {
+	return (q.EventID == "" || q.EventID == record.EventID) &&
+		(q.APIUUID == "" || q.APIUUID == record.APIUUID) &&
+		(q.Action == "" || strings.EqualFold(q.Action, record.Action)) &&
+		(q.Since.IsZero() || !record.Time.Before(q.Since))
+}
+
+// auditLog writes the records as JSON lines to the stdout or to a file per day, and keeps the latest records within
+// the retention period to be queried.
+type auditLog struct {
+	mutex      sync.Mutex
+	output     io.Writer
+	path       string
+	retention  time.Duration
+	maxRecords int
+	records    []Record
+	file       *os.File
+	fileDate   string
+	now        func() time.Time
+}
+
+// getAuditLog returns the audit log, or nil if the audit log is disabled
+func getAuditLog() *auditLog {
+	onceAuditLogInit.Do(func() {
+		conf, err := config.ReadConfigs()
+		if err != nil || !conf.Agent.Audit.Enabled {
+			return
+		}
+		auditConf := conf.Agent.Audit
+		path := ""
+		if strings.EqualFold(auditConf.Output, fileOutput) {
+			path = auditConf.Path
+		}
+		auditLogInstance = newAuditLog(os.Stdout, path, time.Duration(auditConf.RetentionDays)*24*time.Hour,
+			auditConf.MaxRecords)
+		logger.LoggerAudit.Infof("Audit log is enabled with the %s output", auditConf.Output)
+	})
+	return auditLogInstance
+}
+
+// newAuditLog creates an audit log which writes to the files in the given directory, or to the given output if the
+// path is empty. The records retained in the files are loaded to be queried.
+func newAuditLog(output io.Writer, path string, retention time.Duration, maxRecords int) *auditLog {
+	l := &auditLog{output: output, path: path, retention: retention, maxRecords: maxRecords, now: time.Now}
+	if path == "" {
+		return l
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		logger.LoggerAudit.Errorf("Unable to create the audit log directory %s: %v", path, err)
+	}
+	for _, fileName := range l.recordFiles() {
+		l.records = append(l.records, readRecords(filepath.Join(path, fileName))...)
+	}
+	l.prune()
+	return l
+}
+
+// Enabled returns true if the audit log is enabled
+func Enabled() bool {
+	return getAuditLog() != nil
+}
+
+// NotificationReceived records a notification event received from the control plane
+func NotificationReceived(eventID string, eventType string, apiUUID string) {
+	write(Record{Action: ActionNotificationReceived, EventID: eventID, EventType: eventType, APIUUID: apiUUID})
+}
+
+// RevisionAckSent records a revision deployment acknowledgement confirmed by the control plane
+func RevisionAckSent(action string, apiUUID string, environment string, revision string) {
+	write(Record{Action: action, APIUUID: apiUUID, Environment: environment, Revision: revision})
+}
+
+// RevisionAckDropped records a revision deployment acknowledgement which is dropped, since the control plane
+// rejected it or it could not be sent within the maximum number of attempts
+func RevisionAckDropped(action string, apiUUID string, environment string, revision string) {
+	write(Record{Action: action, APIUUID: apiUUID, Environment: environment, Revision: revision})
+}
+
+// QueryRecords returns the retained records which match the query, the oldest first
+func QueryRecords(query Query) []Record {
+	l := getAuditLog()
+	if l == nil {
+		return []Record{}
+	}
+	return l.query(query)
+}
+
+func write(record Record) {
+	if l := getAuditLog(); l != nil {
+		l.write(record)
+	}
+}
+
+func (l *auditLog) write(record Record) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	record.Time = l.now().UTC()
+	line, err := json.Marshal(record)
+	if err != nil {
+		logger.LoggerAudit.Errorf("Unable to marshal the audit record %v: %v", record, err)
+		return
+	}
+	output := l.output
+	if l.path != "" {
+		if output, err = l.currentFile(record.Time); err != nil {
+			logger.LoggerAudit.Errorf("Unable to open the audit log file: %v", err)
+			return
+		}
+	}
+	if _, err := output.Write(append(line, '\n')); err != nil {
+		logger.LoggerAudit.Errorf("Unable to write the audit record: %v", err)
+	}
+	l.records = append(l.records, record)
+	l.prune()
+}
+
+// currentFile returns the file of the day of the given time. The files older than the retention period are removed
+// once the day changes.
+func (l *auditLog) currentFile(now time.Time) (*os.File, error) {
+	date := now.Format(dateLayout)
+	if l.file != nil && l.fileDate == date {
+		return l.file, nil
+	}
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+	file, err := os.OpenFile(filepath.Join(l.path, recordFilePrefix+date+recordFileSuffix),
+		os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	l.file = file
+	l.fileDate = date
+	for _, fileName := range l.recordFiles() {
+		fileDate, _ := time.Parse(dateLayout, strings.TrimSuffix(strings.TrimPrefix(fileName, recordFilePrefix), recordFileSuffix))
+		if now.Sub(fileDate) > l.retention+24*time.Hour {
+			if err := os.Remove(filepath.Join(l.path, fileName)); err != nil {
+				logger.LoggerAudit.Warnf("Unable to remove the expired audit log file %s: %v", fileName, err)
+			}
+		}
+	}
+	return file, nil
+}
+
+// recordFiles returns the names of the files of records in the directory, the oldest first
+func (l *auditLog) recordFiles() []string {
+	entries, err := os.ReadDir(l.path)
+	if err != nil {
+		return nil
+	}
+	var fileNames []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), recordFilePrefix) && strings.HasSuffix(entry.Name(), recordFileSuffix) {
+			fileNames = append(fileNames, entry.Name())
+		}
+	}
+	sort.Strings(fileNames)
+	return fileNames
+}
+
+// prune drops the records older than the retention period and the oldest records beyond the maximum
+func (l *auditLog) prune() {
+	expiry := l.now().Add(-l.retention)
+	expired := 0
+	for expired < len(l.records) && l.records[expired].Time.Before(expiry) {
+		expired++
+	}
+	if excess := len(l.records) - expired - l.maxRecords; l.maxRecords > 0 && excess > 0 {
+		expired += excess
+	}
+	if expired > 0 {
+		l.records = append([]Record{}, l.records[expired:]...)
+	}
+}
+
+func (l *auditLog) query(query Query) []Record {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	matched := []Record{}
+	for _, record := range l.records {
+		if query.matches(record) {
+			matched = append(matched, record)
+		}
+	}
+	if query.Limit > 0 && len(matched) > query.Limit {
+		matched = matched[len(matched)-query.Limit:]
+	}
+	return matched
+}
+
+// readRecords reads the records of a file, skipping the lines which are not records
+func readRecords(fileName string) []Record {
+	file, err := os.Open(fileName)
+	if err != nil {
+		logger.LoggerAudit.Warnf("Unable to read the audit log file %s: %v", fileName, err)
+		return nil
+	}
+	defer file.Close()
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err == nil {
+			records = append(records, record)
+		}
+	}
+	return records
+}
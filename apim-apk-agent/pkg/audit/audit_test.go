@@ -0,0 +1,107 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAuditLogWritesAndQueriesRecords(t *testing.T) {
+	var output bytes.Buffer
+	l := newAuditLog(&output, "", 24*time.Hour, 3)
+	l.write(Record{Action: ActionNotificationReceived, EventID: "e1", APIUUID: "api1"})
+	l.write(Record{Action: ActionCRCreated, EventID: "e1", APIUUID: "api1", Kind: "API", Name: "api1", ResourceVersion: "10"})
+	l.write(Record{Action: ActionNotificationReceived, EventID: "e2", APIUUID: "api2"})
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Len(t, lines, 3)
+	var record Record
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "10", record.ResourceVersion)
+
+	assert.Len(t, l.query(Query{EventID: "e1"}), 2)
+	assert.Len(t, l.query(Query{APIUUID: "api2"}), 1)
+	assert.Len(t, l.query(Query{Action: "cr_created"}), 1)
+	latest := l.query(Query{Limit: 1})
+	assert.Equal(t, "e2", latest[0].EventID)
+
+	// The oldest record is dropped beyond the maximum number of records
+	l.write(Record{Action: ActionDeployAckSent, APIUUID: "api1", Environment: "Default", Revision: "1"})
+	records := l.query(Query{})
+	assert.Len(t, records, 3)
+	assert.Equal(t, ActionCRCreated, records[0].Action)
+}
+
+func TestAuditLogRetention(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().UTC().AddDate(0, 0, -4).Truncate(24 * time.Hour).Add(10 * time.Hour)
+	now := start
+	l := newAuditLog(nil, dir, 24*time.Hour, 100)
+	l.now = func() time.Time { return now }
+	l.write(Record{Action: ActionNotificationReceived, EventID: "e1"})
+	now = now.Add(30 * time.Hour)
+	l.write(Record{Action: ActionNotificationReceived, EventID: "e2"})
+	records := l.query(Query{})
+	assert.Len(t, records, 1)
+	assert.Equal(t, "e2", records[0].EventID)
+	now = now.Add(20 * time.Hour)
+	l.write(Record{Action: ActionNotificationReceived, EventID: "e3"})
+
+	// The file of the first day is removed, and the retained records are loaded after a restart
+	_, err := os.Stat(filepath.Join(dir, "audit-"+start.Format(dateLayout)+".log"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "audit-"+now.Format(dateLayout)+".log"))
+	assert.NoError(t, err)
+	restarted := newAuditLog(nil, dir, 30*24*time.Hour, 100)
+	assert.Equal(t, []string{"e2", "e3"}, []string{restarted.query(Query{})[0].EventID, restarted.query(Query{})[1].EventID})
+}
+
+func TestWithScopeRecordsCRChanges(t *testing.T) {
+	var output bytes.Buffer
+	onceAuditLogInit.Do(func() {})
+	auditLogInstance = newAuditLog(&output, "", time.Hour, 100)
+	defer func() { auditLogInstance = nil }()
+
+	k8sClient := WithScope(fake.NewClientBuilder().Build(), Scope{EventID: "e1", EventType: "DEPLOY_API_IN_GATEWAY", APIUUID: "api1"})
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "api1-definition", Namespace: "apk"}}
+	assert.NoError(t, k8sClient.Create(context.Background(), configMap))
+	configMap.Data = map[string]string{"definition": "{}"}
+	assert.NoError(t, k8sClient.Update(context.Background(), configMap))
+	assert.NoError(t, k8sClient.Delete(context.Background(), configMap))
+
+	records := QueryRecords(Query{EventID: "e1"})
+	assert.Len(t, records, 3)
+	assert.Equal(t, []string{ActionCRCreated, ActionCRUpdated, ActionCRDeleted},
+		[]string{records[0].Action, records[1].Action, records[2].Action})
+	assert.Equal(t, "ConfigMap", records[0].Kind)
+	assert.Equal(t, "api1", records[0].APIUUID)
+	assert.NotEmpty(t, records[1].ResourceVersion)
+	assert.NotEqual(t, records[0].ResourceVersion, records[1].ResourceVersion)
+}
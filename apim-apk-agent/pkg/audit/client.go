@@ -0,0 +1,130 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package audit
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	apiKind      = "API"
+	apiUUIDLabel = "apiUUID"
+)
+
+var eventRecorder record.EventRecorder
+
+// Scope correlates the CR changes with the notification event which caused them. The CRs applied outside of an
+// event, such as at the startup, are recorded with an empty scope.
+type Scope struct {
+	EventID   string
+	EventType string
+	APIUUID   string
+}
+
+// SetEventRecorder registers the recorder of the Kubernetes Events emitted on the API CRs
+func SetEventRecorder(recorder record.EventRecorder) {
+	eventRecorder = recorder
+}
+
+// auditClient records the CRs created, updated and deleted through the Kubernetes client within a scope
+type auditClient struct {
+	client.Client
+	scope Scope
+}
+
+// WithScope returns a Kubernetes client which records the CR changes within the given scope. The client is returned
+// as it is if the audit log is disabled.
+func WithScope(k8sClient client.Client, scope Scope) client.Client {
+	if getAuditLog() == nil {
+		return k8sClient
+	}
+	if scoped, ok := k8sClient.(*auditClient); ok {
+		k8sClient = scoped.Client
+	}
+	return &auditClient{Client: k8sClient, scope: scope}
+}
+
+func (c *auditClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.recordChange(ActionCRCreated, "Created", obj)
+	return nil
+}
+
+func (c *auditClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.recordChange(ActionCRUpdated, "Updated", obj)
+	return nil
+}
+
+func (c *auditClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	c.recordChange(ActionCRUpdated, "Updated", obj)
+	return nil
+}
+
+func (c *auditClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.recordChange(ActionCRDeleted, "Deleted", obj)
+	return nil
+}
+
+// recordChange records the change of the CR, and emits a Kubernetes Event on it if it is an API CR
+func (c *auditClient) recordChange(action string, reason string, obj client.Object) {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+			kind = gvk.Kind
+		}
+	}
+	apiUUID := c.scope.APIUUID
+	if apiUUID == "" {
+		apiUUID = obj.GetLabels()[apiUUIDLabel]
+	}
+	write(Record{
+		Action:          action,
+		EventID:         c.scope.EventID,
+		EventType:       c.scope.EventType,
+		APIUUID:         apiUUID,
+		Kind:            kind,
+		Namespace:       obj.GetNamespace(),
+		Name:            obj.GetName(),
+		ResourceVersion: obj.GetResourceVersion(),
+	})
+	if kind == apiKind && eventRecorder != nil {
+		if c.scope.EventID != "" {
+			eventRecorder.Eventf(obj, corev1.EventTypeNormal, reason, "%s by the event %s (%s) of the control plane, resource version %s",
+				reason, c.scope.EventID, c.scope.EventType, obj.GetResourceVersion())
+		} else {
+			eventRecorder.Eventf(obj, corev1.EventTypeNormal, reason, "%s by the agent, resource version %s",
+				reason, obj.GetResourceVersion())
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testIssuer = "https://am.wso2.com/token"
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid})
+	assert.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	assert.NoError(t, err)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.NoError(t, err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestJWKSValidator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jwkSet{Keys: []jwk{{
+			Kid: "key-1",
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	defer jwksServer.Close()
+	validator := NewJWKSValidator(testIssuer, "apk-agent", jwksServer.URL, jwksServer.Client())
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss": testIssuer,
+			"aud": []string{"apk-agent"},
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	claims, err := validator.Validate(signTestToken(t, key, "key-1", validClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, testIssuer, claims["iss"])
+
+	expiredClaims := validClaims()
+	expiredClaims["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = validator.Validate(signTestToken(t, key, "key-1", expiredClaims))
+	assert.EqualError(t, err, "token is expired")
+
+	otherIssuerClaims := validClaims()
+	otherIssuerClaims["iss"] = "https://other.com/token"
+	_, err = validator.Validate(signTestToken(t, key, "key-1", otherIssuerClaims))
+	assert.Error(t, err)
+
+	otherAudienceClaims := validClaims()
+	otherAudienceClaims["aud"] = "other"
+	_, err = validator.Validate(signTestToken(t, key, "key-1", otherAudienceClaims))
+	assert.Error(t, err)
+
+	_, err = validator.Validate(signTestToken(t, otherKey, "key-1", validClaims()))
+	assert.EqualError(t, err, "invalid token signature")
+
+	_, err = validator.Validate(signTestToken(t, key, "key-2", validClaims()))
+	assert.EqualError(t, err, "no key found for the key ID key-2")
+
+	_, err = validator.Validate("not-a-token")
+	assert.EqualError(t, err, "malformed token")
+}
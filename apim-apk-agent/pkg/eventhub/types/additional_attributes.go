@@ -0,0 +1,110 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// UnmarshalJSON unmarshals the Config and keeps the unknown attributes in Additional.
+func (c *Config) UnmarshalJSON(data []byte) error {
+	type config Config
+	var known config
+	additional, err := unmarshalWithAdditional(data, &known)
+	if err != nil {
+		return err
+	}
+	*c = Config(known)
+	c.Additional = additional
+	return nil
+}
+
+// MarshalJSON marshals the Config along with the unknown attributes in Additional.
+func (c Config) MarshalJSON() ([]byte, error) {
+	type config Config
+	return marshalWithAdditional(config(c), c.Additional)
+}
+
+// UnmarshalJSON unmarshals the Fields and keeps the unknown attributes in Additional.
+func (f *Fields) UnmarshalJSON(data []byte) error {
+	type fields Fields
+	var known fields
+	additional, err := unmarshalWithAdditional(data, &known)
+	if err != nil {
+		return err
+	}
+	*f = Fields(known)
+	f.Additional = additional
+	return nil
+}
+
+// MarshalJSON marshals the Fields along with the unknown attributes in Additional.
+func (f Fields) MarshalJSON() ([]byte, error) {
+	type fields Fields
+	return marshalWithAdditional(fields(f), f.Additional)
+}
+
+// unmarshalWithAdditional unmarshals the data to the given struct, and returns the attributes which do not match
+// any of its JSON field names.
+func unmarshalWithAdditional(data []byte, known interface{}) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, known); err != nil {
+		return nil, err
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, err
+	}
+	for _, name := range jsonFieldNames(reflect.TypeOf(known).Elem()) {
+		delete(attributes, name)
+	}
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	return attributes, nil
+}
+
+// marshalWithAdditional marshals the given struct and adds the additional attributes which it does not contain.
+func marshalWithAdditional(known interface{}, additional map[string]json.RawMessage) ([]byte, error) {
+	content, err := json.Marshal(known)
+	if err != nil || len(additional) == 0 {
+		return content, err
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(content, &attributes); err != nil {
+		return nil, err
+	}
+	for name, value := range additional {
+		if _, exists := attributes[name]; !exists {
+			attributes[name] = value
+		}
+	}
+	return json.Marshal(attributes)
+}
+
+func jsonFieldNames(structType reflect.Type) []string {
+	names := make([]string, 0, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		name := strings.Split(structType.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
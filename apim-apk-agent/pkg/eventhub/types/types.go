@@ -17,6 +17,8 @@
 
 package types
 
+import "encoding/json"
+
 // Subscription for struct subscription
 type Subscription struct {
 	SubscriptionID          int32  `json:"subscriptionId"`
@@ -146,17 +148,22 @@ type AIProvider struct {
 	Configurations string `json:"configurations"` // Initially treat as string
 }
 
-// Config for struct Metadata
+// Config for struct Metadata. The attributes which are not known to the agent are kept in Additional, and
+// written back when the Config is marshalled.
 type Config struct {
 	Metadata   []Fields `json:"metadata"`
 	AuthHeader string   `json:"authHeader"`
+	// Models supported by the AI provider
+	Models     []string                   `json:"models,omitempty"`
+	Additional map[string]json.RawMessage `json:"-"`
 }
 
-// Fields for struct Fields
+// Fields for struct Fields. The attributes which are not known to the agent are kept in Additional.
 type Fields struct {
-	AttributeName       string `json:"attributeName"`
-	InputSource         string `json:"inputSource"`
-	AttributeIdentifier string `json:"attributeIdentifier"`
+	AttributeName       string                     `json:"attributeName"`
+	InputSource         string                     `json:"inputSource"`
+	AttributeIdentifier string                     `json:"attributeIdentifier"`
+	Additional          map[string]json.RawMessage `json:"-"`
 }
 
 // APIPolicyEvent for struct policy Info events
@@ -278,6 +285,7 @@ type KeyManagerConfig struct {
 	TokenURL                   string   `json:"TokenURL,token_endpoint"`
 	CertificateType            string   `json:"certificate_type"`
 	CertificateValue           string   `json:"certificate_value"`
+	JwksEndpoint               string   `json:"jwks_endpoint"`
 	ConsumerKeyClaim           string   `json:"consumer_key_claim"`
 	ScopesClaim                string   `json:"scopes_claim"`
 }
@@ -19,6 +19,8 @@ package health
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"sync"
 
 	healthservice "github.com/wso2/apk/adapter/pkg/health/api/wso2/health/service"
@@ -51,6 +53,21 @@ func (s service) SetStatus(isHealthy bool) {
 	serviceHealthStatus[string(s)] = isHealthy
 }
 
+// IsHealthy returns the health state of the service. A service of which the state is not set yet is not healthy.
+func (s service) IsHealthy() bool {
+	mutexForHealthUpdate.Lock()
+	defer mutexForHealthUpdate.Unlock()
+	return serviceHealthStatus[string(s)]
+}
+
+// Check returns an error if the service is not healthy. It is used as a check of the HTTP health endpoints.
+func (s service) Check(_ *http.Request) error {
+	if !s.IsHealthy() {
+		return fmt.Errorf("service %s is %s", s, healthStatuses[false])
+	}
+	return nil
+}
+
 // Server represents the Health GRPC server
 type Server struct {
 	healthservice.UnimplementedHealthServer
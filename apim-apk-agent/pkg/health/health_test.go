@@ -0,0 +1,43 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	healthservice "github.com/wso2/apk/adapter/pkg/health/api/wso2/health/service"
+)
+
+// TestServiceCheck tests the checks of the services used by the HTTP health endpoints
+func TestServiceCheck(t *testing.T) {
+	testService := service("apk.apim.agent.internal.TestService")
+	// A service of which the state is not set is not healthy
+	assert.Error(t, testService.Check(nil))
+
+	testService.SetStatus(true)
+	assert.NoError(t, testService.Check(nil))
+	response, err := Server{}.Check(context.Background(), &healthservice.HealthCheckRequest{Service: string(testService)})
+	assert.NoError(t, err)
+	assert.Equal(t, healthservice.HealthCheckResponse_SERVING, response.Status)
+
+	testService.SetStatus(false)
+	assert.Error(t, testService.Check(nil))
+	assert.False(t, testService.IsHealthy())
+}
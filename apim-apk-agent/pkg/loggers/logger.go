@@ -42,6 +42,7 @@ const (
 	pkgSoapUtils   = "github.com/wso2/apk/adapter/pkg/soaputils"
 	pkgTransformer = "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/transformer"
 	pkgMgtServer   = "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/managementserver"
+	pkgAudit       = "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/audit"
 )
 
 // logger package references
@@ -57,6 +58,7 @@ var (
 	LoggerSubscription logging.Log
 	LoggerTransformer  logging.Log
 	LoggerMgtServer    logging.Log
+	LoggerAudit        logging.Log
 )
 
 func init() {
@@ -75,5 +77,6 @@ func UpdateLoggers() {
 	LoggerSoapUtils = logging.InitPackageLogger(pkgSoapUtils)
 	LoggerTransformer = logging.InitPackageLogger(pkgTransformer)
 	LoggerMgtServer = logging.InitPackageLogger(pkgMgtServer)
+	LoggerAudit = logging.InitPackageLogger(pkgAudit)
 	logrus.Info("Updated loggers")
 }
@@ -0,0 +1,116 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+package managementserver
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/audit"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+	"google.golang.org/grpc/peer"
+)
+
+var (
+	resyncHandler       func()
+	resyncInProgress    atomic.Bool
+	revisionAckProvider func() []RevisionAck
+)
+
+// SetResyncHandler registers the function which reloads all the resources from the control plane.
+// It is invoked when a resync is requested through the internal server.
+func SetResyncHandler(handler func()) {
+	resyncHandler = handler
+}
+
+// SetRevisionAckProvider registers the function which returns the revision deployment acknowledgements that are
+// not confirmed by the control plane yet.
+func SetRevisionAckProvider(provider func() []RevisionAck) {
+	revisionAckProvider = provider
+}
+
+// GetPendingRevisionAcks returns the revision deployment acknowledgements which are not confirmed by the control
+// plane yet
+func GetPendingRevisionAcks() []RevisionAck {
+	if revisionAckProvider == nil {
+		return []RevisionAck{}
+	}
+	return revisionAckProvider()
+}
+
+// handleResync triggers a full resync in the background. Only one resync runs at a time.
+func handleResync(c *gin.Context) {
+	if resyncHandler == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "Resync is not supported"})
+		return
+	}
+	if !resyncInProgress.CompareAndSwap(false, true) {
+		c.JSON(http.StatusConflict, gin.H{"error": "A resync is already in progress"})
+		return
+	}
+	go func() {
+		defer resyncInProgress.Store(false)
+		logger.LoggerMgtServer.Info("Starting the resync requested through the internal server")
+		resyncHandler()
+		logger.LoggerMgtServer.Info("Completed the resync requested through the internal server")
+	}()
+	c.JSON(http.StatusAccepted, gin.H{"status": "Resync started"})
+}
+
+// GetAllGRPCClients returns the gRPC clients which are connected to the agent
+func GetAllGRPCClients() []GRPCClient {
+	grpcClients := []GRPCClient{}
+	for clientID, stream := range utils.GetAllClientConnections() {
+		grpcClient := GRPCClient{ID: clientID}
+		if p, ok := peer.FromContext(stream.Context()); ok && p.Addr != nil {
+			grpcClient.Address = p.Addr.String()
+		}
+		grpcClients = append(grpcClients, grpcClient)
+	}
+	sort.Slice(grpcClients, func(i, j int) bool {
+		return grpcClients[i].ID < grpcClients[j].ID
+	})
+	return grpcClients
+}
+
+// handleAuditQuery returns the audit records filtered by the eventId, apiUuid, action, since (RFC 3339) and limit
+// query parameters
+func handleAuditQuery(c *gin.Context) {
+	query := audit.Query{EventID: c.Query("eventId"), APIUUID: c.Query("apiUuid"), Action: c.Query("action")}
+	if since := c.Query("since"); since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid since parameter, it should be in RFC 3339 format"})
+			return
+		}
+		query.Since = sinceTime
+	}
+	if limit := c.Query("limit"); limit != "" {
+		limitValue, err := strconv.Atoi(limit)
+		if err != nil || limitValue < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit parameter"})
+			return
+		}
+		query.Limit = limitValue
+	}
+	c.JSON(http.StatusOK, AuditRecordList{List: audit.QueryRecords(query)})
+}
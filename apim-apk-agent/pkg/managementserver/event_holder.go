@@ -17,6 +17,9 @@
 package managementserver
 
 import (
+	"sync"
+
+	"github.com/wso2/apk/common-go-libs/constants"
 	eventHub "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
@@ -30,6 +33,9 @@ var (
 	rateLimitPolicyMap       map[string]eventHub.RateLimitPolicy
 	aiProviderMap            map[string]eventHub.AIProvider
 	subscriptionPolicyMap    map[string]eventHub.SubscriptionPolicy
+	keyManagerMap            map[string]eventHub.ResolvedKeyManager
+	// holderMutex guards the maps, since the events of different resources are processed concurrently
+	holderMutex sync.RWMutex
 )
 
 func init() {
@@ -40,25 +46,37 @@ func init() {
 	rateLimitPolicyMap = make(map[string]eventHub.RateLimitPolicy)
 	aiProviderMap = make(map[string]eventHub.AIProvider)
 	subscriptionPolicyMap = make(map[string]eventHub.SubscriptionPolicy)
+	keyManagerMap = make(map[string]eventHub.ResolvedKeyManager)
 }
 
 // AddAIProvider adds an AI provider to the aiProviderMap
 func AddAIProvider(aiProvider eventHub.AIProvider) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	if !utils.IsOrganizationAllowed(aiProvider.Organization) {
+		return
+	}
 	aiProviderMap[aiProvider.ID] = aiProvider
 }
 
 // GetAIProvider returns an AI provider from the aiProviderMap
 func GetAIProvider(id string) eventHub.AIProvider {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return aiProviderMap[id]
 }
 
 // DeleteAIProvider deletes an AI provider from the aiProviderMap
 func DeleteAIProvider(id string) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	delete(aiProviderMap, id)
 }
 
 // GetAllAIProviders returns all the AI providers in the aiProviderMap
 func GetAllAIProviders() []eventHub.AIProvider {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	var aiProviders []eventHub.AIProvider
 	for _, aiProvider := range aiProviderMap {
 		aiProviders = append(aiProviders, aiProvider)
@@ -66,28 +84,98 @@ func GetAllAIProviders() []eventHub.AIProvider {
 	return aiProviders
 }
 
-// AddRateLimitPolicy adds a rate limit policy to the rateLimitPolicyMap
+// AddKeyManager adds a key manager to the keyManagerMap
+func AddKeyManager(keyManager eventHub.ResolvedKeyManager) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	if !utils.IsOrganizationAllowed(keyManager.Organization) {
+		return
+	}
+	keyManagerMap[keyManager.Name+keyManager.Organization] = keyManager
+}
+
+// DeleteKeyManager deletes a key manager from the keyManagerMap
+func DeleteKeyManager(name string, organization string) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	delete(keyManagerMap, name+organization)
+}
+
+// AddAllKeyManagers replaces the keyManagerMap with the given key managers
+func AddAllKeyManagers(keyManagers []eventHub.ResolvedKeyManager) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	keyManagerMapTemp := make(map[string]eventHub.ResolvedKeyManager)
+	for _, keyManager := range keyManagers {
+		if utils.IsOrganizationAllowed(keyManager.Organization) {
+			keyManagerMapTemp[keyManager.Name+keyManager.Organization] = keyManager
+		}
+	}
+	keyManagerMap = keyManagerMapTemp
+}
+
+// GetAllKeyManagers returns all the key managers in the keyManagerMap
+func GetAllKeyManagers() []eventHub.ResolvedKeyManager {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
+	var keyManagers []eventHub.ResolvedKeyManager
+	for _, keyManager := range keyManagerMap {
+		keyManagers = append(keyManagers, keyManager)
+	}
+	return keyManagers
+}
+
+// GetAllSubscriptionPolicies returns all the subscription policies in the subscriptionPolicyMap
+func GetAllSubscriptionPolicies() []eventHub.SubscriptionPolicy {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
+	var subscriptionPolicies []eventHub.SubscriptionPolicy
+	for _, subscriptionPolicy := range subscriptionPolicyMap {
+		subscriptionPolicies = append(subscriptionPolicies, subscriptionPolicy)
+	}
+	return subscriptionPolicies
+}
+
+// AddRateLimitPolicy adds a rate limit policy to the rateLimitPolicyMap. The policy is not streamed to the
+// common-controllers, it is applied as a CR instead.
 func AddRateLimitPolicy(rateLimitPolicy eventHub.RateLimitPolicy) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	if !utils.IsOrganizationAllowed(rateLimitPolicy.TenantDomain) {
+		return
+	}
 	rateLimitPolicyMap[rateLimitPolicy.Name+rateLimitPolicy.TenantDomain] = rateLimitPolicy
 }
 
-// AddSubscriptionPolicy adds a rate limit policy to the subscriptionPolicyMap
+// AddSubscriptionPolicy adds a subscription policy to the subscriptionPolicyMap. The policy is not streamed to the
+// common-controllers, it is applied as a CR instead.
 func AddSubscriptionPolicy(rateLimitPolicy eventHub.SubscriptionPolicy) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	if !utils.IsOrganizationAllowed(rateLimitPolicy.TenantDomain) {
+		return
+	}
 	subscriptionPolicyMap[rateLimitPolicy.Name+rateLimitPolicy.TenantDomain] = rateLimitPolicy
 }
 
 // GetSubscriptionPolicies return the subscription policy map
 func GetSubscriptionPolicies() map[string]eventHub.SubscriptionPolicy {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return subscriptionPolicyMap
 }
 
 // GetRateLimitPolicy returns a rate limit policy from the rateLimitPolicyMap
 func GetRateLimitPolicy(name string, tenantDomain string) eventHub.RateLimitPolicy {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return rateLimitPolicyMap[name+tenantDomain]
 }
 
 // GetAllRateLimitPolicies returns all the rate limit policies in the rateLimitPolicyMap
 func GetAllRateLimitPolicies() []eventHub.RateLimitPolicy {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	var rateLimitPolicies []eventHub.RateLimitPolicy
 	for _, rateLimitPolicy := range rateLimitPolicyMap {
 		rateLimitPolicies = append(rateLimitPolicies, rateLimitPolicy)
@@ -97,43 +185,80 @@ func GetAllRateLimitPolicies() []eventHub.RateLimitPolicy {
 
 // DeleteRateLimitPolicy deletes a rate limit policy from the rateLimitPolicyMap
 func DeleteRateLimitPolicy(name string, tenantDomain string) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	delete(rateLimitPolicyMap, name+tenantDomain)
 }
 
 // DeleteSubscriptionPolicy deletes a subscription policy from the subscriptionPolicyMap
 func DeleteSubscriptionPolicy(name string, tenantDomain string) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	delete(subscriptionPolicyMap, name+tenantDomain)
 }
 
 // UpdateRateLimitPolicy updates a rate limit policy in the rateLimitPolicyMap
 func UpdateRateLimitPolicy(name string, tenantDomain string, rateLimitPolicy eventHub.RateLimitPolicy) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	if !utils.IsOrganizationAllowed(tenantDomain) {
+		return
+	}
 	rateLimitPolicyMap[name+tenantDomain] = rateLimitPolicy
 }
 
-// AddApplication adds an application to the applicationMap
+// AddApplication adds an application to the applicationMap and streams it to the common-controllers
 func AddApplication(application Application) {
+	if !utils.IsOrganizationAllowed(application.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	applicationMap[application.UUID] = application
+	holderMutex.Unlock()
+	publishApplicationEvent(constants.ApplicationCreated, application)
 }
 
-// AddSubscription adds a subscription to the subscriptionMap
+// AddSubscription adds a subscription to the subscriptionMap and streams it to the common-controllers
 func AddSubscription(subscription Subscription) {
+	if !utils.IsOrganizationAllowed(subscription.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	subscriptionMap[subscription.UUID] = subscription
+	holderMutex.Unlock()
+	publishSubscriptionEvent(constants.SubscriptionCreated, subscription)
 }
 
-// AddApplicationMapping adds an application mapping to the applicationMappingMap
+// AddApplicationMapping adds an application mapping to the applicationMappingMap and streams it to the
+// common-controllers
 func AddApplicationMapping(applicationMapping ApplicationMapping) {
+	if !utils.IsOrganizationAllowed(applicationMapping.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	applicationMappingMap[applicationMapping.UUID] = applicationMapping
+	holderMutex.Unlock()
+	publishApplicationMappingEvent(constants.ApplicationMappingCreated, applicationMapping)
 }
 
-// AddApplicationKeyMapping adds an application key mapping to the applicationKeyMappingMap
+// AddApplicationKeyMapping adds an application key mapping to the applicationKeyMappingMap and streams it to the
+// common-controllers
 func AddApplicationKeyMapping(applicationKeyMapping ApplicationKeyMapping) {
+	if !utils.IsOrganizationAllowed(applicationKeyMapping.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	uuid := utils.GetUniqueIDOfApplicationKeyMapping(applicationKeyMapping.ApplicationUUID, applicationKeyMapping.KeyType, applicationKeyMapping.SecurityScheme, applicationKeyMapping.EnvID, applicationKeyMapping.Organization)
 	loggers.LoggerMgtServer.Infof("Adding application key mapping with uuid: %v", uuid)
 	applicationKeyMappingMap[uuid] = applicationKeyMapping
+	holderMutex.Unlock()
+	publishApplicationKeyMappingEvent(constants.ApplicationKeyMappingCreated, applicationKeyMapping)
 }
 
 // GetAllApplications returns all the applications in the applicationMap
 func GetAllApplications() []ResolvedApplication {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	var applications []ResolvedApplication
 	for _, application := range applicationMap {
 		resolvedApplication := marshalApplication(application)
@@ -154,6 +279,8 @@ func marshalApplication(application Application) ResolvedApplication {
 
 // GetAllSubscriptions returns all the subscriptions in the subscriptionMap
 func GetAllSubscriptions() []Subscription {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	var subscriptions []Subscription
 	for _, subscription := range subscriptionMap {
 		subscriptions = append(subscriptions, subscription)
@@ -163,6 +290,8 @@ func GetAllSubscriptions() []Subscription {
 
 // GetAllApplicationMappings returns all the application mappings in the applicationMappingMap
 func GetAllApplicationMappings() []ApplicationMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	var applicationMappings []ApplicationMapping
 	for _, applicationMapping := range applicationMappingMap {
 		applicationMappings = append(applicationMappings, applicationMapping)
@@ -172,67 +301,136 @@ func GetAllApplicationMappings() []ApplicationMapping {
 
 // GetApplication returns an application from the applicationMap
 func GetApplication(uuid string) Application {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return applicationMap[uuid]
 }
 
 // GetSubscription returns a subscription from the subscriptionMap
 func GetSubscription(uuid string) Subscription {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return subscriptionMap[uuid]
 }
 
 // GetApplicationMapping returns an application mapping from the applicationMappingMap
 func GetApplicationMapping(uuid string) ApplicationMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return applicationMappingMap[uuid]
 }
 
 // GetApplicationKeyMapping returns an application key mapping from the applicationKeyMappingMap
 func GetApplicationKeyMapping(uuid string) ApplicationKeyMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	return applicationKeyMappingMap[uuid]
 }
 
-// DeleteApplication deletes an application from the applicationMap
+// DeleteApplication deletes an application from the applicationMap and streams the deletion to the
+// common-controllers
 func DeleteApplication(uuid string) {
+	holderMutex.Lock()
+	application, exists := applicationMap[uuid]
+	if !exists {
+		application = Application{UUID: uuid}
+	}
 	delete(applicationMap, uuid)
+	holderMutex.Unlock()
+	publishApplicationEvent(constants.ApplicationDeleted, application)
 }
 
-// DeleteSubscription deletes a subscription from the subscriptionMap
+// DeleteSubscription deletes a subscription from the subscriptionMap and streams the deletion to the
+// common-controllers
 func DeleteSubscription(uuid string) {
+	holderMutex.Lock()
+	subscription, exists := subscriptionMap[uuid]
+	if !exists {
+		subscription = Subscription{UUID: uuid}
+	}
 	delete(subscriptionMap, uuid)
+	holderMutex.Unlock()
+	publishSubscriptionEvent(constants.SubscriptionDeleted, subscription)
 }
 
-// DeleteApplicationMapping deletes an application mapping from the applicationMappingMap
+// DeleteApplicationMapping deletes an application mapping from the applicationMappingMap and streams the deletion
+// to the common-controllers
 func DeleteApplicationMapping(uuid string) {
+	holderMutex.Lock()
+	applicationMapping, exists := applicationMappingMap[uuid]
+	if !exists {
+		applicationMapping = ApplicationMapping{UUID: uuid}
+	}
 	delete(applicationMappingMap, uuid)
+	holderMutex.Unlock()
+	publishApplicationMappingEvent(constants.ApplicationMappingDeleted, applicationMapping)
 }
 
-// DeleteApplicationKeyMapping deletes an application key mapping from the applicationKeyMappingMap
+// DeleteApplicationKeyMapping deletes an application key mapping from the applicationKeyMappingMap and streams the
+// deletion to the common-controllers
 func DeleteApplicationKeyMapping(uuid string) {
+	holderMutex.Lock()
 	loggers.LoggerMgtServer.Infof("Deleting application key mapping with uuid: %v", uuid)
+	applicationKeyMapping, exists := applicationKeyMappingMap[uuid]
+	if !exists {
+		holderMutex.Unlock()
+		return
+	}
 	delete(applicationKeyMappingMap, uuid)
+	holderMutex.Unlock()
+	publishApplicationKeyMappingEvent(constants.ApplicationKeyMappingDeleted, applicationKeyMapping)
 }
 
-// UpdateApplication updates an application in the applicationMap
+// UpdateApplication updates an application in the applicationMap and streams it to the common-controllers
 func UpdateApplication(uuid string, application Application) {
+	if !utils.IsOrganizationAllowed(application.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	applicationMap[uuid] = application
+	holderMutex.Unlock()
+	publishApplicationEvent(constants.ApplicationUpdated, application)
 }
 
-// UpdateSubscription updates a subscription in the subscriptionMap
+// UpdateSubscription updates a subscription in the subscriptionMap and streams it to the common-controllers
 func UpdateSubscription(uuid string, subscription Subscription) {
+	if !utils.IsOrganizationAllowed(subscription.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	subscriptionMap[uuid] = subscription
+	holderMutex.Unlock()
+	publishSubscriptionEvent(constants.SubscriptionUpdated, subscription)
 }
 
-// UpdateApplicationMapping updates an application mapping in the applicationMappingMap
+// UpdateApplicationMapping updates an application mapping in the applicationMappingMap and streams it to the
+// common-controllers
 func UpdateApplicationMapping(uuid string, applicationMapping ApplicationMapping) {
+	if !utils.IsOrganizationAllowed(applicationMapping.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	applicationMappingMap[uuid] = applicationMapping
+	holderMutex.Unlock()
+	publishApplicationMappingEvent(constants.ApplicationMappingUpdated, applicationMapping)
 }
 
-// UpdateApplicationKeyMapping updates an application key mapping in the applicationKeyMappingMap
+// UpdateApplicationKeyMapping updates an application key mapping in the applicationKeyMappingMap and streams it to
+// the common-controllers
 func UpdateApplicationKeyMapping(uuid string, applicationKeyMapping ApplicationKeyMapping) {
+	if !utils.IsOrganizationAllowed(applicationKeyMapping.Organization) {
+		return
+	}
+	holderMutex.Lock()
 	applicationKeyMappingMap[uuid] = applicationKeyMapping
+	holderMutex.Unlock()
+	publishApplicationKeyMappingEvent(constants.ApplicationKeyMappingUpdated, applicationKeyMapping)
 }
 
 // GetApplicationKeyMappingByApplicationUUID returns an application key mapping from the applicationKeyMappingMap
 func GetApplicationKeyMappingByApplicationUUID(uuid string) ApplicationKeyMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	for _, applicationKeyMapping := range applicationKeyMappingMap {
 		if applicationKeyMapping.ApplicationUUID == uuid {
 			return applicationKeyMapping
@@ -243,6 +441,8 @@ func GetApplicationKeyMappingByApplicationUUID(uuid string) ApplicationKeyMappin
 
 // GetApplicationKeyMappingByApplicationUUIDAndEnvID returns an application key mapping from the applicationKeyMappingMap
 func GetApplicationKeyMappingByApplicationUUIDAndEnvID(uuid string, envID string) ApplicationKeyMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	for _, applicationKeyMapping := range applicationKeyMappingMap {
 		if applicationKeyMapping.ApplicationUUID == uuid && applicationKeyMapping.EnvID == envID {
 			return applicationKeyMapping
@@ -253,6 +453,8 @@ func GetApplicationKeyMappingByApplicationUUIDAndEnvID(uuid string, envID string
 
 // GetApplicationKeyMappingByApplicationUUIDAndSecurityScheme returns an application key mapping from the applicationKeyMappingMap
 func GetApplicationKeyMappingByApplicationUUIDAndSecurityScheme(uuid string, securityScheme string) ApplicationKeyMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	for _, applicationKeyMapping := range applicationKeyMappingMap {
 		if applicationKeyMapping.ApplicationUUID == uuid && applicationKeyMapping.SecurityScheme == securityScheme {
 			return applicationKeyMapping
@@ -263,6 +465,8 @@ func GetApplicationKeyMappingByApplicationUUIDAndSecurityScheme(uuid string, sec
 
 // GetApplicationKeyMappingByApplicationUUIDAndSecuritySchemeAndEnvID returns an application key mapping from the applicationKeyMappingMap
 func GetApplicationKeyMappingByApplicationUUIDAndSecuritySchemeAndEnvID(uuid string, securityScheme string, envID string) ApplicationKeyMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	for _, applicationKeyMapping := range applicationKeyMappingMap {
 		if applicationKeyMapping.ApplicationUUID == uuid && applicationKeyMapping.SecurityScheme == securityScheme && applicationKeyMapping.EnvID == envID {
 			return applicationKeyMapping
@@ -273,6 +477,8 @@ func GetApplicationKeyMappingByApplicationUUIDAndSecuritySchemeAndEnvID(uuid str
 
 // GetApplicationMappingByApplicationUUID returns an application mapping from the applicationMappingMap
 func GetApplicationMappingByApplicationUUID(uuid string) ApplicationMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	for _, applicationMapping := range applicationMappingMap {
 		if applicationMapping.ApplicationRef == uuid {
 			return applicationMapping
@@ -283,6 +489,8 @@ func GetApplicationMappingByApplicationUUID(uuid string) ApplicationMapping {
 
 // GetApplicationMappingByApplicationUUIDAndSubscriptionUUID returns an application mapping from the applicationMappingMap
 func GetApplicationMappingByApplicationUUIDAndSubscriptionUUID(uuid string, subscriptionUUID string) ApplicationMapping {
+	holderMutex.RLock()
+	defer holderMutex.RUnlock()
 	for _, applicationMapping := range applicationMappingMap {
 		if applicationMapping.ApplicationRef == uuid && applicationMapping.SubscriptionRef == subscriptionUUID {
 			return applicationMapping
@@ -293,46 +501,84 @@ func GetApplicationMappingByApplicationUUIDAndSubscriptionUUID(uuid string, subs
 
 // DeleteAllApplications deletes all the applications in the applicationMap
 func DeleteAllApplications() {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	applicationMap = make(map[string]Application)
 }
 
 // DeleteAllSubscriptions deletes all the subscriptions in the subscriptionMap
 func DeleteAllSubscriptions() {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	subscriptionMap = make(map[string]Subscription)
 }
 
 // DeleteAllApplicationMappings deletes all the application mappings in the applicationMappingMap
 func DeleteAllApplicationMappings() {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	applicationMappingMap = make(map[string]ApplicationMapping)
 }
 
 // DeleteAllApplicationKeyMappings deletes all the application key mappings in the applicationKeyMappingMap
 func DeleteAllApplicationKeyMappings() {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	applicationKeyMappingMap = make(map[string]ApplicationKeyMapping)
 }
 
 // AddAllSubscriptions adds all the subscriptions in the subscriptionMap
 func AddAllSubscriptions(subscriptionMapTemp map[string]Subscription) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	for key, resource := range subscriptionMapTemp {
+		if !utils.IsOrganizationAllowed(resource.Organization) {
+			delete(subscriptionMapTemp, key)
+		}
+	}
 	subscriptionMap = subscriptionMapTemp
 }
 
 // AddAllApplications adds all the applications in the applicationMap
 func AddAllApplications(applicationMapTemp map[string]Application) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	for key, resource := range applicationMapTemp {
+		if !utils.IsOrganizationAllowed(resource.Organization) {
+			delete(applicationMapTemp, key)
+		}
+	}
 	applicationMap = applicationMapTemp
 }
 
 // AddAllApplicationMappings adds all the application mappings in the applicationMappingMap
 func AddAllApplicationMappings(applicationMappingMapTemp map[string]ApplicationMapping) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	for key, resource := range applicationMappingMapTemp {
+		if !utils.IsOrganizationAllowed(resource.Organization) {
+			delete(applicationMappingMapTemp, key)
+		}
+	}
 	applicationMappingMap = applicationMappingMapTemp
 }
 
 // AddAllApplicationKeyMappings adds all the application key mappings in the applicationKeyMappingMap
 func AddAllApplicationKeyMappings(applicationKeyMappingMapTemp map[string]ApplicationKeyMapping) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
+	for key, resource := range applicationKeyMappingMapTemp {
+		if !utils.IsOrganizationAllowed(resource.Organization) {
+			delete(applicationKeyMappingMapTemp, key)
+		}
+	}
 	applicationKeyMappingMap = applicationKeyMappingMapTemp
 }
 
 // DeleteAllSubscriptionsByApplicationsUUID deletes all the subscriptions in the subscriptionMap
 func DeleteAllSubscriptionsByApplicationsUUID(uuid string) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	for _, subscription := range subscriptionMap {
 		if subscription.Organization == uuid {
 			delete(subscriptionMap, subscription.UUID)
@@ -342,6 +588,8 @@ func DeleteAllSubscriptionsByApplicationsUUID(uuid string) {
 
 // DeleteAllApplicationMappingsByApplicationsUUID deletes all the application mappings in the applicationMappingMap
 func DeleteAllApplicationMappingsByApplicationsUUID(uuid string) {
+	holderMutex.Lock()
+	defer holderMutex.Unlock()
 	for _, applicationMapping := range applicationMappingMap {
 		if applicationMapping.UUID == uuid {
 			delete(applicationMappingMap, applicationMapping.UUID)
@@ -0,0 +1,91 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package managementserver
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/subscription"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+)
+
+// The changes of the applications, subscriptions and their mappings in the event holder are streamed to the
+// common-controllers as delta events, hence they do not reload all the data for a single change. The bulk updates
+// are followed by an AllEvents event instead, upon which the common-controllers reload all the data. The events are
+// built from copies of the changed entries and sent after the event holder is unlocked, hence a slow stream does not
+// block the event holder.
+//
+// The rate-limit and subscription policies are not streamed, since the subscription.Event message of the event
+// stream has no field for them. They are applied as RateLimitPolicy and AIRateLimitPolicy CRs by the synchronizer
+// and the notification listener instead, which the common-controllers watch.
+
+// sendEvent streams the event to the common-controllers. The current time is used if the change does not carry
+// a timestamp.
+func sendEvent(eventType string, timeStamp int64, event *subscription.Event) {
+	if timeStamp == 0 {
+		timeStamp = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	event.Uuid = uuid.New().String()
+	event.Type = eventType
+	event.TimeStamp = timeStamp
+	utils.SendEvent(event)
+}
+
+func publishApplicationEvent(eventType string, application Application) {
+	sendEvent(eventType, application.TimeStamp, &subscription.Event{Application: &subscription.Application{
+		Uuid:         application.UUID,
+		Name:         application.Name,
+		Owner:        application.Owner,
+		Organization: application.Organization,
+		Attributes:   application.Attributes,
+	}})
+}
+
+func publishSubscriptionEvent(eventType string, sub Subscription) {
+	eventSubscription := &subscription.Subscription{
+		Uuid:          sub.UUID,
+		SubStatus:     sub.SubStatus,
+		Organization:  sub.Organization,
+		RatelimitTier: sub.RateLimit,
+	}
+	if sub.SubscribedAPI != nil {
+		eventSubscription.SubscribedApi = &subscription.SubscribedAPI{Name: sub.SubscribedAPI.Name, Version: sub.SubscribedAPI.Version}
+	}
+	sendEvent(eventType, sub.TimeStamp, &subscription.Event{Subscription: eventSubscription})
+}
+
+func publishApplicationMappingEvent(eventType string, applicationMapping ApplicationMapping) {
+	sendEvent(eventType, 0, &subscription.Event{ApplicationMapping: &subscription.ApplicationMapping{
+		Uuid:            applicationMapping.UUID,
+		ApplicationRef:  applicationMapping.ApplicationRef,
+		SubscriptionRef: applicationMapping.SubscriptionRef,
+		Organization:    applicationMapping.Organization,
+	}})
+}
+
+func publishApplicationKeyMappingEvent(eventType string, applicationKeyMapping ApplicationKeyMapping) {
+	sendEvent(eventType, applicationKeyMapping.Timestamp, &subscription.Event{ApplicationKeyMapping: &subscription.ApplicationKeyMapping{
+		ApplicationUUID:       applicationKeyMapping.ApplicationUUID,
+		SecurityScheme:        applicationKeyMapping.SecurityScheme,
+		ApplicationIdentifier: applicationKeyMapping.ApplicationIdentifier,
+		KeyType:               applicationKeyMapping.KeyType,
+		Organization:          applicationKeyMapping.Organization,
+		EnvID:                 applicationKeyMapping.EnvID,
+	}})
+}
@@ -17,12 +17,28 @@
 package managementserver
 
 import (
+	"sync"
+
 	apkmgt "github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/service/apkmgt"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
 	"google.golang.org/grpc/metadata"
 )
 
+var (
+	// streamsClosed is closed to end the event streams of the connected common-controllers
+	streamsClosed    = make(chan struct{})
+	closeStreamsOnce sync.Once
+)
+
+// CloseEventStreams ends the event streams of the connected common-controllers, which otherwise keep the gRPC
+// server from being stopped gracefully. The common-controllers reconnect to another replica of the agent.
+func CloseEventStreams() {
+	closeStreamsOnce.Do(func() {
+		close(streamsClosed)
+	})
+}
+
 // EventServer struct use to hold event server
 type EventServer struct {
 	apkmgt.UnimplementedEventStreamServiceServer
@@ -41,8 +57,12 @@ func (s EventServer) StreamEvents(req *apkmgt.Request, srv apkmgt.EventStreamSer
 	logger.LoggerMgtServer.Debugf("Enforcer ID : %v", commonControllerID[0])
 	utils.AddClientConnection(commonControllerID[0], srv)
 	utils.SendInitialEvent(srv)
-	<-srv.Context().Done()
-	logger.LoggerMgtServer.Infof("Connection closed by the client : %v", commonControllerID[0])
+	select {
+	case <-srv.Context().Done():
+		logger.LoggerMgtServer.Infof("Connection closed by the client : %v", commonControllerID[0])
+	case <-streamsClosed:
+		logger.LoggerMgtServer.Infof("Connection closed for the shutdown : %v", commonControllerID[0])
+	}
 	utils.DeleteClientConnection(commonControllerID[0])
 	return nil // Client closed the connection
 }
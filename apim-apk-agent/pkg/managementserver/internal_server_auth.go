@@ -0,0 +1,120 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+package managementserver
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/auth"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+)
+
+const (
+	// authTypeMTLS requires the clients to present a certificate signed by a CA in the agent truststore
+	authTypeMTLS = "mtls"
+	// authTypeJWT requires the clients to present a bearer token issued by the configured issuer
+	authTypeJWT = "jwt"
+	// authTypeNone does not authenticate the clients, except for the /admin endpoints, which require a client
+	// certificate signed by a CA in the agent truststore
+	authTypeNone = "none"
+
+	bearerPrefix      = "Bearer "
+	jwksClientTimeout = 30 * time.Second
+)
+
+// getTLSConfig returns the TLS configuration of the internal server for the given authentication type. The
+// certificates are served by the certificate provider, hence the rotated certificates are used without a restart.
+func getTLSConfig(authType string) *tls.Config {
+	template := &tls.Config{
+		ClientAuth: tls.NoClientCert,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	switch authType {
+	case authTypeMTLS:
+		template.ClientAuth = tls.RequireAndVerifyClientCert
+	case authTypeNone:
+		// The certificate is requested so that the /admin endpoints can require it
+		template.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsutils.GetCertificateProvider().ServerTLSConfig(template)
+}
+
+// newJWTValidator creates the validator of the bearer tokens using the agent.internalServer.jwt configurations.
+// The tokens are validated with the JWKS of the issuer if it is configured, otherwise with its certificate.
+func newJWTValidator(conf *config.Config) (*auth.JWTValidator, error) {
+	jwtConf := conf.Agent.InternalServer.JWT
+	issuer := jwtConf.Issuer
+	if issuer == "" {
+		issuer = conf.ControlPlane.InternalKeyIssuer
+	}
+	if jwtConf.JwksURL != "" {
+		_, _, truststoreLocation := tlsutils.GetKeyLocations()
+		httpClient := &http.Client{
+			Timeout: jwksClientTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: tlsutils.GetClientTLSConfig(truststoreLocation, conf.ControlPlane.SkipSSLVerification),
+			},
+		}
+		return auth.NewJWKSValidator(issuer, jwtConf.Audience, jwtConf.JwksURL, httpClient), nil
+	}
+	if jwtConf.Certificate == "" {
+		return nil, fmt.Errorf("either the jwksURL or the certificate of the issuer should be configured")
+	}
+	certificate, err := os.ReadFile(jwtConf.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the certificate of the issuer: %w", err)
+	}
+	return auth.NewCertificateValidator(issuer, jwtConf.Audience, certificate)
+}
+
+// bearerTokenAuth returns a middleware which rejects the requests without a valid bearer token.
+func bearerTokenAuth(validator *auth.JWTValidator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if !strings.HasPrefix(authHeader, bearerPrefix) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing bearer token"})
+			return
+		}
+		if _, err := validator.Validate(strings.TrimPrefix(authHeader, bearerPrefix)); err != nil {
+			logger.LoggerMgtServer.Debugf("Rejected the request to %s: %v", c.Request.URL.Path, err)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid bearer token"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// clientCertificateAuth returns a middleware which rejects the requests of the clients which did not present a
+// certificate signed by a CA in the agent truststore.
+func clientCertificateAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.TLS == nil || len(c.Request.TLS.VerifiedChains) == 0 {
+			logger.LoggerMgtServer.Debugf("Rejected the request to %s without a client certificate", c.Request.URL.Path)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing client certificate"})
+			return
+		}
+		c.Next()
+	}
+}
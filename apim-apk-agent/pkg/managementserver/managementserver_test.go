@@ -17,9 +17,21 @@
 package managementserver
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/apk/common-go-libs/constants"
+	"github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/subscription"
+	eventHub "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/utils"
+	"google.golang.org/grpc"
 )
 
 func TestAddApplication(t *testing.T) {
@@ -343,3 +355,136 @@ func TestDeleteAllApplicationMappingsByApplicationsUUID(t *testing.T) {
 		assert.NotEqual(t, uuid, appMapping.ApplicationRef)
 	}
 }
+
+func TestUnmarshalOperationFilters(t *testing.T) {
+	payload := `{"path": "/pets", "verb": "GET", "scopes": ["read"], "filters": [
+		{"policyName": "apkHeaders", "requestHeaders": {"addHeaders": [{"headerName": "x-a", "headerValue": "a"}], "removeHeaders": ["x-b"]}},
+		{"policyName": "apkMirrorRequest", "urls": ["https://mirror"]},
+		{"policyName": "apkRedirectRequest", "url": "https://redirect"},
+		{"policyName": "unknown"}]}`
+	var operation OperationFromDP
+	assert.NoError(t, json.Unmarshal([]byte(payload), &operation))
+	assert.Equal(t, "/pets", operation.Path)
+	assert.Equal(t, []string{"read"}, operation.Scopes)
+	assert.Len(t, operation.Filters, 3)
+
+	policies := createOperationPolicies(operation.Filters)
+	assert.Len(t, policies.Request, 4)
+	assert.Empty(t, policies.Response)
+	assert.Equal(t, Header{Name: "x-b"}, policies.Request[1].Parameters)
+	assert.Equal(t, RedirectRequest{URL: "https://redirect"}, policies.Request[3].Parameters)
+}
+
+func TestExtractOperationsDoesNotShareOperationPolicies(t *testing.T) {
+	event := APICPEvent{API: API{
+		APIType: "GraphQL",
+		Operations: []OperationFromDP{
+			{Path: "hero", Verb: "QUERY", Scopes: []string{"read"}, Filters: []Filter{&APKMirrorRequest{URLs: []string{"https://mirror"}}}},
+			{Path: "addHero", Verb: "MUTATION"},
+		},
+	}}
+	operations, scopes, err := extractOperations(event)
+	assert.NoError(t, err)
+	assert.Len(t, operations, 2)
+	assert.Len(t, operations[0].OperationPolicies.Request, 1)
+	assert.Empty(t, operations[1].OperationPolicies.Request)
+	assert.Len(t, scopes, 1)
+}
+
+func TestResolveThrottlingPolicy(t *testing.T) {
+	policy := eventHub.RateLimitPolicy{Name: "10PerMin", TenantDomain: "Org1"}
+	policy.DefaultLimit.RequestCount.RequestCount = 10
+	policy.DefaultLimit.RequestCount.UnitTime = 1
+	policy.DefaultLimit.RequestCount.TimeUnit = "min"
+	AddRateLimitPolicy(policy)
+	defer DeleteRateLimitPolicy(policy.Name, policy.TenantDomain)
+
+	policyName, err := resolveThrottlingPolicy(&RateLimit{RequestsPerUnit: 10, Unit: "Minute"}, "Org1")
+	assert.NoError(t, err)
+	assert.Equal(t, "10PerMin", policyName)
+	policyName, err = resolveThrottlingPolicy(nil, "Org1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Unlimited", policyName)
+
+	var mappingErr *RateLimitMappingError
+	_, err = resolveThrottlingPolicy(&RateLimit{RequestsPerUnit: 10, Unit: "Hour"}, "Org1")
+	assert.ErrorAs(t, err, &mappingErr, "Should not fall back to the Unlimited policy")
+	_, err = resolveThrottlingPolicy(&RateLimit{RequestsPerUnit: 10, Unit: "Minute"}, "Org2")
+	assert.ErrorAs(t, err, &mappingErr)
+	assert.Equal(t, "Org2", mappingErr.Organization)
+	_, err = resolveSubscriptionPolicy(&RateLimit{RequestsPerUnit: 10, Unit: "Minute"}, "Org1")
+	assert.ErrorAs(t, err, &mappingErr)
+	assert.Equal(t, "subscription", mappingErr.PolicyType)
+}
+
+func TestResolveEnvironments(t *testing.T) {
+	envLabel := []string{"Default"}
+	assert.Equal(t, []string{"Default"}, resolveEnvironments(API{}, envLabel))
+	assert.Equal(t, []string{"Gateway1"}, resolveEnvironments(API{Environment: "Gateway1"}, envLabel))
+	assert.Equal(t, []string{"Gateway1", "Gateway2"},
+		resolveEnvironments(API{Environment: "Gateway1", Environments: []string{"Gateway1", "Gateway2"}}, envLabel))
+}
+
+// eventRecorder records the events streamed to a common-controller
+type eventRecorder struct {
+	grpc.ServerStream
+	events []*subscription.Event
+}
+
+func (r *eventRecorder) Send(event *subscription.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *eventRecorder) Context() context.Context {
+	return context.Background()
+}
+
+// TestEventHolderStreamsDeltas tests that the changes of the event holder are streamed as typed events
+func TestEventHolderStreamsDeltas(t *testing.T) {
+	recorder := &eventRecorder{}
+	utils.AddClientConnection("recorder", recorder)
+	defer utils.DeleteClientConnection("recorder")
+
+	AddApplication(Application{UUID: "app1", Name: "App1", Organization: "Org1", TimeStamp: 100})
+	UpdateSubscription("sub1", Subscription{UUID: "sub1", Organization: "Org1", SubscribedAPI: &SubscribedAPI{Name: "API1", Version: "v1"}})
+	DeleteApplication("app1")
+	DeleteApplicationKeyMapping("unknown")
+
+	assert.Len(t, recorder.events, 3)
+	assert.Equal(t, constants.ApplicationCreated, recorder.events[0].Type)
+	assert.Equal(t, "app1", recorder.events[0].Application.Uuid)
+	assert.Equal(t, int64(100), recorder.events[0].TimeStamp)
+	assert.Equal(t, constants.SubscriptionUpdated, recorder.events[1].Type)
+	assert.Equal(t, "API1", recorder.events[1].Subscription.SubscribedApi.Name)
+	assert.NotZero(t, recorder.events[1].TimeStamp)
+	assert.Equal(t, constants.ApplicationDeleted, recorder.events[2].Type)
+	assert.Equal(t, "App1", recorder.events[2].Application.Name)
+	assert.NotEqual(t, recorder.events[0].Uuid, recorder.events[2].Uuid)
+}
+
+func TestClientCertificateAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	admin := r.Group("/admin")
+	admin.Use(clientCertificateAuth())
+	admin.GET("/grpcclients", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/admin/grpcclients", nil))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, "Should reject a request without TLS")
+
+	request := httptest.NewRequest(http.MethodGet, "/admin/grpcclients", nil)
+	request.TLS = &tls.ConnectionState{}
+	recorder = httptest.NewRecorder()
+	r.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code, "Should reject a request without a verified certificate")
+
+	request = httptest.NewRequest(http.MethodGet, "/admin/grpcclients", nil)
+	request.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}
+	recorder = httptest.NewRecorder()
+	r.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -18,11 +18,14 @@ package managementserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
@@ -32,19 +35,58 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	invalidPayloadError   = "INVALID_PAYLOAD"
+	archiveCreationError  = "ARCHIVE_CREATION_FAILED"
+	importAPIError        = "API_IMPORT_FAILED"
+	undeployRevisionError = "REVISION_UNDEPLOY_FAILED"
+	rateLimitMappingError = "RATELIMIT_MAPPING_FAILED"
+	unlimitedPolicy       = "Unlimited"
+)
+
+var (
+	internalServer      *http.Server
+	internalServerMutex sync.Mutex
+)
+
 func init() {
 }
 
-// StartInternalServer starts the internal server
-func StartInternalServer(port uint) {
+// StartInternalServer starts the internal server in the background. An error is returned without starting the server
+// if the client authentication of it can not be configured.
+func StartInternalServer(port uint) error {
 	cpConfig, err := config.ReadConfigs()
 	envLabel := []string{"Default"}
 	if err == nil {
 		envLabel = cpConfig.ControlPlane.EnvironmentLabels
 	}
+	authType := authTypeNone
+	if err == nil && cpConfig.Agent.InternalServer.AuthType != "" {
+		authType = strings.ToLower(cpConfig.Agent.InternalServer.AuthType)
+	}
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.Default()
 
+	switch authType {
+	case authTypeMTLS:
+		logger.LoggerMgtServer.Info("Internal server requires the clients to authenticate with a certificate")
+	case authTypeJWT:
+		validator, err := newJWTValidator(cpConfig)
+		if err != nil {
+			logger.LoggerMgtServer.Errorf("Error while creating the bearer token validator of the internal server: %v", err)
+			return fmt.Errorf("unable to create the bearer token validator of the internal server: %w", err)
+		}
+		r.Use(bearerTokenAuth(validator))
+		logger.LoggerMgtServer.Info("Internal server requires the clients to authenticate with a bearer token")
+	case authTypeNone:
+		logger.LoggerMgtServer.Warn("Client authentication of the internal server is disabled except for the /admin endpoints, " +
+			"which require a client certificate. Set agent.internalServer.authType to mtls or jwt to require all the clients " +
+			"to authenticate")
+	default:
+		logger.LoggerMgtServer.Errorf("Unsupported authentication type of the internal server: %s", authType)
+		return fmt.Errorf("unsupported authentication type of the internal server: %s", authType)
+	}
+
 	r.GET("/applications", func(c *gin.Context) {
 		applicationList := GetAllApplications()
 		c.JSON(http.StatusOK, ResolvedApplicationList{List: applicationList})
@@ -57,35 +99,62 @@ func StartInternalServer(port uint) {
 		applicationMappingList := GetAllApplicationMappings()
 		c.JSON(http.StatusOK, ApplicationMappingList{List: applicationMappingList})
 	})
+	r.GET("/ratelimitpolicies", func(c *gin.Context) {
+		c.JSON(http.StatusOK, RateLimitPolicyList{List: GetAllRateLimitPolicies()})
+	})
+	r.GET("/subscriptionpolicies", func(c *gin.Context) {
+		c.JSON(http.StatusOK, SubscriptionPolicyList{List: GetAllSubscriptionPolicies()})
+	})
+	r.GET("/aiproviders", func(c *gin.Context) {
+		c.JSON(http.StatusOK, AIProviderList{List: GetAllAIProviders()})
+	})
+	r.GET("/keymanagers", func(c *gin.Context) {
+		c.JSON(http.StatusOK, KeyManagerList{List: GetAllKeyManagers()})
+	})
+	admin := r.Group("/admin")
+	if authType == authTypeNone {
+		// The /admin endpoints change the state of the agent, hence they are never served without authentication
+		admin.Use(clientCertificateAuth())
+	}
+	admin.POST("/resync", handleResync)
+	admin.GET("/grpcclients", func(c *gin.Context) {
+		c.JSON(http.StatusOK, GRPCClientList{List: GetAllGRPCClients()})
+	})
+	admin.GET("/revisionacks", func(c *gin.Context) {
+		c.JSON(http.StatusOK, RevisionAckList{List: GetPendingRevisionAcks()})
+	})
+	admin.GET("/audit", handleAuditQuery)
 	r.POST("/apis", func(c *gin.Context) {
 		var event APICPEvent
 		if err := c.ShouldBindJSON(&event); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			c.JSON(http.StatusBadRequest, ErrorResponse{Code: invalidPayloadError, Message: "Invalid API event payload", Description: err.Error()})
 			return
 		}
 		logger.LoggerMgtServer.Debugf("Recieved payload for endpoint /apis: %+v", event)
+		environments := resolveEnvironments(event.API, envLabel)
 		if event.Event == DeleteEvent {
 			logger.LoggerMgtServer.Infof("Delete event received with APIUUID: %s", event.API.APIUUID)
-			payload := []map[string]interface{}{
-				{
+			payload := []map[string]interface{}{}
+			for _, environment := range environments {
+				payload = append(payload, map[string]interface{}{
 					"revisionUuid":       event.API.RevisionID,
-					"name":               envLabel[0],
+					"name":               environment,
 					"vhost":              event.API.Vhost,
 					"displayOnDevportal": true,
-				},
+				})
 			}
 			jsonPayload, err := json.Marshal(payload)
 			logger.LoggerMgtServer.Debugf("Sending payload for revision undeploy: %+v", string(jsonPayload))
 			if err != nil {
 				logger.LoggerMgtServer.Errorf("Error while preparing payload to delete revision. Processed object: %+v", payload)
-				c.JSON(http.StatusInternalServerError, err.Error())
+				c.JSON(http.StatusInternalServerError, ErrorResponse{Code: undeployRevisionError, Message: "Error while preparing the undeploy revision request", Description: err.Error()})
 				return
 			}
 			// Delete the api
 			errorUndeployRevision := utils.DeleteAPIRevision(event.API.APIUUID, event.API.RevisionID, string(jsonPayload))
 			if errorUndeployRevision != nil {
 				logger.LoggerMgtServer.Errorf("Error while undeploying api revision. RevisionId: %s, API ID: %s . Sending error response to Adapter.", event.API.RevisionID, event.API.APIUUID)
-				c.JSON(http.StatusServiceUnavailable, errorUndeployRevision.Error())
+				c.JSON(newControlPlaneErrorResponse(undeployRevisionError, "Error while undeploying the API revision", errorUndeployRevision))
 				return
 			}
 			c.JSON(http.StatusOK, map[string]string{"message": "Success"})
@@ -99,8 +168,21 @@ func StartInternalServer(port uint) {
 					event.API.Definition = yaml
 				}
 			}
-			apiYaml, definition := createAPIYaml(&event)
-			deploymentContent := createDeployementYaml(event.API.Vhost)
+			apiYaml, definition, err := createAPIYaml(&event)
+			if err != nil {
+				var mappingErr *RateLimitMappingError
+				if errors.As(err, &mappingErr) {
+					logger.LoggerMgtServer.Errorf("Error while mapping the ratelimits of API %s: %v", event.API.APIUUID, err)
+					c.JSON(http.StatusUnprocessableEntity, ErrorResponse{Code: rateLimitMappingError,
+						Message: "No control plane policy enforces the ratelimit of the API", Description: err.Error()})
+					return
+				}
+				logger.LoggerMgtServer.Errorf("Error while creating the API project of API %s: %v", event.API.APIUUID, err)
+				c.JSON(http.StatusInternalServerError, ErrorResponse{Code: archiveCreationError,
+					Message: "Error while creating the API project archive", Description: err.Error()})
+				return
+			}
+			deploymentContent := createDeployementYaml(event.API.Vhost, environments)
 			logger.LoggerMgtServer.Debugf("Created apiYaml : %s, \n\n\n created definition file: %s", apiYaml, definition)
 			definitionPath := fmt.Sprintf("%s-%s/Definitions/swagger.yaml", event.API.APIName, event.API.APIVersion)
 			if strings.ToUpper(event.API.APIType) == "GRAPHQL" {
@@ -116,25 +198,55 @@ func StartInternalServer(port uint) {
 				Path:    definitionPath,
 				Content: definition,
 			}}
+			zipFiles = append(zipFiles, createEndpointCertificateFiles(fmt.Sprintf("%s-%s", event.API.APIName, event.API.APIVersion),
+				event.API.EndpointCertificates)...)
 			var buf bytes.Buffer
 			if err := utils.CreateZipFile(&buf, zipFiles); err != nil {
 				logger.LoggerMgtServer.Errorf("Error while creating apim zip file for api uuid: %s. Error: %+v", event.API.APIUUID, err)
+				c.JSON(http.StatusInternalServerError, ErrorResponse{Code: archiveCreationError, Message: "Error while creating the API project archive", Description: err.Error()})
+				return
 			}
 
 			id, revisionID, err := utils.ImportAPI(fmt.Sprintf("admin-%s-%s.zip", event.API.APIName, event.API.APIVersion), &buf)
 			if err != nil {
-				logger.LoggerMgtServer.Errorf("Error while importing API. Sending error response to Adapter.")
-				c.JSON(http.StatusServiceUnavailable, err.Error())
+				logger.LoggerMgtServer.Errorf("Error while importing API. Sending error response to Adapter. Error: %+v", err)
+				c.JSON(newControlPlaneErrorResponse(importAPIError, "Error while importing the API to the control plane", err))
 				return
 			}
 			c.JSON(http.StatusOK, map[string]string{"id": id, "revisionID": revisionID})
 		}
 	})
-	publicKeyLocation, privateKeyLocation, _ := config.GetKeyLocations()
-	r.RunTLS(fmt.Sprintf(":%d", port), publicKeyLocation, privateKeyLocation)
+	server := &http.Server{
+		Addr:      fmt.Sprintf(":%d", port),
+		Handler:   r,
+		TLSConfig: getTLSConfig(authType),
+	}
+	internalServerMutex.Lock()
+	internalServer = server
+	internalServerMutex.Unlock()
+	go func() {
+		if err := server.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			logger.LoggerMgtServer.Errorf("Error while starting the internal server: %v", err)
+		}
+	}()
+	return nil
+}
+
+// StopInternalServer shuts down the internal server once the requests in progress are completed, or the context
+// is done.
+func StopInternalServer(ctx context.Context) error {
+	internalServerMutex.Lock()
+	server := internalServer
+	internalServerMutex.Unlock()
+	if server == nil {
+		return nil
+	}
+	return server.Shutdown(ctx)
 }
 
-func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
+// createAPIYaml returns the api.yaml and the definition of the API project of the event. A RateLimitMappingError is
+// returned if a ratelimit of the API can not be mapped to a control plane policy.
+func createAPIYaml(apiCPEvent *APICPEvent) (string, string, error) {
 	config, err := config.ReadConfigs()
 	provider := "admin"
 	if err == nil {
@@ -142,10 +254,22 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 	}
 	context := removeVersionSuffix(apiCPEvent.API.BasePath, apiCPEvent.API.APIVersion)
 	operations, scopes, operationsErr := extractOperations(*apiCPEvent)
+	var mappingErr *RateLimitMappingError
+	if errors.As(operationsErr, &mappingErr) {
+		return "", "", operationsErr
+	}
 	if operationsErr != nil {
 		logger.LoggerMgtServer.Errorf("Error occured while extracting operations from open API: %s, \nError: %+v", apiCPEvent.API.Definition, operationsErr)
 		operations = []APIOperation{}
 	}
+	subscriptionPolicy, err := resolveSubscriptionPolicy(apiCPEvent.API.SubscriptionRateLimit, apiCPEvent.API.Organization)
+	if err != nil {
+		return "", "", err
+	}
+	throttlingPolicy, err := resolveThrottlingPolicy(apiCPEvent.API.RateLimit, apiCPEvent.API.Organization)
+	if err != nil {
+		return "", "", err
+	}
 	sandEndpoint := ""
 	if apiCPEvent.API.SandEndpoint != "" {
 		sandEndpoint = fmt.Sprintf("%s://%s", apiCPEvent.API.EndpointProtocol, apiCPEvent.API.SandEndpoint)
@@ -200,37 +324,12 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 					"url": prodEndpoint,
 				},
 				"endpoint_security": map[string]interface{}{
-					"sandbox": map[string]interface{}{
-						"apiKeyValue":                      apiCPEvent.API.SandEndpointSecurity.APIKeyValue,
-						"apiKeyIdentifier":                 apiCPEvent.API.SandEndpointSecurity.APIKeyName,
-						"apiKeyIdentifierType":             "HEADER",
-						"type":                             apiCPEvent.API.SandEndpointSecurity.SecurityType,
-						"username":                         apiCPEvent.API.SandEndpointSecurity.BasicUsername,
-						"password":                         apiCPEvent.API.SandEndpointSecurity.BasicPassword,
-						"enabled":                          apiCPEvent.API.SandEndpointSecurity.Enabled,
-						"additionalProperties":             map[string]interface{}{},
-						"customParameters":                 map[string]interface{}{},
-						"connectionTimeoutDuration":        -1.0,
-						"socketTimeoutDuration":            -1.0,
-						"connectionRequestTimeoutDuration": -1.0,
-					},
-					"production": map[string]interface{}{
-						"apiKeyValue":                      apiCPEvent.API.ProdEndpointSecurity.APIKeyValue,
-						"apiKeyIdentifier":                 apiCPEvent.API.ProdEndpointSecurity.APIKeyName,
-						"apiKeyIdentifierType":             "HEADER",
-						"type":                             apiCPEvent.API.ProdEndpointSecurity.SecurityType,
-						"username":                         apiCPEvent.API.ProdEndpointSecurity.BasicUsername,
-						"password":                         apiCPEvent.API.ProdEndpointSecurity.BasicPassword,
-						"enabled":                          apiCPEvent.API.ProdEndpointSecurity.Enabled,
-						"additionalProperties":             map[string]interface{}{},
-						"customParameters":                 map[string]interface{}{},
-						"connectionTimeoutDuration":        -1.0,
-						"socketTimeoutDuration":            -1.0,
-						"connectionRequestTimeoutDuration": -1.0,
-					},
+					"sandbox":    createEndpointSecurity(apiCPEvent.API.SandEndpointSecurity),
+					"production": createEndpointSecurity(apiCPEvent.API.ProdEndpointSecurity),
 				},
 			},
-			"policies":             []string{"Unlimited"},
+			"policies":             []string{subscriptionPolicy},
+			"apiThrottlingPolicy":  throttlingPolicy,
 			"gatewayType":          "wso2/apk",
 			"gatewayVendor":        "wso2",
 			"operations":           operations,
@@ -244,10 +343,9 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 	if len(subTypeConfiguration) > 0 {
 		data["data"].(map[string]interface{})["subtypeConfiguration"] = subTypeConfiguration
 	}
-	// TODO when we start to process sandbox we need to have this if condition. For now we remove sandbox endpoint always.
-	// if apiCPEvent.API.SandEndpoint == "" {
-	delete(data["data"].(map[string]interface{})["endpointConfig"].(map[string]interface{}), "sandbox_endpoints")
-	// }
+	if apiCPEvent.API.SandEndpoint == "" {
+		delete(data["data"].(map[string]interface{})["endpointConfig"].(map[string]interface{}), "sandbox_endpoints")
+	}
 	if apiCPEvent.API.ProdEndpoint == "" {
 		delete(data["data"].(map[string]interface{})["endpointConfig"].(map[string]interface{}), "production_endpoints")
 	}
@@ -265,7 +363,9 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 
 	// Handle Production fields
 	if apiCPEvent.API.ProdAIRL != nil {
-		maxTps["production"] = apiCPEvent.API.ProdAIRL.RequestCount
+		if apiCPEvent.API.ProdAIRL.RequestCount != nil {
+			maxTps["production"] = *apiCPEvent.API.ProdAIRL.RequestCount
+		}
 		maxTps["productionTimeUnit"] = strings.ToUpper(apiCPEvent.API.ProdAIRL.TimeUnit)
 
 		tokenConfig := make(map[string]interface{})
@@ -286,7 +386,9 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 
 	// Handle Sandbox fields
 	if apiCPEvent.API.SandAIRL != nil {
-		maxTps["sandbox"] = apiCPEvent.API.SandAIRL.RequestCount
+		if apiCPEvent.API.SandAIRL.RequestCount != nil {
+			maxTps["sandbox"] = *apiCPEvent.API.SandAIRL.RequestCount
+		}
 		maxTps["sandboxTimeUnit"] = strings.ToUpper(apiCPEvent.API.SandAIRL.TimeUnit)
 
 		// Add sandbox token-based throttling config
@@ -303,6 +405,11 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 		if apiCPEvent.API.SandAIRL.TotalTokenCount != nil {
 			tokenConfig["sandboxMaxTotalTokenCount"] = *apiCPEvent.API.SandAIRL.TotalTokenCount
 		}
+		if len(tokenConfig) > 0 {
+			tokenConfig["isTokenBasedThrottlingEnabled"] = true
+		} else {
+			delete(maxTps, "tokenBasedThrottlingConfiguration")
+		}
 	}
 	if len(maxTps) > 0 {
 		data["data"].(map[string]interface{})["maxTps"] = maxTps
@@ -375,17 +482,12 @@ func createAPIYaml(apiCPEvent *APICPEvent) (string, string) {
 	}
 	logger.LoggerMgtServer.Debugf("API Yaml: %+v", data)
 	yamlBytes, _ := yaml.Marshal(data)
-	return string(yamlBytes), definition
+	return string(yamlBytes), definition, nil
 }
 
-func createDeployementYaml(vhost string) string {
-	config, err := config.ReadConfigs()
-	envLabel := []string{"Default"}
-	if err == nil {
-		envLabel = config.ControlPlane.EnvironmentLabels
-	}
+func createDeployementYaml(vhost string, environments []string) string {
 	deploymentEnvData := []map[string]interface{}{}
-	for _, label := range envLabel {
+	for _, label := range environments {
 		deploymentEnvData = append(deploymentEnvData, map[string]interface{}{
 			"displayOnDevportal":    true,
 			"deploymentEnvironment": label,
@@ -402,6 +504,92 @@ func createDeployementYaml(vhost string) string {
 	return string(yamlBytes)
 }
 
+// resolveEnvironments returns the gateway environments of the control plane to which the API is deployed.
+// The environments sent by the adapter take precedence over the configured environment labels.
+func resolveEnvironments(api API, envLabel []string) []string {
+	if len(api.Environments) > 0 {
+		return api.Environments
+	}
+	if api.Environment != "" {
+		return []string{api.Environment}
+	}
+	return envLabel
+}
+
+// createEndpointSecurity maps the endpoint security of the data plane to the endpoint security of the control plane
+func createEndpointSecurity(endpointSecurity EndpointSecurity) map[string]interface{} {
+	securityType := endpointSecurity.SecurityType
+	switch strings.ToLower(securityType) {
+	case "basic":
+		securityType = "BASIC"
+	case "apikey":
+		securityType = "apikey"
+	}
+	apiKeyIdentifierType := "HEADER"
+	if strings.EqualFold(endpointSecurity.APIKeyIn, "query") {
+		apiKeyIdentifierType = "QUERY_PARAMETER"
+	}
+	return map[string]interface{}{
+		"apiKeyValue":                      endpointSecurity.APIKeyValue,
+		"apiKeyIdentifier":                 endpointSecurity.APIKeyName,
+		"apiKeyIdentifierType":             apiKeyIdentifierType,
+		"type":                             securityType,
+		"username":                         endpointSecurity.BasicUsername,
+		"password":                         endpointSecurity.BasicPassword,
+		"enabled":                          endpointSecurity.Enabled,
+		"additionalProperties":             map[string]interface{}{},
+		"customParameters":                 map[string]interface{}{},
+		"connectionTimeoutDuration":        -1.0,
+		"socketTimeoutDuration":            -1.0,
+		"connectionRequestTimeoutDuration": -1.0,
+	}
+}
+
+// createEndpointCertificateFiles creates the endpoint certificate files of the API project archive
+func createEndpointCertificateFiles(projectDir string, certificates []EndpointCertificate) []utils.ZipFile {
+	if len(certificates) == 0 {
+		return nil
+	}
+	var zipFiles []utils.ZipFile
+	certificateData := []map[string]interface{}{}
+	for _, certificate := range certificates {
+		fileName := fmt.Sprintf("%s.crt", certificate.Alias)
+		zipFiles = append(zipFiles, utils.ZipFile{
+			Path:    fmt.Sprintf("%s/Endpoint-certificates/%s", projectDir, fileName),
+			Content: certificate.Certificate,
+		})
+		certificateData = append(certificateData, map[string]interface{}{
+			"alias":       certificate.Alias,
+			"endpoint":    certificate.Endpoint,
+			"certificate": fileName,
+		})
+	}
+	data := map[string]interface{}{
+		"type":    "endpoint_certificates",
+		"version": "v4.4.0",
+		"data":    certificateData,
+	}
+	yamlBytes, _ := yaml.Marshal(data)
+	return append(zipFiles, utils.ZipFile{
+		Path:    fmt.Sprintf("%s/Endpoint-certificates/endpoint_certificates.yaml", projectDir),
+		Content: string(yamlBytes),
+	})
+}
+
+// newControlPlaneErrorResponse creates the error response for a failed request to the control plane. The requests
+// rejected by the control plane are not retryable, hence they are reported with a client error status.
+func newControlPlaneErrorResponse(code string, message string, err error) (int, ErrorResponse) {
+	response := ErrorResponse{Code: code, Message: message, Description: err.Error()}
+	var controlPlaneError *utils.ControlPlaneError
+	if errors.As(err, &controlPlaneError) {
+		response.ControlPlaneStatus = controlPlaneError.StatusCode
+		if controlPlaneError.StatusCode >= http.StatusBadRequest && controlPlaneError.StatusCode < http.StatusInternalServerError {
+			return http.StatusUnprocessableEntity, response
+		}
+	}
+	return http.StatusServiceUnavailable, response
+}
+
 // APIOperation represents the desired struct format for each API operation
 type APIOperation struct {
 	ID                string            `yaml:"id"`
@@ -494,16 +682,34 @@ type Scope struct {
 
 func extractOperations(event APICPEvent) ([]APIOperation, []ScopeWrapper, error) {
 	var apiOperations []APIOperation
-	var requestOperationPolicies []OperationPolicy
-	var responseOperationPolicies []OperationPolicy
 	scopewrappers := map[string]ScopeWrapper{}
+	addScopes := func(scopes []string) {
+		for _, scope := range scopes {
+			scopewrappers[scope] = ScopeWrapper{
+				Scope: Scope{
+					Name:        scope,
+					DisplayName: scope,
+					Description: scope,
+					Bindings:    []string{},
+				},
+				Shared: false,
+			}
+		}
+	}
 	if strings.ToUpper(event.API.APIType) == "GRAPHQL" {
 		for _, operation := range event.API.Operations {
+			addScopes(operation.Scopes)
+			throttlingPolicy, err := resolveThrottlingPolicy(operation.RateLimit, event.API.Organization)
+			if err != nil {
+				return nil, nil, err
+			}
 			apiOp := APIOperation{
-				Target:           operation.Path,
-				Verb:             operation.Verb,
-				AuthType:         "Application & Application User",
-				ThrottlingPolicy: "Unlimited",
+				Target:            operation.Path,
+				Verb:              operation.Verb,
+				AuthType:          "Application & Application User",
+				ThrottlingPolicy:  throttlingPolicy,
+				Scopes:            operation.Scopes,
+				OperationPolicies: createOperationPolicies(operation.Filters),
 			}
 			apiOperations = append(apiOperations, apiOp)
 		}
@@ -521,138 +727,205 @@ func extractOperations(event APICPEvent) ([]APIOperation, []ScopeWrapper, error)
 					continue
 				}
 				operationFromDP := *ptrToOperationFromDP
-				scopes := operationFromDP.Scopes
-				for _, scope := range scopes {
-					scopewrappers[scope] = ScopeWrapper{
-						Scope: Scope{
-							Name:        scope,
-							DisplayName: scope,
-							Description: scope,
-							Bindings:    []string{},
+				addScopes(operationFromDP.Scopes)
+				throttlingPolicy, err := resolveThrottlingPolicy(operationFromDP.RateLimit, event.API.Organization)
+				if err != nil {
+					return nil, nil, err
+				}
+				apiOp := APIOperation{
+					Target:            path,
+					Verb:              verb,
+					AuthType:          "Application & Application User",
+					ThrottlingPolicy:  throttlingPolicy,
+					Scopes:            operationFromDP.Scopes,
+					OperationPolicies: createOperationPolicies(operationFromDP.Filters),
+				}
+				apiOperations = append(apiOperations, apiOp)
+			}
+		}
+	} else {
+		return []APIOperation{}, []ScopeWrapper{}, nil
+	}
+	var scopeWrapperSlice []ScopeWrapper
+	for _, value := range scopewrappers {
+		scopeWrapperSlice = append(scopeWrapperSlice, value)
+	}
+	return apiOperations, scopeWrapperSlice, nil
+}
+
+// createOperationPolicies maps the filters of an operation in the data plane to the request and response
+// operation policies of the control plane
+func createOperationPolicies(filters []Filter) OperationPolicies {
+	var requestOperationPolicies []OperationPolicy
+	var responseOperationPolicies []OperationPolicy
+	for _, operationLevelFilter := range filters {
+		switch filter := operationLevelFilter.(type) {
+		// Header modification policies
+		case *APKHeaders:
+			requestHeaders := filter.RequestHeaders
+			// Add headers
+			if len(requestHeaders.AddHeaders) > 0 {
+				logger.LoggerMgtServer.Debugf("Processing request filter for header addition")
+				for _, requestHeader := range requestHeaders.AddHeaders {
+					operationPolicy := OperationPolicy{
+						PolicyName:    constants.AddHeader,
+						PolicyVersion: constants.V1,
+						Parameters: Header{
+							Name:  requestHeader.Name,
+							Value: requestHeader.Value,
 						},
-						Shared: false,
 					}
+					requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
 				}
-				// Process filters
-				for _, operationLevelFilter := range operationFromDP.Filters {
-					switch filter := operationLevelFilter.(type) {
-					// Header modification policies
-					case *APKHeaders:
-						requestHeaders := filter.RequestHeaders
-						// Add headers
-						if requestHeaders.AddHeaders != nil && len(requestHeaders.AddHeaders) > 0 {
-							logger.LoggerMgtServer.Debugf("Processing request filter for header addition")
-							for _, requestHeader := range requestHeaders.AddHeaders {
-								operationPolicy := OperationPolicy{
-									PolicyName:    constants.AddHeader,
-									PolicyVersion: constants.V1,
-									Parameters: Header{
-										Name:  requestHeader.Name,
-										Value: requestHeader.Value,
-									},
-								}
-								requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
-							}
-						}
-
-						// Remove headers
-						if requestHeaders.RemoveHeaders != nil && len(requestHeaders.RemoveHeaders) > 0 {
-							logger.LoggerMgtServer.Debugf("Processing request filter for header removal")
-							for _, requestHeader := range requestHeaders.RemoveHeaders {
-								operationPolicy := OperationPolicy{
-									PolicyName:    constants.RemoveHeader,
-									PolicyVersion: constants.V1,
-									Parameters: Header{
-										Name: requestHeader,
-									},
-								}
-								requestOperationPolicies = append(responseOperationPolicies, operationPolicy)
-							}
-						}
-
-						responseHeaders := filter.ResponseHeaders
-						// Add headers
-						if responseHeaders.AddHeaders != nil && len(responseHeaders.AddHeaders) > 0 {
-							logger.LoggerMgtServer.Debugf("Processing response filter for header addition")
-							for _, responseHeader := range responseHeaders.AddHeaders {
-								operationPolicy := OperationPolicy{
-									PolicyName:    constants.AddHeader,
-									PolicyVersion: constants.V1,
-									Parameters: Header{
-										Name:  responseHeader.Name,
-										Value: responseHeader.Value,
-									},
-								}
-								responseOperationPolicies = append(responseOperationPolicies, operationPolicy)
-							}
-						}
-
-						// Remove headers
-						if responseHeaders.RemoveHeaders != nil && len(responseHeaders.RemoveHeaders) > 0 {
-							logger.LoggerMgtServer.Debugf("Processing response filter for header removal")
-							for _, responseHeader := range responseHeaders.RemoveHeaders {
-								operationPolicy := OperationPolicy{
-									PolicyName:    constants.RemoveHeader,
-									PolicyVersion: constants.V1,
-									Parameters: Header{
-										Name: responseHeader,
-									},
-								}
-								responseOperationPolicies = append(responseOperationPolicies, operationPolicy)
-							}
-						}
-					// Mirror request
-					case *APKMirrorRequest:
-						logger.LoggerMgtServer.Debugf("Processing request filter for request mirroring")
-						for _, url := range filter.URLs {
-							operationPolicy := OperationPolicy{
-								PolicyName:    constants.MirrorRequest,
-								PolicyVersion: constants.V1,
-								Parameters: MirrorRequest{
-									URL: url,
-								},
-							}
-							requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
-						}
+			}
 
-					// Redirect request
-					case *APKRedirectRequest:
-						logger.LoggerMgtServer.Debugf("Processing request filter for request redirection")
-						operationPolicy := OperationPolicy{
-							PolicyName:    constants.RedirectRequest,
-							PolicyVersion: constants.V1,
-							Parameters: MirrorRequest{
-								URL: filter.URL,
-							},
-						}
-						requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
+			// Remove headers
+			if len(requestHeaders.RemoveHeaders) > 0 {
+				logger.LoggerMgtServer.Debugf("Processing request filter for header removal")
+				for _, requestHeader := range requestHeaders.RemoveHeaders {
+					operationPolicy := OperationPolicy{
+						PolicyName:    constants.RemoveHeader,
+						PolicyVersion: constants.V1,
+						Parameters: Header{
+							Name: requestHeader,
+						},
+					}
+					requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
+				}
+			}
 
-					default:
-						logger.LoggerMgtServer.Errorf("Unknown filter type ")
+			responseHeaders := filter.ResponseHeaders
+			// Add headers
+			if len(responseHeaders.AddHeaders) > 0 {
+				logger.LoggerMgtServer.Debugf("Processing response filter for header addition")
+				for _, responseHeader := range responseHeaders.AddHeaders {
+					operationPolicy := OperationPolicy{
+						PolicyName:    constants.AddHeader,
+						PolicyVersion: constants.V1,
+						Parameters: Header{
+							Name:  responseHeader.Name,
+							Value: responseHeader.Value,
+						},
 					}
+					responseOperationPolicies = append(responseOperationPolicies, operationPolicy)
 				}
+			}
 
-				apiOp := APIOperation{
-					Target:           path,
-					Verb:             verb,
-					AuthType:         "Application & Application User",
-					ThrottlingPolicy: "Unlimited",
-					Scopes:           scopes,
-					OperationPolicies: OperationPolicies{
-						Request:  requestOperationPolicies,
-						Response: responseOperationPolicies,
+			// Remove headers
+			if len(responseHeaders.RemoveHeaders) > 0 {
+				logger.LoggerMgtServer.Debugf("Processing response filter for header removal")
+				for _, responseHeader := range responseHeaders.RemoveHeaders {
+					operationPolicy := OperationPolicy{
+						PolicyName:    constants.RemoveHeader,
+						PolicyVersion: constants.V1,
+						Parameters: Header{
+							Name: responseHeader,
+						},
+					}
+					responseOperationPolicies = append(responseOperationPolicies, operationPolicy)
+				}
+			}
+		// Mirror request
+		case *APKMirrorRequest:
+			logger.LoggerMgtServer.Debugf("Processing request filter for request mirroring")
+			for _, url := range filter.URLs {
+				operationPolicy := OperationPolicy{
+					PolicyName:    constants.MirrorRequest,
+					PolicyVersion: constants.V1,
+					Parameters: MirrorRequest{
+						URL: url,
 					},
 				}
-				apiOperations = append(apiOperations, apiOp)
+				requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
 			}
+
+		// Redirect request
+		case *APKRedirectRequest:
+			logger.LoggerMgtServer.Debugf("Processing request filter for request redirection")
+			operationPolicy := OperationPolicy{
+				PolicyName:    constants.RedirectRequest,
+				PolicyVersion: constants.V1,
+				Parameters: RedirectRequest{
+					URL: filter.URL,
+				},
+			}
+			requestOperationPolicies = append(requestOperationPolicies, operationPolicy)
+
+		default:
+			logger.LoggerMgtServer.Errorf("Unknown filter type ")
+		}
+	}
+	return OperationPolicies{
+		Request:  requestOperationPolicies,
+		Response: responseOperationPolicies,
+	}
+}
+
+// RateLimitMappingError is returned when no control plane policy enforces the same request count as a data plane
+// ratelimit, as publishing the API with another policy would change its ratelimit.
+type RateLimitMappingError struct {
+	// PolicyType is the type of the control plane policies searched, which is "throttling" or "subscription"
+	PolicyType   string
+	Organization string
+	RateLimit    RateLimit
+}
+
+func (e *RateLimitMappingError) Error() string {
+	return fmt.Sprintf("no %s policy of the organization %s allows %d requests per %s", e.PolicyType, e.Organization,
+		e.RateLimit.RequestsPerUnit, e.RateLimit.Unit)
+}
+
+// resolveThrottlingPolicy returns the name of the control plane advanced throttling policy which enforces the same
+// request count as the given ratelimit. The Unlimited policy is used when no ratelimit is set, and a
+// RateLimitMappingError is returned when no policy matches.
+func resolveThrottlingPolicy(rateLimit *RateLimit, organization string) (string, error) {
+	if rateLimit == nil {
+		return unlimitedPolicy, nil
+	}
+	for _, policy := range GetAllRateLimitPolicies() {
+		if policy.TenantDomain != organization || len(policy.ConditionGroups) > 0 {
+			continue
+		}
+		requestCount := policy.DefaultLimit.RequestCount
+		if requestCount.UnitTime == 1 && uint32(requestCount.RequestCount) == rateLimit.RequestsPerUnit &&
+			isSameTimeUnit(requestCount.TimeUnit, rateLimit.Unit) {
+			return policy.Name, nil
 		}
-		var scopeWrapperSlice []ScopeWrapper
-		for _, value := range scopewrappers {
-			scopeWrapperSlice = append(scopeWrapperSlice, value)
+	}
+	return "", &RateLimitMappingError{PolicyType: "throttling", Organization: organization, RateLimit: *rateLimit}
+}
+
+// resolveSubscriptionPolicy returns the name of the control plane subscription policy which enforces the same
+// request count as the given subscription ratelimit. The Unlimited policy is used when no ratelimit is set, and a
+// RateLimitMappingError is returned when no policy matches.
+func resolveSubscriptionPolicy(rateLimit *RateLimit, organization string) (string, error) {
+	if rateLimit == nil {
+		return unlimitedPolicy, nil
+	}
+	for _, policy := range GetAllSubscriptionPolicies() {
+		if policy.TenantDomain != organization {
+			continue
+		}
+		requestCount := policy.DefaultLimit.RequestCount
+		if requestCount.UnitTime == 1 && uint32(requestCount.RequestCount) == rateLimit.RequestsPerUnit &&
+			isSameTimeUnit(requestCount.TimeUnit, rateLimit.Unit) {
+			return policy.Name, nil
+		}
+	}
+	return "", &RateLimitMappingError{PolicyType: "subscription", Organization: organization, RateLimit: *rateLimit}
+}
+
+// isSameTimeUnit compares a control plane time unit (min, hours, days) with a data plane time unit (Minute, Hour,
+// Day)
+func isSameTimeUnit(cpTimeUnit string, dpTimeUnit string) bool {
+	normalize := func(timeUnit string) string {
+		timeUnit = strings.TrimSuffix(strings.ToLower(timeUnit), "s")
+		if timeUnit == "min" {
+			return "minute"
 		}
-		return apiOperations, scopeWrapperSlice, nil
+		return timeUnit
 	}
-	return []APIOperation{}, []ScopeWrapper{}, nil
+	return normalize(cpTimeUnit) == normalize(dpTimeUnit)
 }
 
 func findMatchingAPKOperation(path string, verb string, operations []OperationFromDP) *OperationFromDP {
@@ -17,6 +17,15 @@
 
 package managementserver
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/audit"
+	eventHub "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/eventhub/types"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+)
+
 // Subscription for struct subscription
 type Subscription struct {
 	SubStatus     string         `json:"subStatus,omitempty"`
@@ -106,6 +115,60 @@ type ApplicationMappingList struct {
 	List []ApplicationMapping `json:"list"`
 }
 
+// RateLimitPolicyList for struct list of rate limit policies
+type RateLimitPolicyList struct {
+	List []eventHub.RateLimitPolicy `json:"list"`
+}
+
+// SubscriptionPolicyList for struct list of subscription policies
+type SubscriptionPolicyList struct {
+	List []eventHub.SubscriptionPolicy `json:"list"`
+}
+
+// AIProviderList for struct list of AI providers
+type AIProviderList struct {
+	List []eventHub.AIProvider `json:"list"`
+}
+
+// KeyManagerList for struct list of key managers
+type KeyManagerList struct {
+	List []eventHub.ResolvedKeyManager `json:"list"`
+}
+
+// GRPCClient for struct gRPC client connected to the agent
+type GRPCClient struct {
+	ID      string `json:"id"`
+	Address string `json:"address,omitempty"`
+}
+
+// GRPCClientList for struct list of gRPC clients
+type GRPCClientList struct {
+	List []GRPCClient `json:"list"`
+}
+
+// RevisionAck for struct revision deployment acknowledgement which is not confirmed by the control plane yet
+type RevisionAck struct {
+	APIID       string `json:"apiId"`
+	Environment string `json:"environment"`
+	// Action is either "deploy" or "undeploy"
+	Action      string    `json:"action"`
+	Revision    string    `json:"revision"`
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"lastError,omitempty"`
+	QueuedAt    time.Time `json:"queuedAt"`
+	NextAttempt time.Time `json:"nextAttempt"`
+}
+
+// RevisionAckList for struct list of revision deployment acknowledgements
+type RevisionAckList struct {
+	List []RevisionAck `json:"list"`
+}
+
+// AuditRecordList for struct list of audit records
+type AuditRecordList struct {
+	List []audit.Record `json:"list"`
+}
+
 // APICPEvent holds data of a specific API event from adapter
 type APICPEvent struct {
 	Event EventType `json:"event"`
@@ -136,6 +199,7 @@ type API struct {
 	SystemAPI            bool              `json:"systemAPI"`
 	APIProperties        map[string]string `json:"apiProperties,omitempty"`
 	Environment          string            `json:"environment,omitempty"`
+	Environments         []string          `json:"environments,omitempty"`
 	RevisionID           string            `json:"revisionID"`
 	SandEndpoint         string            `json:"sandEndpoint"`
 	SandEndpointSecurity EndpointSecurity  `json:"sandEndpointSecurity"`
@@ -149,9 +213,37 @@ type API struct {
 	AuthHeader           string            `json:"authHeader"`
 	APIKeyHeader         string            `json:"apiKeyHeader"`
 	Operations           []OperationFromDP `json:"operations"`
-	SandAIRL             *AIRL             `json:"sandAIRL"`
-	ProdAIRL             *AIRL             `json:"prodAIRL"`
-	AIConfiguration      AIConfiguration   `json:"aiConfiguration"`
+	// RateLimit is the API level ratelimit of the API and SubscriptionRateLimit is the ratelimit applied per
+	// subscription, both taken from the RateLimitPolicy CR attached to the API
+	RateLimit             *RateLimit            `json:"rateLimit,omitempty"`
+	SubscriptionRateLimit *RateLimit            `json:"subscriptionRateLimit,omitempty"`
+	SandAIRL              *AIRL                 `json:"sandAIRL"`
+	ProdAIRL              *AIRL                 `json:"prodAIRL"`
+	AIConfiguration       AIConfiguration       `json:"aiConfiguration"`
+	EndpointCertificates  []EndpointCertificate `json:"endpointCertificates,omitempty"`
+}
+
+// EndpointCertificate holds a certificate trusted by the gateway when connecting to the backend
+type EndpointCertificate struct {
+	Alias    string `json:"alias"`
+	Endpoint string `json:"endpoint"`
+	// Certificate is the PEM encoded content of the certificate
+	Certificate string `json:"certificate"`
+}
+
+// ErrorResponse is the structured error sent to the adapter when an API event could not be processed
+type ErrorResponse struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	Description string `json:"description,omitempty"`
+	// ControlPlaneStatus is the status code received from the control plane, if the control plane rejected the request
+	ControlPlaneStatus int `json:"controlPlaneStatus,omitempty"`
+}
+
+// RateLimit holds request count based ratelimit related data
+type RateLimit struct {
+	RequestsPerUnit uint32 `json:"requestsPerUnit"`
+	Unit            string `json:"unit"`
 }
 
 // AIRL holds AI ratelimit related data
@@ -202,10 +294,55 @@ type APKHeader struct {
 
 // OperationFromDP holds the path, verb, throttling and interceptor policy
 type OperationFromDP struct {
-	Path    string   `json:"path"`
-	Verb    string   `json:"verb"`
-	Scopes  []string `json:"scopes"`
-	Filters []Filter `json:"filters"`
+	Path      string     `json:"path"`
+	Verb      string     `json:"verb"`
+	Scopes    []string   `json:"scopes"`
+	Filters   []Filter   `json:"filters"`
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// UnmarshalJSON decodes the filters of the operation into their concrete types. The type of a filter is
+// identified by its parameters, and the filters which are not known to the agent are skipped.
+func (o *OperationFromDP) UnmarshalJSON(data []byte) error {
+	var operation struct {
+		Path      string            `json:"path"`
+		Verb      string            `json:"verb"`
+		Scopes    []string          `json:"scopes"`
+		Filters   []json.RawMessage `json:"filters"`
+		RateLimit *RateLimit        `json:"rateLimit"`
+	}
+	if err := json.Unmarshal(data, &operation); err != nil {
+		return err
+	}
+	o.Path = operation.Path
+	o.RateLimit = operation.RateLimit
+	o.Verb = operation.Verb
+	o.Scopes = operation.Scopes
+	o.Filters = nil
+	for _, rawFilter := range operation.Filters {
+		var parameters map[string]json.RawMessage
+		if err := json.Unmarshal(rawFilter, &parameters); err != nil {
+			return err
+		}
+		var filter Filter
+		if _, found := parameters["requestHeaders"]; found {
+			filter = &APKHeaders{}
+		} else if _, found := parameters["responseHeaders"]; found {
+			filter = &APKHeaders{}
+		} else if _, found := parameters["urls"]; found {
+			filter = &APKMirrorRequest{}
+		} else if _, found := parameters["url"]; found {
+			filter = &APKRedirectRequest{}
+		} else {
+			logger.LoggerMgtServer.Warnf("Skipping unknown filter of the operation %s %s: %s", o.Verb, o.Path, string(rawFilter))
+			continue
+		}
+		if err := json.Unmarshal(rawFilter, filter); err != nil {
+			return err
+		}
+		o.Filters = append(o.Filters, filter)
+	}
+	return nil
 }
 
 // Policy holds the policy name and version
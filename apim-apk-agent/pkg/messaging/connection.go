@@ -74,6 +74,10 @@ func (c *Consumer) reconnect(key string) {
 	var err error
 	shouldReconnect := false
 	connClose := <-c.Conn.NotifyClose(make(chan *amqp.Error))
+	if shuttingDown.Load() {
+		logger.LoggerMsg.Infof("Connection for %s is closed for the shutdown", key)
+		return
+	}
 	connBlocked := c.Conn.NotifyBlocked(make(chan amqp.Blocking))
 	chClose := c.Channel.NotifyClose(make(chan *amqp.Error))
 
@@ -124,6 +128,9 @@ func connectionRetry(key string) (*Consumer, *amqp.Connection, error) {
 			maskURL(amqpURIArray[j].URL), j, amqpURIArray[j].connectionDelay, maxAttempt)
 
 		for i = 1; i <= maxAttempt; i++ {
+			if shuttingDown.Load() {
+				return nil, RabbitConn, fmt.Errorf("agent is shutting down")
+			}
 
 			RabbitConn, err = amqp.Dial(amqpURIArray[j].URL + "/")
 			if err == nil {
@@ -261,24 +268,38 @@ func handleEvent(c *Consumer, key string) error {
 		false,      // noWait
 		nil,        // arguments
 	)
+	if err != nil {
+		return fmt.Errorf("Queue Consume: %s", err)
+	}
+	var eventChannel chan amqp.Delivery
 	if strings.EqualFold(key, notification) {
-		for event := range deliveries {
-			NotificationChannel <- event
-		}
+		eventChannel = NotificationChannel
 	} else if strings.EqualFold(key, keymanager) {
-		for event := range deliveries {
-			KeyManagerChannel <- event
-		}
+		eventChannel = KeyManagerChannel
 	} else if strings.EqualFold(key, tokenRevocation) {
-		for event := range deliveries {
-			RevokedTokenChannel <- event
-		}
+		eventChannel = RevokedTokenChannel
 	} else if strings.EqualFold(key, throttleData) {
-		for event := range deliveries {
-			ThrottleDataChannel <- event
+		eventChannel = ThrottleDataChannel
+	} else {
+		return nil
+	}
+	// The events which are not handed over once the agent stops consuming are left unacknowledged, hence the
+	// broker requeues them when the connection is closed
+	for {
+		select {
+		case event, ok := <-deliveries:
+			if !ok {
+				return nil
+			}
+			select {
+			case eventChannel <- event:
+			case <-stopConsuming:
+				return nil
+			}
+		case <-stopConsuming:
+			return nil
 		}
 	}
-	return nil
 }
 
 // InitiateJMSConnection to pass event consumption
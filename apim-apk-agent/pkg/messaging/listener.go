@@ -20,6 +20,8 @@ package messaging
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/streadway/amqp"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
@@ -32,6 +34,15 @@ var (
 	rabbitCloseError chan *amqp.Error
 	// amqpURIArray represents an array of amqpFailoverURL objects
 	amqpURIArray = make([]amqpFailoverURL, 0)
+
+	// stopConsuming is closed once the agent stops accepting the events, upon which the received events are
+	// requeued instead of being handed over to the event channels
+	stopConsuming     = make(chan struct{})
+	stopConsumingOnce sync.Once
+	// shuttingDown prevents reconnecting to the broker once the connection is closed for the shutdown
+	shuttingDown   atomic.Bool
+	consumersMutex sync.Mutex
+	consumers      = make(map[*Consumer]struct{})
 )
 
 const (
@@ -60,6 +71,9 @@ func startConsumer(key string) *Consumer {
 	}
 
 	c.Conn = RabbitConn
+	consumersMutex.Lock()
+	consumers[c] = struct{}{}
+	consumersMutex.Unlock()
 	go func() {
 		c.reconnect(key)
 	}()
@@ -67,6 +81,16 @@ func startConsumer(key string) *Consumer {
 	if err != nil {
 		logger.LoggerMsg.Errorf("Error while handling event. %s", err)
 	}
+	close(c.Done)
+	select {
+	case <-stopConsuming:
+		// The consumer is shut down with the connection, once the events in progress are processed
+		return c
+	default:
+	}
+	consumersMutex.Lock()
+	delete(consumers, c)
+	consumersMutex.Unlock()
 
 	logger.LoggerMsg.Infof("Shutting down the consumer")
 	if err := c.Shutdown(); err != nil {
@@ -79,14 +103,16 @@ func startConsumer(key string) *Consumer {
 // Shutdown when error happens
 func (c *Consumer) Shutdown() error {
 
-	if c.Conn.IsClosed() {
+	if c.Conn == nil || c.Conn.IsClosed() {
 		logger.LoggerMsg.Infof("AMQP shutdown OK")
 		return nil
 	}
 
 	// will close() the deliveries channel
-	if err := c.Channel.Cancel(c.Tag, true); err != nil {
-		logger.LoggerMsg.Errorf("Consumer cancel failed: %s", err.Error())
+	if c.Channel != nil {
+		if err := c.Channel.Cancel(c.Tag, true); err != nil {
+			logger.LoggerMsg.Errorf("Consumer cancel failed: %s", err.Error())
+		}
 	}
 
 	if err := c.Conn.Close(); err != nil {
@@ -105,3 +131,41 @@ type Consumer struct {
 	Tag     string
 	Done    chan error
 }
+
+// StopConsuming stops handing over the events received from the broker to the event channels. The events which are
+// not handed over are requeued by the broker once the connection is closed.
+func StopConsuming() {
+	stopConsumingOnce.Do(func() {
+		close(stopConsuming)
+	})
+}
+
+// CloseConnection shuts down the consumers and closes the connection to the broker without reconnecting. The
+// events which are not acknowledged by then are requeued by the broker.
+func CloseConnection() {
+	shuttingDown.Store(true)
+	StopConsuming()
+	consumersMutex.Lock()
+	activeConsumers := make([]*Consumer, 0, len(consumers))
+	for c := range consumers {
+		activeConsumers = append(activeConsumers, c)
+	}
+	consumers = make(map[*Consumer]struct{})
+	consumersMutex.Unlock()
+	for _, c := range activeConsumers {
+		if err := c.Shutdown(); err != nil {
+			logger.LoggerMsg.Errorf("Error during shutdown: %s", err)
+		}
+	}
+	if RabbitConn != nil && !RabbitConn.IsClosed() {
+		if err := RabbitConn.Close(); err != nil {
+			logger.LoggerMsg.Errorf("AMQP connection close error: %s", err)
+		}
+	}
+}
+
+// IsConnected returns true if the connection to the broker is established and not closed
+func IsConnected() bool {
+	conn := RabbitConn
+	return conn != nil && !conn.IsClosed()
+}
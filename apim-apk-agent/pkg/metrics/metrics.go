@@ -29,4 +29,5 @@ func RegisterPrometheusCollector() {
 
 	collector := metrics.CustomMetricsCollector()
 	k8smetrics.Registry.MustRegister(collector)
+	k8smetrics.Registry.MustRegister(RevisionAcksPending, RevisionAcksSent, RevisionAckFailures, RevisionAcksDropped)
 }
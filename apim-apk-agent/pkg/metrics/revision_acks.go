@@ -0,0 +1,47 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// RevisionAcksPending is the number of revision deployment acknowledgements waiting to be confirmed by the
+	// control plane
+	RevisionAcksPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "apim_apk_agent_revision_acks_pending",
+		Help: "Number of revision deployment acknowledgements waiting to be confirmed by the control plane",
+	})
+	// RevisionAcksSent is the number of revision deployment acknowledgements confirmed by the control plane
+	RevisionAcksSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "apim_apk_agent_revision_acks_sent_total",
+		Help: "Number of revision deployment acknowledgements confirmed by the control plane",
+	})
+	// RevisionAckFailures is the number of failed attempts to send revision deployment acknowledgements
+	RevisionAckFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "apim_apk_agent_revision_ack_failures_total",
+		Help: "Number of failed attempts to send revision deployment acknowledgements",
+	})
+	// RevisionAcksDropped is the number of revision deployment acknowledgements which are rejected by the control
+	// plane, or are not confirmed within the maximum number of attempts
+	RevisionAcksDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "apim_apk_agent_revision_acks_dropped_total",
+		Help: "Number of revision deployment acknowledgements dropped without being confirmed by the control plane",
+	})
+)
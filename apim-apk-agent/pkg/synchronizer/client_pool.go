@@ -18,7 +18,6 @@
 package synchronizer
 
 import (
-	"crypto/tls"
 	"net/http"
 	"sync"
 	"time"
@@ -89,16 +88,8 @@ func InitializeWorkerPool(maxWorkers, jobQueueCapacity int, delayForFaultRequest
 			password:      password,
 			retryInterval: retryInterval,
 		}
-		var tr *http.Transport
-		if !skipSSL {
-			caCertPool := tlsutils.GetTrustedCertPool(trustStoreLocation)
-			tr = &http.Transport{
-				TLSClientConfig: &tls.Config{RootCAs: caCertPool},
-			}
-		} else {
-			tr = &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			}
+		tr := &http.Transport{
+			TLSClientConfig: tlsutils.GetClientTLSConfig(trustStoreLocation, skipSSL),
 		}
 		// Configure Connection Level Parameters since it is reused over and over
 		tr.MaxConnsPerHost = maxWorkers * 2
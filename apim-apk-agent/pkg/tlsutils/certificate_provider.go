@@ -0,0 +1,191 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package tlsutils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+)
+
+var (
+	onceCertificateProviderInit sync.Once
+	certificateProvider         *CertificateProvider
+)
+
+// CertificateProvider serves the server certificate and the trusted certificates of the agent. The key, the
+// certificate and the truststore are watched, and reloaded when they are modified (e.g. when cert-manager rotates
+// the secret), so that the listeners and the clients pick up the new certificates without a restart.
+type CertificateProvider struct {
+	certPath           string
+	keyPath            string
+	truststoreLocation string
+
+	mutex       sync.RWMutex
+	certificate *tls.Certificate
+	caCertPool  *x509.CertPool
+}
+
+// GetCertificateProvider returns the certificate provider of the keystore and the truststore configured under
+// agent. The files are watched from the first invocation onwards.
+func GetCertificateProvider() *CertificateProvider {
+	onceCertificateProviderInit.Do(func() {
+		publicKeyLocation, privateKeyLocation, truststoreLocation := GetKeyLocations()
+		certificateProvider = NewCertificateProvider(publicKeyLocation, privateKeyLocation, truststoreLocation)
+		if err := certificateProvider.Watch(); err != nil {
+			logger.LoggerTLSUtils.Errorf("Error while watching the certificates. Rotated certificates will not be reloaded: %v", err)
+		}
+	})
+	return certificateProvider
+}
+
+// NewCertificateProvider loads the key pair and the trusted certificates from the given locations.
+func NewCertificateProvider(certPath string, keyPath string, truststoreLocation string) *CertificateProvider {
+	provider := &CertificateProvider{
+		certPath:           certPath,
+		keyPath:            keyPath,
+		truststoreLocation: truststoreLocation,
+	}
+	provider.Reload()
+	return provider
+}
+
+// Reload reads the key pair and the trusted certificates again. The previously loaded key pair is retained
+// if the new one cannot be loaded, which happens when only one of the files has been updated so far.
+func (p *CertificateProvider) Reload() {
+	cert, err := tls.LoadX509KeyPair(p.certPath, p.keyPath)
+	caCertPool := readTrustedCertPool(p.truststoreLocation)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err != nil {
+		logger.LoggerTLSUtils.Errorf("Error while loading the tls keypair from %s and %s: %v", p.certPath, p.keyPath, err)
+	} else {
+		p.certificate = &cert
+	}
+	p.caCertPool = caCertPool
+}
+
+// Watch reloads the certificates whenever the key, the certificate or the truststore is modified. The parent
+// directories are watched since the mounted secrets are updated by replacing a symbolic link.
+func (p *CertificateProvider) Watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	directories := map[string]bool{
+		filepath.Dir(p.certPath): true,
+		filepath.Dir(p.keyPath):  true,
+	}
+	if info, err := os.Stat(p.truststoreLocation); err == nil && info.IsDir() {
+		directories[p.truststoreLocation] = true
+	} else {
+		directories[filepath.Dir(p.truststoreLocation)] = true
+	}
+	for directory := range directories {
+		if err := watcher.Add(directory); err != nil {
+			logger.LoggerTLSUtils.Warnf("Unable to watch the directory %s: %v", directory, err)
+		}
+	}
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op == fsnotify.Chmod {
+					continue
+				}
+				logger.LoggerTLSUtils.Infof("Reloading the certificates since %s is modified", event.Name)
+				p.Reload()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.LoggerTLSUtils.Warnf("Error while watching the certificates: %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// GetCertificate returns the current server certificate. It can be used as tls.Config.GetCertificate.
+func (p *CertificateProvider) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	if p.certificate == nil {
+		return nil, errors.New("server certificate is not loaded")
+	}
+	return p.certificate, nil
+}
+
+// GetCertPool returns the current pool of the trusted certificates.
+func (p *CertificateProvider) GetCertPool() *x509.CertPool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.caCertPool
+}
+
+// ServerTLSConfig returns the TLS configuration of a listener. Each connection is served with the current
+// certificate and verified against the current trusted certificates, on top of the given template.
+func (p *CertificateProvider) ServerTLSConfig(template *tls.Config) *tls.Config {
+	return &tls.Config{
+		GetCertificate: p.GetCertificate,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := template.Clone()
+			config.GetCertificate = p.GetCertificate
+			config.ClientCAs = p.GetCertPool()
+			return config, nil
+		},
+	}
+}
+
+// ClientTLSConfig returns the TLS configuration of a client to the control plane. The server certificate is
+// verified against the trusted certificates at the time of the handshake, so that long lived transports
+// pick up the rotated truststore. The connection fails if the server name is not known, as the hostname of the
+// server certificate could not be verified otherwise. Both net/http and tls.Dial set it from the dial address.
+func (p *CertificateProvider) ClientTLSConfig() *tls.Config {
+	return &tls.Config{
+		// The default verification is replaced by VerifyConnection which uses the current trusted certificates.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if state.ServerName == "" {
+				return errors.New("server name is not set, hence the server certificate can not be verified")
+			}
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("server did not present a certificate")
+			}
+			intermediates := x509.NewCertPool()
+			for _, cert := range state.PeerCertificates[1:] {
+				intermediates.AddCert(cert)
+			}
+			_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+				DNSName:       state.ServerName,
+				Roots:         p.GetCertPool(),
+				Intermediates: intermediates,
+			})
+			return err
+		},
+	}
+}
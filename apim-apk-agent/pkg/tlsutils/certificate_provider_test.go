@@ -0,0 +1,118 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package tlsutils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestKeyPair(t *testing.T, dir string, commonName string, dnsNames ...string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "tls.key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "tls.crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+}
+
+func servedCommonName(t *testing.T, provider *CertificateProvider) string {
+	cert, err := provider.GetCertificate(nil)
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestCertificateProviderReloadsRotatedCertificate(t *testing.T) {
+	keystore := t.TempDir()
+	truststore := t.TempDir()
+	writeTestKeyPair(t, keystore, "agent-1")
+	provider := NewCertificateProvider(filepath.Join(keystore, "tls.crt"), filepath.Join(keystore, "tls.key"), truststore)
+	assert.Equal(t, "agent-1", servedCommonName(t, provider))
+	assert.NoError(t, provider.Watch())
+
+	writeTestKeyPair(t, keystore, "agent-2")
+	assert.Eventually(t, func() bool {
+		return servedCommonName(t, provider) == "agent-2"
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestCertificateProviderRetainsCertificateOnInvalidKeyPair(t *testing.T) {
+	keystore := t.TempDir()
+	writeTestKeyPair(t, keystore, "agent-1")
+	provider := NewCertificateProvider(filepath.Join(keystore, "tls.crt"), filepath.Join(keystore, "tls.key"), t.TempDir())
+
+	assert.NoError(t, os.WriteFile(filepath.Join(keystore, "tls.key"), []byte("invalid"), 0600))
+	provider.Reload()
+	assert.Equal(t, "agent-1", servedCommonName(t, provider))
+}
+
+// handshake connects a client with the given configuration to a server which serves the certificate of the provider.
+func handshake(provider *CertificateProvider, clientConfig *tls.Config) error {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	server := tls.Server(serverConn, &tls.Config{GetCertificate: provider.GetCertificate})
+	go func() {
+		server.Handshake()
+		serverConn.Close()
+	}()
+	return tls.Client(clientConn, clientConfig).Handshake()
+}
+
+func TestClientTLSConfigVerifiesServerName(t *testing.T) {
+	keystore := t.TempDir()
+	writeTestKeyPair(t, keystore, "controlplane", "controlplane.example.com")
+	// The self signed certificate of the server is trusted
+	provider := NewCertificateProvider(filepath.Join(keystore, "tls.crt"), filepath.Join(keystore, "tls.key"),
+		filepath.Join(keystore, "tls.crt"))
+
+	config := provider.ClientTLSConfig()
+	config.ServerName = "controlplane.example.com"
+	assert.NoError(t, handshake(provider, config))
+
+	config = provider.ClientTLSConfig()
+	config.ServerName = "other.example.com"
+	assert.Error(t, handshake(provider, config), "Certificate of another host should not be accepted")
+
+	assert.Error(t, handshake(provider, provider.ClientTLSConfig()), "Hostname check should not be skipped")
+}
@@ -26,20 +26,11 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"sync"
 
 	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
 	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
 )
 
-var (
-	onceTrustedCertsRead sync.Once
-	onceKeyCertsRead     sync.Once
-	certificate          tls.Certificate
-	certReadErr          error
-	caCertPool           *x509.CertPool
-)
-
 const (
 	pemExtension  string = ".pem"
 	crtExtension  string = ".crt"
@@ -47,44 +38,63 @@ const (
 )
 
 // GetServerCertificate returns the certificate (used for the restAPI server and xds server) created based on configuration values.
-// Move to pkg. remove config and read from a file path
+// The certificate of the agent keystore is served by the certificate provider, hence it reflects the rotated certificate.
 func GetServerCertificate(tlsCertificate string, tlsCertificateKey string) (tls.Certificate, error) {
-	certReadErr = nil
-	onceKeyCertsRead.Do(func() {
-		cert, err := tls.LoadX509KeyPair(string(tlsCertificate), string(tlsCertificateKey))
-		if err != nil {
-			logger.LoggerTLSUtils.Fatal("Error while loading the tls keypair.", err)
-			certReadErr = err
-		}
-		certificate = cert
-	})
-	return certificate, certReadErr
+	provider := GetCertificateProvider()
+	if provider.certPath != tlsCertificate || provider.keyPath != tlsCertificateKey {
+		return tls.LoadX509KeyPair(tlsCertificate, tlsCertificateKey)
+	}
+	cert, err := provider.GetCertificate(nil)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return *cert, nil
 }
 
 // GetTrustedCertPool returns the trusted certificate (used for the restAPI server and xds server) created based on
-// the provided directory/file path.
-// Move to pkg
+// the provided directory/file path. The agent truststore is served by the certificate provider, hence it reflects
+// the rotated certificates.
 func GetTrustedCertPool(truststoreLocation string) *x509.CertPool {
-	onceTrustedCertsRead.Do(func() {
-		caCertPool = x509.NewCertPool()
-		filepath.Walk(truststoreLocation, func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				logger.LoggerTLSUtils.Warn("Error while reading the trusted certificates directory/file.", err)
-			} else {
-				if !info.IsDir() && (filepath.Ext(info.Name()) == pemExtension ||
-					filepath.Ext(info.Name()) == crtExtension) {
-					caCert, caCertErr := ioutil.ReadFile(path)
-					if caCertErr != nil {
-						logger.LoggerTLSUtils.Warn("Error while reading the certificate file.", info.Name())
-					}
-					if IsPublicCertificate(caCert) {
-						caCertPool.AppendCertsFromPEM(caCert)
-						logger.LoggerTLSUtils.Debugf("%v : Certificate is added as a trusted certificate.", info.Name())
-					}
+	provider := GetCertificateProvider()
+	if provider.truststoreLocation == truststoreLocation {
+		return provider.GetCertPool()
+	}
+	return readTrustedCertPool(truststoreLocation)
+}
+
+// GetClientTLSConfig returns the TLS configuration of a client which trusts the certificates in the given
+// directory/file path. The rotated certificates of the agent truststore are picked up on the next handshake.
+func GetClientTLSConfig(truststoreLocation string, skipSSL bool) *tls.Config {
+	if skipSSL {
+		return &tls.Config{InsecureSkipVerify: true}
+	}
+	provider := GetCertificateProvider()
+	if provider.truststoreLocation != truststoreLocation {
+		return &tls.Config{RootCAs: readTrustedCertPool(truststoreLocation)}
+	}
+	return provider.ClientTLSConfig()
+}
+
+// readTrustedCertPool reads the certificates in the given directory/file path.
+func readTrustedCertPool(truststoreLocation string) *x509.CertPool {
+	caCertPool := x509.NewCertPool()
+	filepath.Walk(truststoreLocation, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			logger.LoggerTLSUtils.Warn("Error while reading the trusted certificates directory/file.", err)
+		} else {
+			if !info.IsDir() && (filepath.Ext(info.Name()) == pemExtension ||
+				filepath.Ext(info.Name()) == crtExtension) {
+				caCert, caCertErr := ioutil.ReadFile(path)
+				if caCertErr != nil {
+					logger.LoggerTLSUtils.Warn("Error while reading the certificate file.", info.Name())
+				}
+				if IsPublicCertificate(caCert) {
+					caCertPool.AppendCertsFromPEM(caCert)
+					logger.LoggerTLSUtils.Debugf("%v : Certificate is added as a trusted certificate.", info.Name())
 				}
 			}
-			return nil
-		})
+		}
+		return nil
 	})
 	return caCertPool
 }
@@ -102,17 +112,9 @@ func IsPublicCertificate(certContent []byte) bool {
 
 // InvokeControlPlane sends request to the control plane and returns the response
 func InvokeControlPlane(req *http.Request, skipSSL bool) (*http.Response, error) {
-	tr := &http.Transport{}
-	if !skipSSL {
-		_, _, truststoreLocation := GetKeyLocations()
-		caCertPool := GetTrustedCertPool(truststoreLocation)
-		tr = &http.Transport{
-			TLSClientConfig: &tls.Config{RootCAs: caCertPool},
-		}
-	} else {
-		tr = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		}
+	_, _, truststoreLocation := GetKeyLocations()
+	tr := &http.Transport{
+		TLSClientConfig: GetClientTLSConfig(truststoreLocation, skipSSL),
 	}
 
 	// Configuring the http client
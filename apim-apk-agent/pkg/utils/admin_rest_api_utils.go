@@ -0,0 +1,118 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/tlsutils"
+)
+
+const (
+	// ThrottlingPolicyRelativePath is the relative path of the throttling policies in admin rest API
+	ThrottlingPolicyRelativePath = "api/am/admin/v4/throttling/policies/"
+	// TierManageScope is the scope required to manage the throttling policies
+	TierManageScope Scope = "apim:tier_manage"
+)
+
+// Types of the throttling policies in admin rest API
+const (
+	AdvancedThrottlingPolicy     = "advanced"
+	SubscriptionThrottlingPolicy = "subscription"
+)
+
+// ThrottlingPolicyInfo holds the identifying fields of a throttling policy in the control plane
+type ThrottlingPolicyInfo struct {
+	PolicyID    string `json:"policyId"`
+	PolicyName  string `json:"policyName"`
+	Description string `json:"description"`
+}
+
+// GetThrottlingPolicies returns the throttling policies of the given type in the control plane
+func GetThrottlingPolicies(policyType string) ([]ThrottlingPolicyInfo, error) {
+	respBody, err := invokeAdminAPI(http.MethodGet, throttlingPolicyURL+policyType, nil)
+	if err != nil {
+		return nil, err
+	}
+	var policyList struct {
+		List []ThrottlingPolicyInfo `json:"list"`
+	}
+	if err := json.Unmarshal(respBody, &policyList); err != nil {
+		return nil, err
+	}
+	return policyList.List, nil
+}
+
+// CreateThrottlingPolicy creates a throttling policy of the given type in the control plane
+func CreateThrottlingPolicy(policyType string, policy interface{}) error {
+	_, err := invokeAdminAPI(http.MethodPost, throttlingPolicyURL+policyType, policy)
+	return err
+}
+
+// UpdateThrottlingPolicy updates the throttling policy of the given type and ID in the control plane
+func UpdateThrottlingPolicy(policyType string, policyID string, policy interface{}) error {
+	_, err := invokeAdminAPI(http.MethodPut, throttlingPolicyURL+policyType+"/"+policyID, policy)
+	return err
+}
+
+// DeleteThrottlingPolicy deletes the throttling policy of the given type and ID from the control plane
+func DeleteThrottlingPolicy(policyType string, policyID string) error {
+	_, err := invokeAdminAPI(http.MethodDelete, throttlingPolicyURL+policyType+"/"+policyID, nil)
+	return err
+}
+
+// invokeAdminAPI sends a request with the given JSON payload to admin rest API, returning the response body.
+// ControlPlaneError is returned if the control plane responds with an unsuccessful status code.
+func invokeAdminAPI(method string, url string, payload interface{}) ([]byte, error) {
+	authHeaderVal, err := GetSuitableAuthHeadervalue([]string{string(AdminScope), string(TierManageScope)})
+	if err != nil {
+		return nil, err
+	}
+	var body io.Reader
+	if payload != nil {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(jsonPayload)
+	}
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", authHeaderVal)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := tlsutils.InvokeControlPlane(req, skipSSL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	logger.LoggerUtils.Debugf("For the admin rest API request %s %s we received response status: %s", method, url, resp.Status)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &ControlPlaneError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	return respBody, nil
+}
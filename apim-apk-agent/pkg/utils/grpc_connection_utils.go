@@ -4,6 +4,7 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,21 +14,37 @@ import (
 	"github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/subscription"
 )
 
-var clientConnections = make(map[string]apkmgt.EventStreamService_StreamEventsServer)
+var (
+	clientConnections      = make(map[string]apkmgt.EventStreamService_StreamEventsServer)
+	clientConnectionsMutex sync.RWMutex
+	// sendMutex serialises the events sent to the clients, since a stream does not support concurrent sends and
+	// the clients apply the delta events in the order they are received.
+	sendMutex sync.Mutex
+)
 
 // AddClientConnection adds a client connection to the map
 func AddClientConnection(clientID string, stream apkmgt.EventStreamService_StreamEventsServer) {
+	clientConnectionsMutex.Lock()
+	defer clientConnectionsMutex.Unlock()
 	clientConnections[clientID] = stream
 }
 
 // DeleteClientConnection deletes a client connection from the map
 func DeleteClientConnection(clientID string) {
+	clientConnectionsMutex.Lock()
+	defer clientConnectionsMutex.Unlock()
 	delete(clientConnections, clientID)
 }
 
 // GetAllClientConnections returns all client connections
 func GetAllClientConnections() map[string]apkmgt.EventStreamService_StreamEventsServer {
-	return clientConnections
+	clientConnectionsMutex.RLock()
+	defer clientConnectionsMutex.RUnlock()
+	connections := make(map[string]apkmgt.EventStreamService_StreamEventsServer, len(clientConnections))
+	for clientID, stream := range clientConnections {
+		connections[clientID] = stream
+	}
+	return connections
 }
 
 // SendInitialEventToAllConnectedClients sends initial event to all connected clients
@@ -41,6 +58,8 @@ func SendInitialEventToAllConnectedClients() {
 		TimeStamp: milliseconds,
 	}
 	loggers.LoggerAPKOperator.Debugf("Sending initial event to all clients: %v", &event)
+	sendMutex.Lock()
+	defer sendMutex.Unlock()
 	for _, stream := range GetAllClientConnections() {
 		stream.Send(&event)
 	}
@@ -57,12 +76,20 @@ func SendInitialEvent(srv apkmgt.EventStreamService_StreamEventsServer) {
 		TimeStamp: milliseconds,
 	}
 	loggers.LoggerAPKOperator.Debugf("Sending initial event to client: %v", &event)
+	sendMutex.Lock()
+	defer sendMutex.Unlock()
 	srv.Send(&event)
 }
 
 // SendEvent sends event to the common-controllers
 func SendEvent(event *subscription.Event) {
+	if organization := getEventOrganization(event); organization != "" && !IsOrganizationAllowed(organization) {
+		loggers.LoggerAPKOperator.Debugf("Event %s is not sent since the organization %s is not served by the agent", event.Uuid, organization)
+		return
+	}
 	loggers.LoggerAPKOperator.Infof("Sending event to all clients: %v", event)
+	sendMutex.Lock()
+	defer sendMutex.Unlock()
 	for clientID, stream := range GetAllClientConnections() {
 		err := stream.Send(event)
 		if err != nil {
@@ -0,0 +1,73 @@
+/*
+ *  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/wso2/apk/common-go-libs/pkg/discovery/api/wso2/discovery/subscription"
+	"github.com/wso2/product-apim-tooling/apim-apk-agent/config"
+	logger "github.com/wso2/product-apim-tooling/apim-apk-agent/pkg/loggers"
+)
+
+// IsOrganizationAllowed returns true if the resources of the given organization are served by this agent,
+// according to the agent.organizations configurations.
+func IsOrganizationAllowed(organization string) bool {
+	conf, errReadConfig := config.ReadConfigs()
+	if errReadConfig != nil {
+		logger.LoggerUtils.Errorf("Error reading configs: %v", errReadConfig)
+	}
+	if conf == nil {
+		return true
+	}
+	return isOrganizationAllowed(organization, conf.Agent.Organizations.AllowList, conf.Agent.Organizations.DenyList)
+}
+
+// isOrganizationAllowed returns false if the organization is in the deny list. Otherwise it returns true if the
+// allow list is empty or the organization is in the allow list.
+func isOrganizationAllowed(organization string, allowList []string, denyList []string) bool {
+	if containsOrganization(denyList, organization) {
+		return false
+	}
+	return len(allowList) == 0 || containsOrganization(allowList, organization)
+}
+
+func containsOrganization(organizations []string, organization string) bool {
+	for _, org := range organizations {
+		if strings.EqualFold(org, organization) {
+			return true
+		}
+	}
+	return false
+}
+
+// getEventOrganization returns the organization of the resource carried by the given event. An empty string is
+// returned for the events which do not carry a resource, such as the initial event.
+func getEventOrganization(event *subscription.Event) string {
+	switch {
+	case event.Application != nil:
+		return event.Application.Organization
+	case event.Subscription != nil:
+		return event.Subscription.Organization
+	case event.ApplicationMapping != nil:
+		return event.ApplicationMapping.Organization
+	case event.ApplicationKeyMapping != nil:
+		return event.ApplicationKeyMapping.Organization
+	}
+	return ""
+}
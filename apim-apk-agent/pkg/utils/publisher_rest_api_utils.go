@@ -107,6 +107,7 @@ var (
 	tokenURL             string
 	apiImportURL         string
 	apiDeleteURL         string
+	throttlingPolicyURL  string
 	username             string
 	password             string
 	skipSSL              bool
@@ -130,10 +131,12 @@ func init() {
 		apiImportURL = cpURL + APIImportRelativePath
 		tokenURL = cpURL + TokenRelativePath
 		apiDeleteURL = cpURL + APIDeleteRelativePath
+		throttlingPolicyURL = cpURL + ThrottlingPolicyRelativePath
 	} else {
 		apiImportURL = cpURL + "/" + APIImportRelativePath
 		tokenURL = cpURL + "/" + TokenRelativePath
 		apiDeleteURL = cpURL + "/" + APIDeleteRelativePath
+		throttlingPolicyURL = cpURL + "/" + ThrottlingPolicyRelativePath
 	}
 	username = cpConfigs.Username
 	password = cpConfigs.Password
@@ -214,6 +217,17 @@ func GetSuitableAuthHeadervalue(scopes []string) (string, error) {
 	return basicAuthHeaderValue, nil
 }
 
+// ControlPlaneError is returned when the control plane responds to a publisher REST API request with an
+// unsuccessful status code
+type ControlPlaneError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ControlPlaneError) Error() string {
+	return fmt.Sprintf("control plane responded with status code %d: %s", e.StatusCode, e.Body)
+}
+
 // ImportAPI imports an API from a zip file, returning the ID of the imported API.
 func ImportAPI(apiZipName string, zipFileBytes *bytes.Buffer) (string, string, error) {
 	authHeaderVal, err := GetSuitableAuthHeadervalue([]string{string(AdminScope), string(ImportExportScope)})
@@ -248,6 +262,9 @@ func ImportAPI(apiZipName string, zipFileBytes *bytes.Buffer) (string, string, e
 	if resp.StatusCode == http.StatusServiceUnavailable {
 		return "", "", fmt.Errorf("could not reach APIM. Received service unavailable reponse")
 	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", "", &ControlPlaneError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
 	// try to parse the body as json and extract id from the response.
 	var responseMap map[string]interface{}
 	if err := json.Unmarshal([]byte(respBody), &responseMap); err != nil {
@@ -289,5 +306,8 @@ func DeleteAPIRevision(apiUUID string, revisionID string, body string) error {
 	if resp.StatusCode == http.StatusServiceUnavailable {
 		return fmt.Errorf("could not reach APIM. Received service unavailable reponse")
 	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &ControlPlaneError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
 	return nil
 }
@@ -177,3 +177,22 @@ func TestGetUniqueIDOfApplicationKeyMapping(t *testing.T) {
 		})
 	}
 }
+
+// TestIsOrganizationAllowed tests the allow and deny lists of the organizations
+func TestIsOrganizationAllowed(t *testing.T) {
+	assert.True(t, isOrganizationAllowed("org1", nil, nil))
+	assert.True(t, isOrganizationAllowed("org1", []string{"org1", "org2"}, nil))
+	assert.False(t, isOrganizationAllowed("org3", []string{"org1", "org2"}, nil))
+	assert.False(t, isOrganizationAllowed("org1", nil, []string{"org1"}))
+	assert.True(t, isOrganizationAllowed("org2", nil, []string{"org1"}))
+	// The deny list takes precedence over the allow list
+	assert.False(t, isOrganizationAllowed("org1", []string{"org1"}, []string{"org1"}))
+	assert.True(t, isOrganizationAllowed("Org1", []string{"org1"}, nil))
+}
+
+// TestGetEventOrganization tests resolving the organization of the events sent to the common-controllers
+func TestGetEventOrganization(t *testing.T) {
+	assert.Equal(t, "org1", getEventOrganization(&subscription.Event{Application: &subscription.Application{Organization: "org1"}}))
+	assert.Equal(t, "org2", getEventOrganization(&subscription.Event{Subscription: &subscription.Subscription{Organization: "org2"}}))
+	assert.Equal(t, "", getEventOrganization(&subscription.Event{Type: "ALL_EVENTS"}))
+}
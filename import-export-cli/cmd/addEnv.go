@@ -34,6 +34,12 @@ var flagApiManagerEndpoint string   // api manager endpoint of the environment t
 var flagAdminEndpoint string        // admin endpoint of the environment to be added
 var flagMiManagementEndpoint string // mi management endpoint of the environment to be added
 
+var flagCABundle string                // CA bundle trusted for the endpoints of the environment to be added
+var flagClientCertificate string       // client certificate for mutual TLS with the environment to be added
+var flagClientKey string               // client key for mutual TLS with the environment to be added
+var flagProxy string                   // HTTP proxy for the endpoints of the environment to be added
+var flagEnvTLSRenegotiationMode string // TLS renegotiation mode of the environment to be added
+
 // AddEnv command related Info
 const AddEnvCmdLiteral = "env [environment]"
 const AddEnvCmdLiteralTrimmed = "env"
@@ -62,10 +68,19 @@ const addEnvCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnv
 --registration https://idp.com:9443 \
 --token https://gw.com:8243/token
 
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` staging \
+--apim https://apim.internal:9443 \
+--ca-bundle /etc/ssl/internal-ca.pem \
+--client-cert certs/apictl.crt \
+--client-key certs/apictl.key \
+--proxy http://proxy.internal:3128
+
 You can either provide only the flag --apim , or all the other 4 flags (--registration --publisher --devportal --admin) without providing --apim flag.
 If you are omitting any of --registration --publisher --devportal --admin flags, you need to specify --apim flag with the API Manager endpoint. In both of the
 cases --token flag is optional and use it to specify the gateway token endpoint. This will be used for "apictl get-keys" operation.
-To add a micro integrator instance to an environment you can use the --mi flag.`
+To add a micro integrator instance to an environment you can use the --mi flag.
+The --ca-bundle, --client-cert, --client-key, --proxy and --tls-renegotiation-mode flags set the TLS and proxy
+settings of the connections to the endpoints of the environment. Relative paths are resolved against the config directory.`
 
 // addEnvCmd represents the addEnv command
 var addEnvCmd = &cobra.Command{
@@ -92,6 +107,13 @@ func executeAddEnvCmd(mainConfigFilePath string) {
 	envEndpoints.AdminEndpoint = flagAdminEndpoint
 	envEndpoints.TokenEndpoint = flagTokenEndpoint
 	envEndpoints.MiManagementEndpoint = flagMiManagementEndpoint
+	envEndpoints.TransportSettings = utils.TransportSettings{
+		CABundle:             flagCABundle,
+		ClientCertificate:    flagClientCertificate,
+		ClientKey:            flagClientKey,
+		Proxy:                flagProxy,
+		TLSRenegotiationMode: flagEnvTLSRenegotiationMode,
+	}
 	err := impl.AddEnv(envToBeAdded, envEndpoints, mainConfigFilePath, AddEnvCmdLiteral)
 	if err != nil {
 		utils.HandleErrorAndExit("Error adding environment", err)
@@ -110,5 +132,14 @@ func init() {
 		"Registration endpoint for the environment")
 	addEnvCmd.Flags().StringVar(&flagAdminEndpoint, "admin", "", "Admin endpoint for the environment")
 	addEnvCmd.Flags().StringVar(&flagMiManagementEndpoint, "mi", "", "Micro Integrator Management endpoint for the environment")
+	addEnvCmd.Flags().StringVar(&flagCABundle, "ca-bundle", "",
+		"PEM file of the CA certificates trusted for the endpoints of the environment")
+	addEnvCmd.Flags().StringVar(&flagClientCertificate, "client-cert", "",
+		"PEM file of the client certificate for mutual TLS with the environment")
+	addEnvCmd.Flags().StringVar(&flagClientKey, "client-key", "",
+		"PEM file of the client key for mutual TLS with the environment")
+	addEnvCmd.Flags().StringVar(&flagProxy, "proxy", "", "HTTP proxy URL for the endpoints of the environment")
+	addEnvCmd.Flags().StringVar(&flagEnvTLSRenegotiationMode, "tls-renegotiation-mode", "",
+		"TLS renegotiation mode of the environment (never, once or freely)")
 	_ = addEnvCmd.MarkFlagRequired("environment")
 }
@@ -0,0 +1,98 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var addSubscriptionEnvironment string
+var addSubscriptionAppName string
+var addSubscriptionApiName string
+var addSubscriptionApiVersion string
+var addSubscriptionApiProvider string
+var addSubscriptionThrottlingPolicy string
+
+// AddSubscription command related usage info
+const AddSubscriptionCmdLiteral = "subscription"
+const addSubscriptionCmdShortDesc = "Subscribe an Application to an API or API Product"
+const addSubscriptionCmdLongDesc = "Subscribe an Application to an API or API Product in the DevPortal of an " +
+	"environment. The first subscription throttling policy of the API or API Product is used if the flag " +
+	"--throttling-policy is not given."
+
+const addSubscriptionCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddSubscriptionCmdLiteral + ` --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddSubscriptionCmdLiteral + ` --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -r admin --throttling-policy Gold -e dev
+NOTE: The flags (--app-name, --name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// AddSubscriptionCmd represents the add subscription command
+var AddSubscriptionCmd = &cobra.Command{
+	Use:     AddSubscriptionCmdLiteral,
+	Short:   addSubscriptionCmdShortDesc,
+	Long:    addSubscriptionCmdLongDesc,
+	Example: addSubscriptionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + AddCmdLiteral + " " + AddSubscriptionCmdLiteral + " called")
+		cred, err := GetCredentials(addSubscriptionEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeAddSubscriptionCmd(cred)
+	},
+}
+
+// executeAddSubscriptionCmd executes the add subscription command
+func executeAddSubscriptionCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, addSubscriptionEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while subscribing", err)
+	}
+	subscription, err := impl.AddSubscription(accessToken, addSubscriptionEnvironment, addSubscriptionAppName,
+		addSubscriptionApiName, addSubscriptionApiVersion, addSubscriptionApiProvider, addSubscriptionThrottlingPolicy)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while subscribing "+addSubscriptionAppName+" to "+addSubscriptionApiName, err)
+	}
+	fmt.Println(addSubscriptionAppName + " subscribed to " + addSubscriptionApiName + " successfully! Subscription ID: " +
+		subscription.SubscriptionID + " Status: " + subscription.Status)
+}
+
+// init using Cobra
+func init() {
+	AddCmd.AddCommand(AddSubscriptionCmd)
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionAppName, "app-name", "", "",
+		"Name of the Application to be subscribed")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionApiName, "name", "n", "",
+		"Name of the API or API Product")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionApiVersion, "version", "v", "",
+		"Version of the API or API Product")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionApiProvider, "provider", "r", "",
+		"Provider of the API or API Product")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionThrottlingPolicy, "throttling-policy", "", "",
+		"Subscription throttling policy")
+	AddSubscriptionCmd.Flags().StringVarP(&addSubscriptionEnvironment, "environment", "e", "",
+		"Environment of the Application and the API or API Product")
+	_ = AddSubscriptionCmd.MarkFlagRequired("app-name")
+	_ = AddSubscriptionCmd.MarkFlagRequired("name")
+	_ = AddSubscriptionCmd.MarkFlagRequired("version")
+	utils.MarkEnvFlagRequired(AddSubscriptionCmd)
+}
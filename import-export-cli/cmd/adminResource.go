@@ -0,0 +1,70 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// executeExportAdminResourceCmd exports an admin resource of the export environment and archives it in the export
+// directory of the admin resources
+func executeExportAdminResourceCmd(credential credentials.Credential, resourceType, name, exportFormat string) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, CmdExportEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while exporting the "+resourceType, err)
+	}
+	resource, err := impl.ExportAdminResourceFromEnv(accessToken, CmdExportEnvironment, resourceType, name)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while exporting the "+resourceType, err)
+	}
+	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedAdminResourcesDirName, CmdExportEnvironment)
+	archivePath, err := impl.WriteAdminResourceToArchive(exportDirectory, resource, exportFormat)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while writing the exported "+resourceType, err)
+	}
+	fmt.Println("Successfully exported the " + resource.Subtype + "!")
+	fmt.Println("Find the exported " + resource.Subtype + " at " + archivePath)
+	variables, err := impl.GetAdminResourceSecretVariables(resource)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while reading the exported "+resourceType, err)
+	}
+	if len(variables) > 0 {
+		fmt.Println("The secrets of the " + resource.Subtype + " are not exported. Set the environment variables " +
+			strings.Join(variables, ", ") + " before importing it.")
+	}
+}
+
+// executeImportAdminResourceCmd imports an exported admin resource to the import environment
+func executeImportAdminResourceCmd(credential credentials.Credential, resourceType, importPath string, update bool) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, importEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while importing the "+resourceType, err)
+	}
+	err = impl.ImportAdminResourceToEnv(accessToken, importEnvironment, resourceType, importPath, update)
+	if err != nil {
+		utils.HandleErrorAndExit("Error importing the "+resourceType, err)
+	}
+	fmt.Println("Successfully imported the " + resourceType + "!")
+}
@@ -69,5 +69,5 @@ func init() {
 		"", "Environment from which the APIs should be Purgeed")
 	PurgeAPIsCmd.Flags().StringVarP(&token, "token", "", "", "on-prem-key of the organization")
 	PurgeAPIsCmd.Flags().StringVarP(&endpoint, "endpoint", "", "", "endpoint of the marketplace assistant service")
-	_ = PurgeAPIsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(PurgeAPIsCmd)
 }
@@ -65,6 +65,6 @@ func init() {
 	UploadAPIProductsCmd.Flags().StringVarP(&endpoint, "endpoint", "", "", "endpoint of the marketplace assistant service")
 	UploadAPIProductsCmd.Flags().BoolVarP(&uploadAll, "all", "", false,
 		"Upload both apis and api products")
-	_ = UploadAPIProductsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(UploadAPIProductsCmd)
 
 }
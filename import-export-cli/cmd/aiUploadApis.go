@@ -70,5 +70,5 @@ func init() {
 	UploadAPIsCmd.Flags().StringVarP(&endpoint, "endpoint", "", "", "endpoint of the marketplace assistant service")
 	UploadAPIsCmd.Flags().BoolVarP(&uploadAll, "all", "", false,
 		"Upload both apis and api products")
-	_ = UploadAPIsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(UploadAPIsCmd)
 }
@@ -103,5 +103,5 @@ func init() {
 	_ = ChangeAPIProductStatusCmd.MarkFlagRequired("action")
 	_ = ChangeAPIProductStatusCmd.MarkFlagRequired("name")
 	_ = ChangeAPIProductStatusCmd.MarkFlagRequired("version")
-	_ = ChangeAPIProductStatusCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ChangeAPIProductStatusCmd)
 }
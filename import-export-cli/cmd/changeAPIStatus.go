@@ -104,5 +104,5 @@ func init() {
 	_ = ChangeAPIStatusCmd.MarkFlagRequired("action")
 	_ = ChangeAPIStatusCmd.MarkFlagRequired("name")
 	_ = ChangeAPIStatusCmd.MarkFlagRequired("version")
-	_ = ChangeAPIStatusCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ChangeAPIStatusCmd)
 }
@@ -26,12 +26,13 @@ import (
 
 // ChangeStatus command related usage info
 const changeStatusCmdLiteral = "change-status"
-const changeStatusCmdShortDesc = "Change Status of an API or API Product"
-const changeStatusCmdLongDesc = "Change the lifecycle status of an API or API Product in an environment"
+const changeStatusCmdShortDesc = "Change Status of an API, API Product or Subscription"
+const changeStatusCmdLongDesc = "Change the lifecycle status of an API or API Product, or block or unblock a Subscription in an environment"
 
 const changeStatusCmdExamples = utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIStatusCmdLiteral + ` -a Publish -n TwitterAPI -v 1.0.0 -r admin -e dev
 ` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIStatusCmdLiteral + ` -a Publish -n FacebookAPI -v 2.1.0 -e production
-` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIProductStatusCmdLiteral + ` -a Publish -n SocialMediaProduct -v 1.0.0 -r admin -e dev`
+` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeAPIProductStatusCmdLiteral + ` -a Publish -n SocialMediaProduct -v 1.0.0 -r admin -e dev
+` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeSubscriptionStatusCmdLiteral + ` -a BLOCKED --app-name SampleApp -n TwitterAPI -v 1.0.0 -e dev`
 
 // ChangeStatusCmd represents the change-status command
 var ChangeStatusCmd = &cobra.Command{
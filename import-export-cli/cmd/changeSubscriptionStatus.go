@@ -0,0 +1,104 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var subscriptionStatusChangeEnvironment string
+var appNameForSubscriptionStatusChange string
+var apiNameForSubscriptionStatusChange string
+var apiVersionForSubscriptionStatusChange string
+var apiProviderForSubscriptionStatusChange string
+var subscriptionStatusChangeAction string
+
+// ChangeSubscriptionStatus command related usage info
+const changeSubscriptionStatusCmdLiteral = "subscription"
+const changeSubscriptionStatusCmdShortDesc = "Change Status of a Subscription"
+const changeSubscriptionStatusCmdLongDesc = "Block or unblock the subscription of an Application to an API or API " +
+	"Product in an environment. The action is " + impl.SubscriptionStatusBlocked + ", " +
+	impl.SubscriptionStatusProdOnlyBlocked + " or " + impl.SubscriptionStatusUnblocked + "."
+
+const changeSubscriptionStatusCmdExamples = utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeSubscriptionStatusCmdLiteral + ` -a BLOCKED --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeSubscriptionStatusCmdLiteral + ` -a PROD_ONLY_BLOCKED --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -r admin -e dev
+` + utils.ProjectName + ` ` + changeStatusCmdLiteral + ` ` + changeSubscriptionStatusCmdLiteral + ` -a UNBLOCKED --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -e dev
+NOTE: The flags (--action (-a), --app-name, --name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// ChangeSubscriptionStatusCmd represents change-status subscription command
+var ChangeSubscriptionStatusCmd = &cobra.Command{
+	Use: changeSubscriptionStatusCmdLiteral + " (--action <new-status-of-the-subscription> --app-name " +
+		"<name-of-the-application> --name <name-of-the-api> --version <version-of-the-api> --provider " +
+		"<provider-of-the-api> --environment <environment-of-the-subscription>)",
+	Short:   changeSubscriptionStatusCmdShortDesc,
+	Long:    changeSubscriptionStatusCmdLongDesc,
+	Example: changeSubscriptionStatusCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + changeSubscriptionStatusCmdLiteral + " called")
+		cred, err := GetCredentials(subscriptionStatusChangeEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials ", err)
+		}
+		executeChangeSubscriptionStatusCmd(cred)
+	},
+}
+
+// executeChangeSubscriptionStatusCmd executes the change-status subscription command
+func executeChangeSubscriptionStatusCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, subscriptionStatusChangeEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while changing the status of the subscription", err)
+	}
+	err = impl.ChangeSubscriptionStatus(accessToken, subscriptionStatusChangeEnvironment,
+		appNameForSubscriptionStatusChange, apiNameForSubscriptionStatusChange, apiVersionForSubscriptionStatusChange,
+		apiProviderForSubscriptionStatusChange, subscriptionStatusChangeAction)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while changing the subscription status", err)
+	}
+	fmt.Println("Subscription of " + appNameForSubscriptionStatusChange + " to " +
+		apiNameForSubscriptionStatusChange + " changed to " + subscriptionStatusChangeAction + " successfully!")
+}
+
+func init() {
+	ChangeStatusCmd.AddCommand(ChangeSubscriptionStatusCmd)
+	ChangeSubscriptionStatusCmd.Flags().StringVarP(&subscriptionStatusChangeAction, "action", "a", "",
+		"New status of the subscription ("+impl.SubscriptionStatusBlocked+", "+
+			impl.SubscriptionStatusProdOnlyBlocked+" or "+impl.SubscriptionStatusUnblocked+")")
+	ChangeSubscriptionStatusCmd.Flags().StringVarP(&appNameForSubscriptionStatusChange, "app-name", "", "",
+		"Name of the subscribed Application")
+	ChangeSubscriptionStatusCmd.Flags().StringVarP(&apiNameForSubscriptionStatusChange, "name", "n", "",
+		"Name of the API or API Product")
+	ChangeSubscriptionStatusCmd.Flags().StringVarP(&apiVersionForSubscriptionStatusChange, "version", "v", "",
+		"Version of the API or API Product")
+	ChangeSubscriptionStatusCmd.Flags().StringVarP(&apiProviderForSubscriptionStatusChange, "provider", "r", "",
+		"Provider of the API or API Product")
+	ChangeSubscriptionStatusCmd.Flags().StringVarP(&subscriptionStatusChangeEnvironment, "environment", "e",
+		"", "Environment of which the subscription status should be changed")
+	// Mark required flags
+	_ = ChangeSubscriptionStatusCmd.MarkFlagRequired("action")
+	_ = ChangeSubscriptionStatusCmd.MarkFlagRequired("app-name")
+	_ = ChangeSubscriptionStatusCmd.MarkFlagRequired("name")
+	_ = ChangeSubscriptionStatusCmd.MarkFlagRequired("version")
+	utils.MarkEnvFlagRequired(ChangeSubscriptionStatusCmd)
+}
@@ -0,0 +1,98 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/wso2/product-apim-tooling/import-export-cli/cmd/mg"
+	mi "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Config command related usage Info
+const ConfigCmdLiteral = "config"
+const configCmdShortDesc = "Manage the contexts of " + utils.ProjectName
+
+const configCmdLongDesc = `Manage the contexts, which combine an environment with the user logged into it, the default tenant ` +
+	`and the default output format. The commands run without the --environment (-e) flag use the current context.`
+
+const configCmdExamples = utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigSetContextCmdLiteral + ` dev-admin -e dev -u admin
+` + utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigUseContextCmdLiteral + ` dev-admin
+` + utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigGetContextsCmdLiteral
+
+// ConfigCmd represents the config command
+var ConfigCmd = &cobra.Command{
+	Use:     ConfigCmdLiteral,
+	Short:   configCmdShortDesc,
+	Long:    configCmdLongDesc,
+	Example: configCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ConfigCmdLiteral + " called")
+
+	},
+}
+
+// applyCurrentContext sets the environment, the tenant domain and the output format flags of the command, which are
+// not provided, from the current context, and then validates the environment flag marked with
+// utils.MarkEnvFlagRequired. The config, mi and mg commands and the environment flags marked required with cobra, such
+// as the one of add env, are not affected.
+func applyCurrentContext(cmd *cobra.Command, args []string) error {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c == ConfigCmd || c == mi.MICmd || c == mg.MgCmd {
+			return nil
+		}
+	}
+	flags := cmd.Flags()
+	if name, context := utils.GetCurrentContext(utils.MainConfigFilePath); context != nil {
+		utils.Logln(utils.LogPrefixInfo + "Using the context " + name)
+		if envFlag := flags.Lookup("environment"); envFlag != nil &&
+			envFlag.Annotations[cobra.BashCompOneRequiredFlag] == nil {
+			setFlagFromContext(flags, "environment", context.Environment)
+		}
+		setFlagFromContext(flags, "tenant-domain", context.Tenant)
+		if cmd.Parent() != nil && cmd.Parent().Name() == GetCmdLiteral {
+			setFlagFromContext(flags, "format", context.Output)
+		}
+	}
+	if envFlag := flags.Lookup("environment"); envFlag != nil && !envFlag.Changed &&
+		envFlag.Annotations[utils.EnvFlagRequiredAnnotation] != nil {
+		return errors.New(`required flag(s) "environment" not set`)
+	}
+	return nil
+}
+
+// setFlagFromContext sets the flag to the value of the context if the command has the flag and it is not provided
+func setFlagFromContext(flags *pflag.FlagSet, name, value string) {
+	flag := flags.Lookup(name)
+	if flag == nil || flag.Changed || value == "" {
+		return
+	}
+	if err := flags.Set(name, value); err != nil {
+		utils.HandleErrorAndExit("Error setting --"+name+" from the current context", err)
+	}
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(ConfigCmd)
+	RootCmd.PersistentPreRunE = applyCurrentContext
+}
@@ -0,0 +1,61 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const defaultContextsTableFormat = "table {{.Current}}\t{{.Name}}\t{{.Environment}}\t{{.User}}\t{{.Tenant}}\t{{.Output}}"
+
+var contextsCmdFormat string
+
+const (
+	// ConfigGetContexts command related usage info
+	ConfigGetContextsCmdLiteral   = "get-contexts"
+	configGetContextsCmdShortDesc = "Display the list of contexts"
+	configGetContextsCmdLongDesc  = "Display the list of contexts defined in '" + utils.MainConfigFileName +
+		"' file. The current context is marked with *."
+)
+
+const configGetContextsCmdExamples = utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigGetContextsCmdLiteral + `
+` + utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigGetContextsCmdLiteral + ` --format "{{ jsonPretty . }}"`
+
+// ConfigGetContextsCmd represents the config get-contexts command
+var ConfigGetContextsCmd = &cobra.Command{
+	Use:     ConfigGetContextsCmdLiteral,
+	Short:   configGetContextsCmdShortDesc,
+	Long:    configGetContextsCmdLongDesc,
+	Example: configGetContextsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ConfigGetContextsCmdLiteral + " called")
+		mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
+		impl.PrintContexts(mainConfig.Contexts, mainConfig.CurrentContext, contextsCmdFormat,
+			defaultContextsTableFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ConfigCmd.AddCommand(ConfigGetContextsCmd)
+	ConfigGetContextsCmd.Flags().StringVarP(&contextsCmdFormat, "format", "", defaultContextsTableFormat,
+		"Pretty-print contexts using go templates")
+}
@@ -0,0 +1,99 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	setContextEnvironment string
+	setContextUser        string
+	setContextTenant      string
+	setContextOutput      string
+)
+
+const (
+	// ConfigSetContext command related usage info
+	ConfigSetContextCmdLiteral   = "set-context"
+	configSetContextCmdShortDesc = "Add or update a context"
+	configSetContextCmdLongDesc  = "Add a context, or update the given fields of an existing context, in '" +
+		utils.MainConfigFileName + "'. A context combines an environment with the user logged into it, the default " +
+		"tenant domain and the default output format of the get commands."
+)
+
+const configSetContextCmdExamples = utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigSetContextCmdLiteral + ` dev-admin -e dev -u admin
+` + utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigSetContextCmdLiteral + ` prod -e production --tenant wso2.com --output "{{ jsonPretty . }}"
+` + utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigSetContextCmdLiteral + ` prod --output ""
+NOTE: The flag (--environment (-e)) is mandatory when a new context is added.`
+
+// ConfigSetContextCmd represents the config set-context command
+var ConfigSetContextCmd = &cobra.Command{
+	Use:     ConfigSetContextCmdLiteral + " <context-name> [flags]",
+	Short:   configSetContextCmdShortDesc,
+	Long:    configSetContextCmdLongDesc,
+	Example: configSetContextCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ConfigSetContextCmdLiteral + " called")
+		err := runSetContext(cmd, args[0])
+		if err != nil {
+			utils.HandleErrorAndExit("Error setting the context "+args[0], err)
+		}
+		fmt.Println("Context " + args[0] + " is set")
+	},
+}
+
+// runSetContext applies the provided flags over the existing context of the given name, if there is one
+func runSetContext(cmd *cobra.Command, name string) error {
+	context := utils.GetMainConfigFromFile(utils.MainConfigFilePath).Contexts[name]
+	if cmd.Flags().Changed("environment") {
+		context.Environment = setContextEnvironment
+	}
+	if cmd.Flags().Changed("user") {
+		context.User = setContextUser
+	}
+	if cmd.Flags().Changed("tenant") {
+		context.Tenant = setContextTenant
+	}
+	if cmd.Flags().Changed("output") {
+		context.Output = setContextOutput
+	}
+	if context.Environment == "" {
+		return errors.New("environment of the context should be provided using --environment (-e)")
+	}
+	return utils.SetContextInMainConfigFile(name, context, utils.MainConfigFilePath)
+}
+
+// init using Cobra
+func init() {
+	ConfigCmd.AddCommand(ConfigSetContextCmd)
+	ConfigSetContextCmd.Flags().StringVarP(&setContextEnvironment, "environment", "e", "",
+		"Environment of the context")
+	ConfigSetContextCmd.Flags().StringVarP(&setContextUser, "user", "u", "",
+		"User who should be logged into the environment to use the context")
+	ConfigSetContextCmd.Flags().StringVarP(&setContextTenant, "tenant", "", "",
+		"Default tenant domain of the commands run in the context")
+	ConfigSetContextCmd.Flags().StringVarP(&setContextOutput, "output", "o", "",
+		"Default format of the output of the get commands run in the context")
+}
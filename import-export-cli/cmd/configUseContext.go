@@ -0,0 +1,89 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	// ConfigUseContext command related usage info
+	ConfigUseContextCmdLiteral   = "use-context"
+	configUseContextCmdShortDesc = "Switch the current context"
+	configUseContextCmdLongDesc  = "Switch the current context, which is used by the commands run without the " +
+		"--environment (-e) flag. The switch is refused if the user of the context is not logged into its environment."
+)
+
+const configUseContextCmdExamples = utils.ProjectName + ` ` + ConfigCmdLiteral + ` ` + ConfigUseContextCmdLiteral + ` dev-admin`
+
+// ConfigUseContextCmd represents the config use-context command
+var ConfigUseContextCmd = &cobra.Command{
+	Use:     ConfigUseContextCmdLiteral + " <context-name>",
+	Short:   configUseContextCmdShortDesc,
+	Long:    configUseContextCmdLongDesc,
+	Example: configUseContextCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ConfigUseContextCmdLiteral + " called")
+		store, err := credentials.GetDefaultCredentialStore()
+		if err != nil {
+			utils.HandleErrorAndExit("Error occurred while loading credential store", err)
+		}
+		err = runUseContext(store, args[0])
+		if err != nil {
+			utils.HandleErrorAndExit("Error switching to the context "+args[0], err)
+		}
+		fmt.Println("Switched to the context " + args[0])
+	},
+}
+
+// runUseContext sets the current context after checking whether the user of the context is logged into its
+// environment
+func runUseContext(store credentials.Store, name string) error {
+	context, err := utils.GetContextFromMainConfigFile(name, utils.MainConfigFilePath)
+	if err != nil {
+		return err
+	}
+	if !store.HasAPIM(context.Environment) {
+		return fmt.Errorf("credentials are not found for the environment %s, login using '%s login %s'",
+			context.Environment, utils.ProjectName, context.Environment)
+	}
+	if context.User != "" {
+		cred, err := store.GetAPIMCredentials(context.Environment)
+		if err != nil {
+			return err
+		}
+		// The credentials of the token logins do not have a username to be matched
+		if cred.Username != "" && cred.Username != context.User {
+			return fmt.Errorf("%s is logged into the environment %s instead of %s, login using '%s login %s -u %s'",
+				cred.Username, context.Environment, context.User, utils.ProjectName, context.Environment,
+				context.User)
+		}
+	}
+	return utils.SetCurrentContextInMainConfigFile(name, utils.MainConfigFilePath)
+}
+
+// init using Cobra
+func init() {
+	ConfigCmd.AddCommand(ConfigUseContextCmd)
+}
@@ -101,6 +101,6 @@ func init() {
 		// Mark required flags
 		_ = DeleteAPICmd.MarkFlagRequired("name")
 		_ = DeleteAPICmd.MarkFlagRequired("version")
-		_ = DeleteAPICmd.MarkFlagRequired("environment")
+		utils.MarkEnvFlagRequired(DeleteAPICmd)
 	}
 }
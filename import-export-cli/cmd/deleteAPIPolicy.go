@@ -86,5 +86,5 @@ func init() {
 
 	_ = DeleteAPIPolicyCmd.MarkFlagRequired("name")
 	_ = DeleteAPIPolicyCmd.MarkFlagRequired("version")
-	_ = DeleteAPIPolicyCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(DeleteAPIPolicyCmd)
 }
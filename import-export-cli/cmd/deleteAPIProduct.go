@@ -88,5 +88,5 @@ func init() {
 	// Mark required flags
 	_ = DeleteAPIProductCmd.MarkFlagRequired("name")
 	_ = DeleteAPIProductCmd.MarkFlagRequired("version")
-	_ = DeleteAPIProductCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(DeleteAPIProductCmd)
 }
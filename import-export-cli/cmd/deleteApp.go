@@ -87,5 +87,5 @@ func init() {
 		"", "Environment from which the Application should be deleted")
 	// Mark required flags
 	_ = DeleteAppCmd.MarkFlagRequired("name")
-	_ = DeleteAppCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(DeleteAppCmd)
 }
@@ -0,0 +1,95 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteSubscriptionEnvironment string
+var deleteSubscriptionAppName string
+var deleteSubscriptionApiName string
+var deleteSubscriptionApiVersion string
+var deleteSubscriptionApiProvider string
+
+// DeleteSubscription command related usage info
+const deleteSubscriptionCmdLiteral = "subscription"
+const deleteSubscriptionCmdShortDesc = "Delete Subscription"
+const deleteSubscriptionCmdLongDesc = "Delete the subscription of an Application to an API or API Product in an environment"
+
+const deleteSubscriptionCmdExamples = utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteSubscriptionCmdLiteral + ` --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + deleteCmdLiteral + ` ` + deleteSubscriptionCmdLiteral + ` --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -r admin -e production
+NOTE: The flags (--app-name, --name (-n), --version (-v) and --environment (-e)) are mandatory.`
+
+// DeleteSubscriptionCmd represents the delete subscription command
+var DeleteSubscriptionCmd = &cobra.Command{
+	Use: deleteSubscriptionCmdLiteral + " (--app-name <name-of-the-application> --name <name-of-the-api> --version " +
+		"<version-of-the-api> --provider <provider-of-the-api> --environment <environment-of-the-subscription>)",
+	Short:   deleteSubscriptionCmdShortDesc,
+	Long:    deleteSubscriptionCmdLongDesc,
+	Example: deleteSubscriptionCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + deleteSubscriptionCmdLiteral + " called")
+		cred, err := GetCredentials(deleteSubscriptionEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials ", err)
+		}
+		executeDeleteSubscriptionCmd(cred)
+	},
+}
+
+// executeDeleteSubscriptionCmd executes the delete subscription command
+func executeDeleteSubscriptionCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, deleteSubscriptionEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while deleting the subscription", err)
+	}
+	err = impl.DeleteSubscription(accessToken, deleteSubscriptionEnvironment, deleteSubscriptionAppName,
+		deleteSubscriptionApiName, deleteSubscriptionApiVersion, deleteSubscriptionApiProvider)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while deleting the subscription ", err)
+	}
+	fmt.Println("Subscription of " + deleteSubscriptionAppName + " to " + deleteSubscriptionApiName +
+		" deleted successfully!")
+}
+
+// Init using Cobra
+func init() {
+	DeleteCmd.AddCommand(DeleteSubscriptionCmd)
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionAppName, "app-name", "", "",
+		"Name of the subscribed Application")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionApiName, "name", "n", "",
+		"Name of the API or API Product")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionApiVersion, "version", "v", "",
+		"Version of the API or API Product")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionApiProvider, "provider", "r", "",
+		"Provider of the API or API Product")
+	DeleteSubscriptionCmd.Flags().StringVarP(&deleteSubscriptionEnvironment, "environment", "e",
+		"", "Environment from which the subscription should be deleted")
+	// Mark required flags
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("app-name")
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("name")
+	_ = DeleteSubscriptionCmd.MarkFlagRequired("version")
+	utils.MarkEnvFlagRequired(DeleteSubscriptionCmd)
+}
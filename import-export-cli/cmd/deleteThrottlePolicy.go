@@ -86,6 +86,6 @@ func init() {
 	DeleteThrottlingPolicyCmd.Flags().StringVarP(&deleteThrottlingPolicyType, "type", "t",
 		"", "Type of the Throttling Policies to be exported (sub,app,custom,advanced)")
 	_ = DeleteThrottlingPolicyCmd.MarkFlagRequired("name")
-	_ = DeleteThrottlingPolicyCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(DeleteThrottlingPolicyCmd)
 	_ = DeleteThrottlingPolicyCmd.MarkFlagRequired("type")
 }
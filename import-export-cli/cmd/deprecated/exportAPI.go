@@ -114,5 +114,5 @@ func init() {
 	ExportAPICmdDeprecated.Flags().StringVarP(&exportAPIFormat, "format", "", utils.DefaultExportFormat, "File format of exported archive(json or yaml)")
 	_ = ExportAPICmdDeprecated.MarkFlagRequired("name")
 	_ = ExportAPICmdDeprecated.MarkFlagRequired("version")
-	_ = ExportAPICmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAPICmdDeprecated)
 }
@@ -100,5 +100,5 @@ func init() {
 	ExportAPIsCmdDeprecated.Flags().BoolVarP(&exportAPIPreserveStatus, "preserveStatus", "", true,
 		"Preserve API status when exporting. Otherwise API will be exported in CREATED status")
 	ExportAPIsCmdDeprecated.Flags().StringVarP(&exportAPIsFormat, "format", "", utils.DefaultExportFormat, "File format of exported archives(json or yaml)")
-	_ = ExportAPIsCmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAPIsCmdDeprecated)
 }
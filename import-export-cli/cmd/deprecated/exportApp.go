@@ -100,7 +100,7 @@ func init() {
 		"", "Environment to which the Application should be exported")
 	ExportAppCmdDeprecated.Flags().BoolVarP(&exportAppWithKeys, "withKeys", "",
 		false, "Export keys for the application ")
-	_ = ExportAppCmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAppCmdDeprecated)
 	_ = ExportAppCmdDeprecated.MarkFlagRequired("owner")
 	_ = ExportAppCmdDeprecated.MarkFlagRequired("name")
 }
@@ -75,5 +75,5 @@ func init() {
 	getKeysCmdDeprecated.Flags().StringVarP(&apiProvider, "provider", "r", "", "Provider of the API or API Product")
 	getKeysCmdDeprecated.Flags().StringVarP(&keyGenTokenEndpoint, "token", "t", "", "Token endpoint URL of Environment")
 	_ = getKeysCmdDeprecated.MarkFlagRequired("name")
-	_ = getKeysCmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getKeysCmdDeprecated)
 }
@@ -90,7 +90,9 @@ func init() {
 		"Provide a API Manager params file")
 	ImportAPICmdDeprecated.Flags().BoolVarP(&importAPISkipCleanup, "skipCleanup", "", false, "Leave "+
 		"all temporary files created during import process")
+	ImportAPICmdDeprecated.Flags().BoolVar(&utils.AllowExecSecrets, "allow-exec-secrets", false,
+		"Run the commands of the ${exec:<command>} secret references in the params file")
 	// Mark required flags
-	_ = ImportAPICmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportAPICmdDeprecated)
 	_ = ImportAPICmdDeprecated.MarkFlagRequired("file")
 }
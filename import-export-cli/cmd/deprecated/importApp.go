@@ -95,5 +95,5 @@ func init() {
 	ImportAppCmdDeprecated.Flags().BoolVarP(&importAppSkipCleanup, "skipCleanup", "", false, "Leave "+
 		"all temporary files created during import process")
 	_ = ImportAppCmdDeprecated.MarkFlagRequired("file")
-	_ = ImportAppCmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportAppCmdDeprecated)
 }
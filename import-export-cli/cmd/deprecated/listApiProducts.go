@@ -100,5 +100,5 @@ func init() {
 		strconv.Itoa(utils.DefaultApiProductsDisplayLimit), "Maximum number of API Products to return")
 	apiProductsCmdDeprecated.Flags().StringVarP(&listApiProductsCmdFormat, "format", "", "", "Pretty-print API Products "+
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = apiProductsCmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(apiProductsCmdDeprecated)
 }
@@ -98,5 +98,5 @@ func init() {
 		strconv.Itoa(utils.DefaultApisDisplayLimit), "Maximum number of apis to return")
 	apisCmdDeprecated.Flags().StringVarP(&listApisCmdFormat, "format", "", "", "Pretty-print apis "+
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = apisCmdDeprecated.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(apisCmdDeprecated)
 }
@@ -96,5 +96,5 @@ func init() {
 		strconv.Itoa(utils.DefaultAppsDisplayLimit), "Maximum number of applications to return")
 	appsCmdDeprected.Flags().StringVarP(&listAppsCmdFormat, "format", "", "", "Pretty-print output"+
 		"using Go templates. Use \"{{jsonPretty .}}\" to list all fields")
-	_ = appsCmdDeprected.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(appsCmdDeprected)
 }
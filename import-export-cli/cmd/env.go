@@ -0,0 +1,46 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Env command related usage Info
+const EnvCmdLiteral = "env"
+const envCmdShortDesc = "Check environments"
+const envCmdLongDesc = `Check the health of an environment and its compatibility with ` + utils.ProjectName
+
+const envCmdExamples = utils.ProjectName + ` ` + EnvCmdLiteral + ` ` + EnvCheckCmdLiteral + ` -e prod`
+
+// EnvCmd represents the env command
+var EnvCmd = &cobra.Command{
+	Use:     EnvCmdLiteral,
+	Short:   envCmdShortDesc,
+	Long:    envCmdLongDesc,
+	Example: envCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + EnvCmdLiteral + " called")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(EnvCmd)
+}
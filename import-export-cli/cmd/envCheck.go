@@ -0,0 +1,103 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var envCheckCmdEnvironment string
+var envCheckCmdFormat string
+var envCheckCmdRegisterClient bool
+
+// EnvCheckCmd related info
+const EnvCheckCmdLiteral = "check"
+const envCheckCmdShortDesc = "Check the health of an environment"
+
+const envCheckCmdLongDesc = `Check the health of the environment specified by the flag --environment, -e and its ` +
+	`compatibility with ` + utils.ProjectName + `. The reachability and the TLS certificates of the endpoints of ` +
+	`the environment, the client registration and the token acquisition with the stored credentials and the ` +
+	`versions of the REST APIs served by the server are checked. The client registration check only checks that ` +
+	`the registration endpoint accepts the stored credentials, unless --register-client is given to register the ` +
+	`DCR client of ` + utils.ProjectName + ` for the logged in user, as the login does. The versions of the REST ` +
+	`APIs are compared with the versions of API Manager ` + utils.ProjectName + ` is built for. A certificate ` +
+	`expiring in 30 days is reported as a warning. ` +
+	`The command exits with a non-zero status if any of the checks fails. Use --format json to get the report ` +
+	`as JSON for monitoring.`
+
+const envCheckCmdExamples = utils.ProjectName + ` ` + EnvCmdLiteral + ` ` + EnvCheckCmdLiteral + ` -e prod
+` + utils.ProjectName + ` ` + EnvCmdLiteral + ` ` + EnvCheckCmdLiteral + ` -e prod --format json
+` + utils.ProjectName + ` ` + EnvCmdLiteral + ` ` + EnvCheckCmdLiteral + ` -e prod --register-client
+` + utils.ProjectName + ` ` + EnvCmdLiteral + ` ` + EnvCheckCmdLiteral + ` -e dev --format "{{.Target}} {{.Status}}"
+NOTE: The flag (--environment (-e)) is mandatory`
+
+// envCheckCmd represents the env check command
+var envCheckCmd = &cobra.Command{
+	Use:     EnvCheckCmdLiteral,
+	Short:   envCheckCmdShortDesc,
+	Long:    envCheckCmdLongDesc,
+	Example: envCheckCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + EnvCmdLiteral + " " + EnvCheckCmdLiteral + " called")
+		executeEnvCheckCmd()
+	},
+}
+
+// executeEnvCheckCmd checks the environment with the stored credentials without prompting for a login, prints the
+// report and exits with a non-zero status if any of the checks failed
+func executeEnvCheckCmd() {
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials", err)
+	}
+	var credential *credentials.Credential
+	if store.HasAPIM(envCheckCmdEnvironment) {
+		cred, err := store.GetAPIMCredentials(envCheckCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		credential = &cred
+	}
+
+	report, err := impl.CheckEnv(envCheckCmdEnvironment, credential, Version, envCheckCmdRegisterClient)
+	if err != nil {
+		utils.HandleErrorAndExit("Error checking the environment "+envCheckCmdEnvironment, err)
+	}
+	impl.PrintEnvCheckReport(report, envCheckCmdFormat)
+	if report.Status == impl.EnvCheckStatusFail {
+		utils.Logln(utils.LogPrefixError + "checks of the environment " + envCheckCmdEnvironment + " failed")
+		os.Exit(1)
+	}
+}
+
+func init() {
+	EnvCmd.AddCommand(envCheckCmd)
+	envCheckCmd.Flags().StringVarP(&envCheckCmdEnvironment, "environment", "e",
+		"", "Environment to be checked")
+	envCheckCmd.Flags().StringVarP(&envCheckCmdFormat, "format", "", "", "Pretty-print the results of the "+
+		"checks using Go Templates, or use \"json\" to print the report as JSON")
+	envCheckCmd.Flags().BoolVarP(&envCheckCmdRegisterClient, "register-client", "", false, "Register the DCR "+
+		"client of the logged in user, or reuse it if it is already registered, to check the client registration")
+	utils.MarkEnvFlagRequired(envCheckCmd)
+}
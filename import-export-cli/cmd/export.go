@@ -25,17 +25,20 @@ import (
 
 // Export command related usage Info
 const ExportCmdLiteral = "export"
-const exportCmdShortDesc = "Export an API/API Product/Application/Policy in an environment"
+const exportCmdShortDesc = "Export an API/API Product/Application/Policy/Admin Resource in an environment"
 
 const exportCmdLongDesc = `Export an API available in the environment specified by flag (--environment, -e)
 Export APIs available in the environment specified by flag (--environment, -e)
 Export an API Product available in the environment specified by flag (--environment, -e)
-Export an Application of a specific user (--owner, -o) in the environment specified by flag (--environment, -e)`
+Export an Application of a specific user (--owner, -o) in the environment specified by flag (--environment, -e)
+Export a Key Manager, Gateway Environment, Shared Scope, API Category, Deny Policy or the Tenant Config in the environment specified by flag (--environment, -e)`
 
 const exportCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAPICmdLiteral + ` -n TwitterAPI -v 1.0.0 -r admin -e dev
 ` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAPIsCmdLiteral + ` -e dev
 ` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAPIProductCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 -e dev
-` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAppCmdLiteral + ` -n SampleApp -o admin -e dev`
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportAppCmdLiteral + ` -n SampleApp -o admin -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportKeyManagerCmdLiteral + ` -n Keycloak -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportTenantConfigCmdLiteral + ` -e dev`
 
 // ExportCmd represents the export command
 var ExportCmd = &cobra.Command{
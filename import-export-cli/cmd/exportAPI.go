@@ -121,5 +121,5 @@ func init() {
 	ExportAPICmd.Flags().StringVarP(&exportAPIFormat, "format", "", utils.DefaultExportFormat, "File format of exported archive(json or yaml)")
 	_ = ExportAPICmd.MarkFlagRequired("name")
 	_ = ExportAPICmd.MarkFlagRequired("version")
-	_ = ExportAPICmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAPICmd)
 }
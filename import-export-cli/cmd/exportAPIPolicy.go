@@ -98,5 +98,5 @@ func init() {
 		"", "Type of the Policy definition file exported")
 	_ = ExportAPIPolicyCmd.MarkFlagRequired("name")
 	_ = ExportAPIPolicyCmd.MarkFlagRequired("version")
-	_ = ExportAPIPolicyCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAPIPolicyCmd)
 }
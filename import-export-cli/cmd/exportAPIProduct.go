@@ -122,5 +122,5 @@ func init() {
 	ExportAPIProductCmd.Flags().StringVarP(&exportAPIProductFormat, "format", "", utils.DefaultExportFormat, "File format of exported archive (json or yaml)")
 	_ = ExportAPIProductCmd.MarkFlagRequired("name")
 	_ = ExportAPIProductCmd.MarkFlagRequired("version")
-	_ = ExportAPIProductCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAPIProductCmd)
 }
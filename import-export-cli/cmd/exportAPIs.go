@@ -102,5 +102,5 @@ func init() {
 	ExportAPIsCmd.Flags().BoolVarP(&exportAPIsAllRevisions, "all", "", false,
 		"Export working copy and all revisions for the APIs in the environments ")
 	ExportAPIsCmd.Flags().StringVarP(&exportAPIsFormat, "format", "", utils.DefaultExportFormat, "File format of exported archives(json or yaml)")
-	_ = ExportAPIsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAPIsCmd)
 }
@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportApiCategoryName string
+var exportApiCategoryFormat string
+
+// ExportApiCategory command related usage info
+const ExportApiCategoryCmdLiteral = "api-category"
+const exportApiCategoryCmdShortDesc = "Export a API Category"
+const exportApiCategoryCmdLongDesc = "Export a API Category from an environment"
+
+const exportApiCategoryCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportApiCategoryCmdLiteral + ` -n Finance -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportApiCategoryCmdLiteral + ` -n Marketing -e prod --format JSON
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// ExportApiCategoryCmd represents the export api-category command
+var ExportApiCategoryCmd = &cobra.Command{
+	Use: ExportApiCategoryCmdLiteral + " (--name <name-of-the-api-category> --environment " +
+		"<environment-from-which-the-api-category-should-be-exported>)",
+	Short:   exportApiCategoryCmdShortDesc,
+	Long:    exportApiCategoryCmdLongDesc,
+	Example: exportApiCategoryCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportApiCategoryCmdLiteral + " called")
+		cred, err := GetCredentials(CmdExportEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeExportAdminResourceCmd(cred, impl.AdminResourceTypeApiCategory, exportApiCategoryName, exportApiCategoryFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ExportCmd.AddCommand(ExportApiCategoryCmd)
+	ExportApiCategoryCmd.Flags().StringVarP(&exportApiCategoryName, "name", "n", "",
+		"Name of the API Category to be exported")
+	ExportApiCategoryCmd.Flags().StringVarP(&CmdExportEnvironment, "environment", "e",
+		"", "Environment from which the API Category should be exported")
+	ExportApiCategoryCmd.Flags().StringVarP(&exportApiCategoryFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported API Category (JSON or YAML)")
+	_ = ExportApiCategoryCmd.MarkFlagRequired("name")
+	utils.MarkEnvFlagRequired(ExportApiCategoryCmd)
+}
@@ -100,7 +100,7 @@ func init() {
 	ExportAppCmd.Flags().BoolVarP(&exportAppWithKeys, "with-keys", "",
 		false, "Export keys for the application ")
 	ExportAppCmd.Flags().StringVarP(&exportAppFormat, "format", "", utils.DefaultExportFormat, "File format of exported archive (json or yaml)")
-	_ = ExportAppCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportAppCmd)
 	_ = ExportAppCmd.MarkFlagRequired("owner")
 	_ = ExportAppCmd.MarkFlagRequired("name")
 }
@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportDenyPolicyName string
+var exportDenyPolicyFormat string
+
+// ExportDenyPolicy command related usage info
+const ExportDenyPolicyCmdLiteral = "deny-policy"
+const exportDenyPolicyCmdShortDesc = "Export a Deny Policy"
+const exportDenyPolicyCmdLongDesc = "Export a Deny Policy from an environment"
+
+const exportDenyPolicyCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportDenyPolicyCmdLiteral + ` -n /pizzashack/1.0.0 -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportDenyPolicyCmdLiteral + ` -n APPLICATION:admin:SampleApp -e prod --format JSON
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// ExportDenyPolicyCmd represents the export deny-policy command
+var ExportDenyPolicyCmd = &cobra.Command{
+	Use: ExportDenyPolicyCmdLiteral + " (--name <name-of-the-deny-policy> --environment " +
+		"<environment-from-which-the-deny-policy-should-be-exported>)",
+	Short:   exportDenyPolicyCmdShortDesc,
+	Long:    exportDenyPolicyCmdLongDesc,
+	Example: exportDenyPolicyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportDenyPolicyCmdLiteral + " called")
+		cred, err := GetCredentials(CmdExportEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeExportAdminResourceCmd(cred, impl.AdminResourceTypeDenyPolicy, exportDenyPolicyName, exportDenyPolicyFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ExportCmd.AddCommand(ExportDenyPolicyCmd)
+	ExportDenyPolicyCmd.Flags().StringVarP(&exportDenyPolicyName, "name", "n", "",
+		"Condition value of the Deny Policy to be exported, optionally prefixed by its condition type and ':'")
+	ExportDenyPolicyCmd.Flags().StringVarP(&CmdExportEnvironment, "environment", "e",
+		"", "Environment from which the Deny Policy should be exported")
+	ExportDenyPolicyCmd.Flags().StringVarP(&exportDenyPolicyFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported Deny Policy (JSON or YAML)")
+	_ = ExportDenyPolicyCmd.MarkFlagRequired("name")
+	utils.MarkEnvFlagRequired(ExportDenyPolicyCmd)
+}
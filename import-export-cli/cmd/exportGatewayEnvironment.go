@@ -0,0 +1,68 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportGatewayEnvironmentName string
+var exportGatewayEnvironmentFormat string
+
+// ExportGatewayEnvironment command related usage info
+const ExportGatewayEnvironmentCmdLiteral = "gateway-environment"
+const exportGatewayEnvironmentCmdShortDesc = "Export a Gateway Environment"
+const exportGatewayEnvironmentCmdLongDesc = "Export a Gateway Environment from an environment"
+
+const exportGatewayEnvironmentCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportGatewayEnvironmentCmdLiteral + ` -n us-region -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportGatewayEnvironmentCmdLiteral + ` -n eu-region -e prod --format JSON
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// ExportGatewayEnvironmentCmd represents the export gateway-environment command
+var ExportGatewayEnvironmentCmd = &cobra.Command{
+	Use: ExportGatewayEnvironmentCmdLiteral + " (--name <name-of-the-gateway-environment> --environment " +
+		"<environment-from-which-the-gateway-environment-should-be-exported>)",
+	Short:   exportGatewayEnvironmentCmdShortDesc,
+	Long:    exportGatewayEnvironmentCmdLongDesc,
+	Example: exportGatewayEnvironmentCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportGatewayEnvironmentCmdLiteral + " called")
+		cred, err := GetCredentials(CmdExportEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeExportAdminResourceCmd(cred, impl.AdminResourceTypeGatewayEnvironment,
+			exportGatewayEnvironmentName, exportGatewayEnvironmentFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ExportCmd.AddCommand(ExportGatewayEnvironmentCmd)
+	ExportGatewayEnvironmentCmd.Flags().StringVarP(&exportGatewayEnvironmentName, "name", "n", "",
+		"Name of the Gateway Environment to be exported")
+	ExportGatewayEnvironmentCmd.Flags().StringVarP(&CmdExportEnvironment, "environment", "e",
+		"", "Environment from which the Gateway Environment should be exported")
+	ExportGatewayEnvironmentCmd.Flags().StringVarP(&exportGatewayEnvironmentFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported Gateway Environment (JSON or YAML)")
+	_ = ExportGatewayEnvironmentCmd.MarkFlagRequired("name")
+	utils.MarkEnvFlagRequired(ExportGatewayEnvironmentCmd)
+}
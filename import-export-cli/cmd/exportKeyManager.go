@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportKeyManagerName string
+var exportKeyManagerFormat string
+
+// ExportKeyManager command related usage info
+const ExportKeyManagerCmdLiteral = "key-manager"
+const exportKeyManagerCmdShortDesc = "Export a Key Manager"
+const exportKeyManagerCmdLongDesc = "Export a Key Manager from an environment"
+
+const exportKeyManagerCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportKeyManagerCmdLiteral + ` -n Keycloak -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportKeyManagerCmdLiteral + ` -n Okta -e prod --format JSON
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// ExportKeyManagerCmd represents the export key-manager command
+var ExportKeyManagerCmd = &cobra.Command{
+	Use: ExportKeyManagerCmdLiteral + " (--name <name-of-the-key-manager> --environment " +
+		"<environment-from-which-the-key-manager-should-be-exported>)",
+	Short:   exportKeyManagerCmdShortDesc,
+	Long:    exportKeyManagerCmdLongDesc,
+	Example: exportKeyManagerCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportKeyManagerCmdLiteral + " called")
+		cred, err := GetCredentials(CmdExportEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeExportAdminResourceCmd(cred, impl.AdminResourceTypeKeyManager, exportKeyManagerName, exportKeyManagerFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ExportCmd.AddCommand(ExportKeyManagerCmd)
+	ExportKeyManagerCmd.Flags().StringVarP(&exportKeyManagerName, "name", "n", "",
+		"Name of the Key Manager to be exported")
+	ExportKeyManagerCmd.Flags().StringVarP(&CmdExportEnvironment, "environment", "e",
+		"", "Environment from which the Key Manager should be exported")
+	ExportKeyManagerCmd.Flags().StringVarP(&exportKeyManagerFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported Key Manager (JSON or YAML)")
+	_ = ExportKeyManagerCmd.MarkFlagRequired("name")
+	utils.MarkEnvFlagRequired(ExportKeyManagerCmd)
+}
@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportScopeName string
+var exportScopeFormat string
+
+// ExportScope command related usage info
+const ExportScopeCmdLiteral = "scope"
+const exportScopeCmdShortDesc = "Export a Shared Scope"
+const exportScopeCmdLongDesc = "Export a Shared Scope from an environment"
+
+const exportScopeCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportScopeCmdLiteral + ` -n read_orders -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportScopeCmdLiteral + ` -n write_orders -e prod --format JSON
+NOTE: Both the flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// ExportScopeCmd represents the export scope command
+var ExportScopeCmd = &cobra.Command{
+	Use: ExportScopeCmdLiteral + " (--name <name-of-the-shared-scope> --environment " +
+		"<environment-from-which-the-shared-scope-should-be-exported>)",
+	Short:   exportScopeCmdShortDesc,
+	Long:    exportScopeCmdLongDesc,
+	Example: exportScopeCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportScopeCmdLiteral + " called")
+		cred, err := GetCredentials(CmdExportEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeExportAdminResourceCmd(cred, impl.AdminResourceTypeScope, exportScopeName, exportScopeFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ExportCmd.AddCommand(ExportScopeCmd)
+	ExportScopeCmd.Flags().StringVarP(&exportScopeName, "name", "n", "",
+		"Name of the Shared Scope to be exported")
+	ExportScopeCmd.Flags().StringVarP(&CmdExportEnvironment, "environment", "e",
+		"", "Environment from which the Shared Scope should be exported")
+	ExportScopeCmd.Flags().StringVarP(&exportScopeFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported Shared Scope (JSON or YAML)")
+	_ = ExportScopeCmd.MarkFlagRequired("name")
+	utils.MarkEnvFlagRequired(ExportScopeCmd)
+}
@@ -0,0 +1,62 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var exportTenantConfigFormat string
+
+// ExportTenantConfig command related usage info
+const ExportTenantConfigCmdLiteral = "tenant-config"
+const exportTenantConfigCmdShortDesc = "Export the Tenant Config"
+const exportTenantConfigCmdLongDesc = "Export the Tenant Config of the tenant from an environment"
+
+const exportTenantConfigCmdExamples = utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportTenantConfigCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + ExportCmdLiteral + ` ` + ExportTenantConfigCmdLiteral + ` -e prod --format JSON
+NOTE: The flag (--environment (-e)) is mandatory.`
+
+// ExportTenantConfigCmd represents the export tenant-config command
+var ExportTenantConfigCmd = &cobra.Command{
+	Use:     ExportTenantConfigCmdLiteral + " (--environment <environment-from-which-the-tenant-config-should-be-exported>)",
+	Short:   exportTenantConfigCmdShortDesc,
+	Long:    exportTenantConfigCmdLongDesc,
+	Example: exportTenantConfigCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ExportTenantConfigCmdLiteral + " called")
+		cred, err := GetCredentials(CmdExportEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeExportAdminResourceCmd(cred, impl.AdminResourceTypeTenantConfig, "", exportTenantConfigFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	ExportCmd.AddCommand(ExportTenantConfigCmd)
+	ExportTenantConfigCmd.Flags().StringVarP(&CmdExportEnvironment, "environment", "e",
+		"", "Environment from which the Tenant Config should be exported")
+	ExportTenantConfigCmd.Flags().StringVarP(&exportTenantConfigFormat, "format", "", utils.DefaultExportFormat,
+		"File format of the exported Tenant Config (JSON or YAML)")
+	utils.MarkEnvFlagRequired(ExportTenantConfigCmd)
+}
@@ -105,6 +105,6 @@ func init() {
 		"", "Environment to which the Throttling Policies should be exported")
 	ExportThrottlePolicyCmd.Flags().StringVarP(&exportThrottlePolicyFormat, "format", "", utils.DefaultExportFormat, "File format of exported archive(JSON or YAML)")
 	_ = ExportThrottlePolicyCmd.MarkFlagRequired("name")
-	_ = ExportThrottlePolicyCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ExportThrottlePolicyCmd)
 
 }
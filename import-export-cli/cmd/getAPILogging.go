@@ -83,5 +83,5 @@ func init() {
 		"", "Environment of the APIs which the API loggers should be displayed")
 	getApiLoggingCmd.Flags().StringVarP(&getAPILoggingCmdFormat, "format", "", "", "Pretty-print API loggers "+
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = getApiLoggingCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getApiLoggingCmd)
 }
@@ -105,6 +105,6 @@ func init() {
 	getAPIPoliciesCmd.Flags().StringVarP(&getAPIPolicyListCmdLimit, "limit", "l",
 		strconv.Itoa(utils.DefaultPoliciesDisplayLimit), "Maximum number of API Policies to return")
 	getAPIPoliciesCmd.Flags().BoolVarP(&getAllAPIPoliciesAvailable, "all", "", false, "Get all API Policies")
-	_ = getAPIPoliciesCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getAPIPoliciesCmd)
 	getAPIPoliciesCmd.MarkFlagsMutuallyExclusive("limit", "all")
 }
@@ -95,5 +95,5 @@ func init() {
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getAPIProductRevisionsCmd.MarkFlagRequired("name")
 	_ = getAPIProductRevisionsCmd.MarkFlagRequired("version")
-	_ = getAPIProductRevisionsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getAPIProductRevisionsCmd)
 }
@@ -95,5 +95,5 @@ func init() {
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getAPIRevisionsCmd.MarkFlagRequired("name")
 	_ = getAPIRevisionsCmd.MarkFlagRequired("version")
-	_ = getAPIRevisionsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getAPIRevisionsCmd)
 }
@@ -94,5 +94,5 @@ func init() {
 		strconv.Itoa(utils.DefaultApiProductsDisplayLimit), "Maximum number of API Products to return")
 	getApiProductsCmd.Flags().StringVarP(&getApiProductsCmdFormat, "format", "", "", "Pretty-print API Products "+
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = getApiProductsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getApiProductsCmd)
 }
@@ -91,5 +91,5 @@ func init() {
 		strconv.Itoa(utils.DefaultApisDisplayLimit), "Maximum number of apis to return")
 	getApisCmd.Flags().StringVarP(&getApisCmdFormat, "format", "", "", "Pretty-print apis "+
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = getApisCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getApisCmd)
 }
@@ -94,5 +94,5 @@ func init() {
 		strconv.Itoa(utils.DefaultAppsDisplayLimit), "Maximum number of applications to return")
 	getAppsCmd.Flags().StringVarP(&getAppsCmdFormat, "format", "", "", "Pretty-print output"+
 		"using Go templates. Use \"{{jsonPretty .}}\" to list all fields")
-	_ = getAppsCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getAppsCmd)
 }
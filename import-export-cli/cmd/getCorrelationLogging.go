@@ -68,5 +68,5 @@ func init() {
 		"", "Environment which the correlation logging components should be displayed")
 	getCorrelationLoggingCmd.Flags().StringVarP(&getCorrelationLoggingCmdFormat, "format", "", "",
 		"Pretty-print correlation logging components using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = getCorrelationLoggingCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getCorrelationLoggingCmd)
 }
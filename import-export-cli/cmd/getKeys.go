@@ -70,5 +70,5 @@ func init() {
 	getKeysCmd.Flags().StringVarP(&apiProvider, "provider", "r", "", "Provider of the API or API Product")
 	getKeysCmd.Flags().StringVarP(&keyGenTokenEndpoint, "token", "t", "", "Token endpoint URL of Environment")
 	_ = getKeysCmd.MarkFlagRequired("name")
-	_ = getKeysCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getKeysCmd)
 }
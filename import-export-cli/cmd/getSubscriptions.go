@@ -0,0 +1,101 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getSubscriptionsCmdEnvironment string
+var getSubscriptionsCmdAppName string
+var getSubscriptionsCmdApiName string
+var getSubscriptionsCmdApiVersion string
+var getSubscriptionsCmdApiProvider string
+var getSubscriptionsCmdLimit string
+var getSubscriptionsCmdFormat string
+
+// GetSubscriptionsCmd related info
+const GetSubscriptionsCmdLiteral = "subscriptions"
+const getSubscriptionsCmdShortDesc = "Display a list of subscriptions of an Application or to an API or API Product"
+
+const getSubscriptionsCmdLongDesc = "Display a list of subscriptions of the Application given by the flag " +
+	"--app-name, or to the API or API Product given by the flags --name (-n), --version (-v) and --provider (-r), in " +
+	"the DevPortal of the environment specified by the flag --environment, -e"
+
+const getSubscriptionsCmdExamples = utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetSubscriptionsCmdLiteral + ` --app-name SampleApp -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetSubscriptionsCmdLiteral + ` -n PizzaShackAPI -v 1.0.0 -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetSubscriptionsCmdLiteral + ` --app-name SampleApp -n PizzaShackAPI -v 1.0.0 -r admin -e dev
+` + utils.ProjectName + ` ` + GetCmdLiteral + ` ` + GetSubscriptionsCmdLiteral + ` --app-name SampleApp -e dev --format jsonArray
+NOTE: The flag (--environment (-e)) is mandatory. Either the flag --app-name or --name (-n) should be given.`
+
+// getSubscriptionsCmd represents the get subscriptions command
+var getSubscriptionsCmd = &cobra.Command{
+	Use:     GetSubscriptionsCmdLiteral,
+	Short:   getSubscriptionsCmdShortDesc,
+	Long:    getSubscriptionsCmdLongDesc,
+	Example: getSubscriptionsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GetSubscriptionsCmdLiteral + " called")
+		cred, err := GetCredentials(getSubscriptionsCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeGetSubscriptionsCmd(cred)
+	},
+}
+
+func executeGetSubscriptionsCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, getSubscriptionsCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error calling '"+GetSubscriptionsCmdLiteral+"'", err)
+	}
+
+	subscriptions, err := impl.GetSubscriptionListFromEnv(accessToken, getSubscriptionsCmdEnvironment,
+		getSubscriptionsCmdAppName, getSubscriptionsCmdApiName, getSubscriptionsCmdApiVersion,
+		getSubscriptionsCmdApiProvider, getSubscriptionsCmdLimit)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting the list of subscriptions.", err)
+	}
+	impl.PrintSubscriptions(subscriptions, getSubscriptionsCmdFormat)
+}
+
+func init() {
+	GetCmd.AddCommand(getSubscriptionsCmd)
+
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdEnvironment, "environment", "e",
+		"", "Environment to be searched")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdAppName, "app-name", "", "",
+		"Name of the Application")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdApiName, "name", "n", "",
+		"Name of the API or API Product")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdApiVersion, "version", "v", "",
+		"Version of the API or API Product")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdApiProvider, "provider", "r", "",
+		"Provider of the API or API Product")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdLimit, "limit", "l",
+		strconv.Itoa(utils.DefaultSubscriptionsDisplayLimit), "Maximum number of subscriptions to return")
+	getSubscriptionsCmd.Flags().StringVarP(&getSubscriptionsCmdFormat, "format", "", "", "Pretty-print output "+
+		"using Go templates. Use \"{{jsonPretty .}}\" to list all fields")
+	utils.MarkEnvFlagRequired(getSubscriptionsCmd)
+}
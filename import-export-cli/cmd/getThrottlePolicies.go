@@ -93,5 +93,5 @@ func init() {
 		[]string{}, "Query pattern")
 	getThrottlePoliciesCmd.Flags().StringVarP(&getThrottlePoliciesCmdFormat, "format", "", "", "Pretty-print throttle policies "+
 		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
-	_ = getThrottlePoliciesCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(getThrottlePoliciesCmd)
 }
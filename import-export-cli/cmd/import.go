@@ -25,15 +25,17 @@ import (
 
 // Import command related usage Info
 const ImportCmdLiteral = "import"
-const importCmdShortDesc = "Import an API/API Product/Application to an environment"
+const importCmdShortDesc = "Import an API/API Product/Application/Admin Resource to an environment"
 
 const importCmdLongDesc = `Import an API to the environment specified by flag (--environment, -e)
 Import an API Product to the environment specified by flag (--environment, -e)
-Import an Application to the environment specified by flag (--environment, -e)`
+Import an Application to the environment specified by flag (--environment, -e)
+Import a Key Manager, Gateway Environment, Shared Scope, API Category, Deny Policy or the Tenant Config to the environment specified by flag (--environment, -e)`
 
 const importCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAPICmdLiteral + ` -f qa/TwitterAPI.zip -e dev
 ` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + importAPIProductCmdLiteral + ` -f qa/LeasingAPIProduct.zip -e dev
-` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f qa/apps/sampleApp.zip -e dev`
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportAppCmdLiteral + ` -f qa/apps/sampleApp.zip -e dev
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportKeyManagerCmdLiteral + ` -f qa/KeyManager-Keycloak.zip -e dev --update`
 
 // ImportCmd represents the import command
 var ImportCmd = &cobra.Command{
@@ -94,7 +94,9 @@ func init() {
 		"or a directory generated using \"gen deployment-dir\" command")
 	ImportAPICmd.Flags().BoolVarP(&importAPISkipCleanup, "skip-cleanup", "", false, "Leave "+
 		"all temporary files created during import process")
+	ImportAPICmd.Flags().BoolVar(&utils.AllowExecSecrets, "allow-exec-secrets", false,
+		"Run the commands of the ${exec:<command>} secret references in the params file")
 	// Mark required flags
-	_ = ImportAPICmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportAPICmd)
 	_ = ImportAPICmd.MarkFlagRequired("file")
 }
@@ -71,6 +71,6 @@ func init() {
 	ImportAPIPolicyCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
 		"", "Environment from the which the API Policy should be imported")
 	// Mark required flags
-	_ = ImportAPIPolicyCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportAPIPolicyCmd)
 	_ = ImportAPIPolicyCmd.MarkFlagRequired("file")
 }
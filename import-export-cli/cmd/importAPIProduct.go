@@ -103,6 +103,6 @@ func init() {
 	ImportAPIProductCmd.Flags().BoolVar(&importAPIProductSkipDeployments, "skip-deployments", false, "Update only "+
 		"the working copy and skip deployment steps in import")
 	// Mark required flags
-	_ = ImportAPIProductCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportAPIProductCmd)
 	_ = ImportAPIProductCmd.MarkFlagRequired("file")
 }
@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importApiCategoryFile string
+var importApiCategoryUpdate bool
+
+// ImportApiCategory command related usage info
+const ImportApiCategoryCmdLiteral = "api-category"
+const importApiCategoryCmdShortDesc = "Import a API Category"
+const importApiCategoryCmdLongDesc = "Import a API Category to an environment. An existing API Category of the same name is updated if the flag --update (-u) is given."
+
+const importApiCategoryCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportApiCategoryCmdLiteral + ` -f dev/ApiCategory-Finance.zip -e prod
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportApiCategoryCmdLiteral + ` -f ~/ApiCategory-Finance -e prod --update
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportApiCategoryCmd represents the import api-category command
+var ImportApiCategoryCmd = &cobra.Command{
+	Use:     ImportApiCategoryCmdLiteral + " --file <path-to-the-exported-api-category> --environment <environment>",
+	Short:   importApiCategoryCmdShortDesc,
+	Long:    importApiCategoryCmdLongDesc,
+	Example: importApiCategoryCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportApiCategoryCmdLiteral + " called")
+		cred, err := GetCredentials(importEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeImportAdminResourceCmd(cred, impl.AdminResourceTypeApiCategory, importApiCategoryFile, importApiCategoryUpdate)
+	},
+}
+
+// init using Cobra
+func init() {
+	ImportCmd.AddCommand(ImportApiCategoryCmd)
+	ImportApiCategoryCmd.Flags().StringVarP(&importApiCategoryFile, "file", "f", "",
+		"Path of the exported archive, the project directory or the file of the API Category")
+	ImportApiCategoryCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
+		"", "Environment to which the API Category should be imported")
+	ImportApiCategoryCmd.Flags().BoolVarP(&importApiCategoryUpdate, "update", "u", false,
+		"Update the API Category if it exists in the environment")
+	_ = ImportApiCategoryCmd.MarkFlagRequired("file")
+	utils.MarkEnvFlagRequired(ImportApiCategoryCmd)
+}
@@ -93,5 +93,5 @@ func init() {
 	ImportAppCmd.Flags().BoolVarP(&importAppSkipCleanup, "skip-cleanup", "", false, "Leave "+
 		"all temporary files created during import process")
 	_ = ImportAppCmd.MarkFlagRequired("file")
-	_ = ImportAppCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportAppCmd)
 }
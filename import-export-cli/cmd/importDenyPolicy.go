@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importDenyPolicyFile string
+var importDenyPolicyUpdate bool
+
+// ImportDenyPolicy command related usage info
+const ImportDenyPolicyCmdLiteral = "deny-policy"
+const importDenyPolicyCmdShortDesc = "Import a Deny Policy"
+const importDenyPolicyCmdLongDesc = "Import a Deny Policy to an environment. An existing Deny Policy of the same condition is replaced if the flag --update (-u) is given."
+
+const importDenyPolicyCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportDenyPolicyCmdLiteral + ` -f dev/DenyPolicy-_pizzashack_1.0.0.zip -e prod
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportDenyPolicyCmdLiteral + ` -f ~/DenyPolicy-_pizzashack_1.0.0 -e prod --update
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportDenyPolicyCmd represents the import deny-policy command
+var ImportDenyPolicyCmd = &cobra.Command{
+	Use:     ImportDenyPolicyCmdLiteral + " --file <path-to-the-exported-deny-policy> --environment <environment>",
+	Short:   importDenyPolicyCmdShortDesc,
+	Long:    importDenyPolicyCmdLongDesc,
+	Example: importDenyPolicyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportDenyPolicyCmdLiteral + " called")
+		cred, err := GetCredentials(importEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeImportAdminResourceCmd(cred, impl.AdminResourceTypeDenyPolicy, importDenyPolicyFile, importDenyPolicyUpdate)
+	},
+}
+
+// init using Cobra
+func init() {
+	ImportCmd.AddCommand(ImportDenyPolicyCmd)
+	ImportDenyPolicyCmd.Flags().StringVarP(&importDenyPolicyFile, "file", "f", "",
+		"Path of the exported archive, the project directory or the file of the Deny Policy")
+	ImportDenyPolicyCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
+		"", "Environment to which the Deny Policy should be imported")
+	ImportDenyPolicyCmd.Flags().BoolVarP(&importDenyPolicyUpdate, "update", "u", false,
+		"Update the Deny Policy if it exists in the environment")
+	_ = ImportDenyPolicyCmd.MarkFlagRequired("file")
+	utils.MarkEnvFlagRequired(ImportDenyPolicyCmd)
+}
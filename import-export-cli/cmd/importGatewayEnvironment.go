@@ -0,0 +1,67 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importGatewayEnvironmentFile string
+var importGatewayEnvironmentUpdate bool
+
+// ImportGatewayEnvironment command related usage info
+const ImportGatewayEnvironmentCmdLiteral = "gateway-environment"
+const importGatewayEnvironmentCmdShortDesc = "Import a Gateway Environment"
+const importGatewayEnvironmentCmdLongDesc = "Import a Gateway Environment to an environment. An existing Gateway Environment of the same name is updated if the flag --update (-u) is given."
+
+const importGatewayEnvironmentCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportGatewayEnvironmentCmdLiteral + ` -f dev/GatewayEnvironment-us-region.zip -e prod
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportGatewayEnvironmentCmdLiteral + ` -f ~/GatewayEnvironment-us-region -e prod --update
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportGatewayEnvironmentCmd represents the import gateway-environment command
+var ImportGatewayEnvironmentCmd = &cobra.Command{
+	Use:     ImportGatewayEnvironmentCmdLiteral + " --file <path-to-the-exported-gateway-environment> --environment <environment>",
+	Short:   importGatewayEnvironmentCmdShortDesc,
+	Long:    importGatewayEnvironmentCmdLongDesc,
+	Example: importGatewayEnvironmentCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportGatewayEnvironmentCmdLiteral + " called")
+		cred, err := GetCredentials(importEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeImportAdminResourceCmd(cred, impl.AdminResourceTypeGatewayEnvironment,
+			importGatewayEnvironmentFile, importGatewayEnvironmentUpdate)
+	},
+}
+
+// init using Cobra
+func init() {
+	ImportCmd.AddCommand(ImportGatewayEnvironmentCmd)
+	ImportGatewayEnvironmentCmd.Flags().StringVarP(&importGatewayEnvironmentFile, "file", "f", "",
+		"Path of the exported archive, the project directory or the file of the Gateway Environment")
+	ImportGatewayEnvironmentCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
+		"", "Environment to which the Gateway Environment should be imported")
+	ImportGatewayEnvironmentCmd.Flags().BoolVarP(&importGatewayEnvironmentUpdate, "update", "u", false,
+		"Update the Gateway Environment if it exists in the environment")
+	_ = ImportGatewayEnvironmentCmd.MarkFlagRequired("file")
+	utils.MarkEnvFlagRequired(ImportGatewayEnvironmentCmd)
+}
@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importKeyManagerFile string
+var importKeyManagerUpdate bool
+
+// ImportKeyManager command related usage info
+const ImportKeyManagerCmdLiteral = "key-manager"
+const importKeyManagerCmdShortDesc = "Import a Key Manager"
+const importKeyManagerCmdLongDesc = "Import a Key Manager to an environment. An existing Key Manager of the same name is updated if the flag --update (-u) is given. The client credentials of an exported Key Manager are substituted from the environment variables referenced as ${var}."
+
+const importKeyManagerCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportKeyManagerCmdLiteral + ` -f dev/KeyManager-Keycloak.zip -e prod
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportKeyManagerCmdLiteral + ` -f ~/KeyManager-Keycloak -e prod --update
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportKeyManagerCmd represents the import key-manager command
+var ImportKeyManagerCmd = &cobra.Command{
+	Use:     ImportKeyManagerCmdLiteral + " --file <path-to-the-exported-key-manager> --environment <environment>",
+	Short:   importKeyManagerCmdShortDesc,
+	Long:    importKeyManagerCmdLongDesc,
+	Example: importKeyManagerCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportKeyManagerCmdLiteral + " called")
+		cred, err := GetCredentials(importEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeImportAdminResourceCmd(cred, impl.AdminResourceTypeKeyManager, importKeyManagerFile, importKeyManagerUpdate)
+	},
+}
+
+// init using Cobra
+func init() {
+	ImportCmd.AddCommand(ImportKeyManagerCmd)
+	ImportKeyManagerCmd.Flags().StringVarP(&importKeyManagerFile, "file", "f", "",
+		"Path of the exported archive, the project directory or the file of the Key Manager")
+	ImportKeyManagerCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
+		"", "Environment to which the Key Manager should be imported")
+	ImportKeyManagerCmd.Flags().BoolVarP(&importKeyManagerUpdate, "update", "u", false,
+		"Update the Key Manager if it exists in the environment")
+	_ = ImportKeyManagerCmd.MarkFlagRequired("file")
+	utils.MarkEnvFlagRequired(ImportKeyManagerCmd)
+}
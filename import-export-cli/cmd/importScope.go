@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importScopeFile string
+var importScopeUpdate bool
+
+// ImportScope command related usage info
+const ImportScopeCmdLiteral = "scope"
+const importScopeCmdShortDesc = "Import a Shared Scope"
+const importScopeCmdLongDesc = "Import a Shared Scope to an environment. An existing Shared Scope of the same name is updated if the flag --update (-u) is given."
+
+const importScopeCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportScopeCmdLiteral + ` -f dev/Scope-read_orders.zip -e prod
+` + utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportScopeCmdLiteral + ` -f ~/Scope-read_orders -e prod --update
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportScopeCmd represents the import scope command
+var ImportScopeCmd = &cobra.Command{
+	Use:     ImportScopeCmdLiteral + " --file <path-to-the-exported-shared-scope> --environment <environment>",
+	Short:   importScopeCmdShortDesc,
+	Long:    importScopeCmdLongDesc,
+	Example: importScopeCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportScopeCmdLiteral + " called")
+		cred, err := GetCredentials(importEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeImportAdminResourceCmd(cred, impl.AdminResourceTypeScope, importScopeFile, importScopeUpdate)
+	},
+}
+
+// init using Cobra
+func init() {
+	ImportCmd.AddCommand(ImportScopeCmd)
+	ImportScopeCmd.Flags().StringVarP(&importScopeFile, "file", "f", "",
+		"Path of the exported archive, the project directory or the file of the Shared Scope")
+	ImportScopeCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
+		"", "Environment to which the Shared Scope should be imported")
+	ImportScopeCmd.Flags().BoolVarP(&importScopeUpdate, "update", "u", false,
+		"Update the Shared Scope if it exists in the environment")
+	_ = ImportScopeCmd.MarkFlagRequired("file")
+	utils.MarkEnvFlagRequired(ImportScopeCmd)
+}
@@ -0,0 +1,62 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importTenantConfigFile string
+
+// ImportTenantConfig command related usage info
+const ImportTenantConfigCmdLiteral = "tenant-config"
+const importTenantConfigCmdShortDesc = "Import the Tenant Config"
+const importTenantConfigCmdLongDesc = "Import the Tenant Config of the tenant to an environment, replacing the existing Tenant Config"
+
+const importTenantConfigCmdExamples = utils.ProjectName + ` ` + ImportCmdLiteral + ` ` + ImportTenantConfigCmdLiteral + ` -f dev/TenantConfig.zip -e prod
+NOTE: Both the flags (--file (-f) and --environment (-e)) are mandatory.`
+
+// ImportTenantConfigCmd represents the import tenant-config command
+var ImportTenantConfigCmd = &cobra.Command{
+	Use:     ImportTenantConfigCmdLiteral + " --file <path-to-the-exported-tenant-config> --environment <environment>",
+	Short:   importTenantConfigCmdShortDesc,
+	Long:    importTenantConfigCmdLongDesc,
+	Example: importTenantConfigCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ImportTenantConfigCmdLiteral + " called")
+		cred, err := GetCredentials(importEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeImportAdminResourceCmd(cred, impl.AdminResourceTypeTenantConfig, importTenantConfigFile, false)
+	},
+}
+
+// init using Cobra
+func init() {
+	ImportCmd.AddCommand(ImportTenantConfigCmd)
+	ImportTenantConfigCmd.Flags().StringVarP(&importTenantConfigFile, "file", "f", "",
+		"Path of the exported archive, the project directory or the file of the Tenant Config")
+	ImportTenantConfigCmd.Flags().StringVarP(&importEnvironment, "environment", "e",
+		"", "Environment to which the Tenant Config should be imported")
+	_ = ImportTenantConfigCmd.MarkFlagRequired("file")
+	utils.MarkEnvFlagRequired(ImportTenantConfigCmd)
+}
@@ -76,6 +76,6 @@ func init() {
 	ImportThrottlingPolicyCmd.Flags().BoolVarP(&importThrottlePolicyUpdate, "update", "u", false, "Update an "+
 		"existing Throttling Policy or create a new Throttling Policy")
 	// Mark required flags
-	_ = ImportThrottlingPolicyCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(ImportThrottlingPolicyCmd)
 	_ = ImportThrottlingPolicyCmd.MarkFlagRequired("file")
 }
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"syscall"
 
@@ -38,6 +39,10 @@ var loginPasswordStdin bool
 var clientId string
 var clientSecret string
 var personalAccessToken string
+var loginSSO bool
+var loginSSOClientID string
+var loginSSOClientSecret string
+var loginSSOCallbackPort int
 
 const loginCmdLiteral = "login [environment] [flags]"
 const loginCmdShortDesc = "Login to an API Manager"
@@ -45,7 +50,8 @@ const loginCmdLongDesc = `Login to an API Manager using credentials or set token
 const loginCmdExamples = utils.ProjectName + " login dev -u admin -p admin\n" +
 	utils.ProjectName + " login dev -u admin\n" +
 	"cat ~/.mypassword | " + utils.ProjectName + " login dev -u admin\n" +
-	utils.ProjectName + " login dev --token e79bda48-3406-3178-acce-f6e4dbdcbb12"
+	utils.ProjectName + " login dev --token e79bda48-3406-3178-acce-f6e4dbdcbb12\n" +
+	utils.ProjectName + " login dev --sso --client-id apictl_sso_client --callback-port 8765"
 
 // loginCmd represents the login command
 var loginCmd = &cobra.Command{
@@ -61,7 +67,18 @@ var loginCmd = &cobra.Command{
 			fmt.Println("Error occurred while loading credential store : ", err)
 			os.Exit(1)
 		}
-		if personalAccessToken != "" {
+		if loginSSO {
+			if loginSSOClientID == "" {
+				fmt.Println("A client ID is required to login using SSO. Provide it using --client-id")
+				os.Exit(1)
+			}
+			err = runSSOLogin(store, environment, loginSSOClientID, loginSSOClientSecret, loginSSOCallbackPort,
+				utils.OpenBrowser)
+			if err != nil {
+				fmt.Println("Error occurred while login using SSO : ", err)
+				os.Exit(1)
+			}
+		} else if personalAccessToken != "" {
 			err = runLogin(store, environment, loginUsername, loginPassword, personalAccessToken)
 			if err != nil {
 				fmt.Println("Error occurred while login using the token : ", err)
@@ -142,6 +159,34 @@ func runLogin(store credentials.Store, environment, username, password, personal
 	return nil
 }
 
+// runSSOLogin logs into the environment through the browser, and stores the access and refresh tokens, which are
+// refreshed silently once the access token expires
+func runSSOLogin(store credentials.Store, environment, clientID, clientSecret string, callbackPort int,
+	openBrowser func(string) error) error {
+	if !utils.APIMExistsInEnv(environment, utils.MainConfigFilePath) {
+		fmt.Println("APIM does not exists in", environment, "Add it using add env")
+		os.Exit(1)
+	}
+
+	authorizeEndpoint := utils.GetAuthorizeEndpointOfEnv(environment, utils.MainConfigFilePath)
+	tokenEndpoint := utils.GetInternalTokenEndpointOfEnv(environment, utils.MainConfigFilePath)
+	tokens, err := utils.GetOAuthTokensWithSSO(clientID, clientSecret, authorizeEndpoint, tokenEndpoint, callbackPort,
+		openBrowser)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Logged into APIM in ", environment, "environment")
+	err = store.SetAPIMSSOCredentials(environment, "", clientID, clientSecret, tokens.AccessToken,
+		tokens.RefreshToken, credentials.GetTokenExpiry(tokens.ExpiresIn))
+	if err != nil {
+		return err
+	}
+	fmt.Printf(credentials.PlainTextWarnMessage, filepath.Join(utils.LocalCredentialsDirectoryPath,
+		credentials.DefaultConfigFile))
+	return nil
+}
+
 // GetCredentials function gets the credentials for the specified environment
 func GetCredentials(env string) (credentials.Credential, error) {
 	// get tokens or login
@@ -179,4 +224,12 @@ func init() {
 	loginCmd.Flags().StringVarP(&loginPassword, "password", "p", "", "Password for login")
 	loginCmd.Flags().BoolVarP(&loginPasswordStdin, "password-stdin", "", false, "Get password from stdin")
 	loginCmd.Flags().StringVarP(&personalAccessToken, "token", "", "", "Personal access token")
+	loginCmd.Flags().BoolVarP(&loginSSO, "sso", "", false, "Login through the browser using the SSO of the "+
+		"identity provider")
+	loginCmd.Flags().StringVarP(&loginSSOClientID, "client-id", "", "", "Client ID of the OAuth application "+
+		"used for the SSO login")
+	loginCmd.Flags().StringVarP(&loginSSOClientSecret, "client-secret", "", "", "Client secret of the OAuth "+
+		"application used for the SSO login, if it is not a public client")
+	loginCmd.Flags().IntVarP(&loginSSOCallbackPort, "callback-port", "", 0, "Port of the loopback callback URL "+
+		"(http://127.0.0.1:<port>/callback) of the SSO login. A free port is used if it is not provided")
 }
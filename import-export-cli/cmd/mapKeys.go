@@ -0,0 +1,94 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var mapKeysCmdEnvironment string
+var mapKeysCmdAppName string
+var mapKeysCmdConsumerKey string
+var mapKeysCmdConsumerSecret string
+var mapKeysCmdKeyType string
+var mapKeysCmdKeyManager string
+
+// MapKeysCmd related info
+const MapKeysCmdLiteral = "keys"
+const mapKeysCmdShortDesc = "Map the keys generated out of band to an Application"
+const mapKeysCmdLongDesc = "Map a consumer key and secret of an OAuth client created out of band in a key manager " +
+	"to an Application, so that the Application uses the OAuth client as its keys of the given key type"
+
+const mapKeysCmdExamples = utils.ProjectName + ` ` + MapCmdLiteral + ` ` + MapKeysCmdLiteral + ` -n SampleApp --consumer-key <consumer-key> --consumer-secret <consumer-secret> -e dev
+` + utils.ProjectName + ` ` + MapCmdLiteral + ` ` + MapKeysCmdLiteral + ` -n SampleApp --consumer-key <consumer-key> --consumer-secret <consumer-secret> --key-type SANDBOX --key-manager Keycloak -e dev
+NOTE: The flags (--name (-n), --consumer-key, --consumer-secret and --environment (-e)) are mandatory.`
+
+// mapKeysCmd represents the map keys command
+var mapKeysCmd = &cobra.Command{
+	Use:     MapKeysCmdLiteral,
+	Short:   mapKeysCmdShortDesc,
+	Long:    mapKeysCmdLongDesc,
+	Example: mapKeysCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + MapCmdLiteral + " " + MapKeysCmdLiteral + " called")
+		cred, err := GetCredentials(mapKeysCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeMapKeysCmd(cred)
+	},
+}
+
+func executeMapKeysCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, mapKeysCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while mapping the keys", err)
+	}
+	key, err := impl.MapApplicationKeys(accessToken, mapKeysCmdEnvironment, mapKeysCmdAppName, mapKeysCmdKeyType,
+		mapKeysCmdKeyManager, mapKeysCmdConsumerKey, mapKeysCmdConsumerSecret)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while mapping the keys to "+mapKeysCmdAppName, err)
+	}
+	fmt.Println("Keys mapped to " + mapKeysCmdAppName + " successfully! Key Mapping ID: " + key.KeyMappingID)
+}
+
+func init() {
+	MapCmd.AddCommand(mapKeysCmd)
+	mapKeysCmd.Flags().StringVarP(&mapKeysCmdAppName, "name", "n", "",
+		"Name of the Application")
+	mapKeysCmd.Flags().StringVarP(&mapKeysCmdConsumerKey, "consumer-key", "", "",
+		"Consumer key of the OAuth client")
+	mapKeysCmd.Flags().StringVarP(&mapKeysCmdConsumerSecret, "consumer-secret", "", "",
+		"Consumer secret of the OAuth client")
+	mapKeysCmd.Flags().StringVarP(&mapKeysCmdKeyType, "key-type", "", utils.ProductionKeyType,
+		"Type of the keys (PRODUCTION or SANDBOX)")
+	mapKeysCmd.Flags().StringVarP(&mapKeysCmdKeyManager, "key-manager", "", utils.DefaultKeyManager,
+		"Name of the key manager of the OAuth client")
+	mapKeysCmd.Flags().StringVarP(&mapKeysCmdEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = mapKeysCmd.MarkFlagRequired("name")
+	_ = mapKeysCmd.MarkFlagRequired("consumer-key")
+	_ = mapKeysCmd.MarkFlagRequired("consumer-secret")
+	utils.MarkEnvFlagRequired(mapKeysCmd)
+}
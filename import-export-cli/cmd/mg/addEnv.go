@@ -28,8 +28,19 @@ const (
 	addEnvCmdShortDesc = "Add Environment to Config file"
 	addEnvCmdLongDesc  = `Add new environment and its related endpoints to the config file`
 )
+
+var (
+	adapterCABundle             string
+	adapterClientCertificate    string
+	adapterClientKey            string
+	adapterProxy                string
+	adapterTLSRenegotiationMode string
+)
+
 const addEnvCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + addCmdLiteral + " " + envCmdLiteral +
 	" prod --adapter https://localhost:9843 " +
+	"\n\n" + utils.ProjectName + " " + mgCmdLiteral + " " + addCmdLiteral + " " + envCmdLiteral +
+	" staging --adapter https://adapter.internal:9843 --ca-bundle /etc/ssl/internal-ca.pem " +
 
 	"\n\nNOTE: The flag --adapter (-a) is mandatory and it has to specify the microgateway adapter" +
 	" url."
@@ -48,6 +59,13 @@ var AddEnvCmd = &cobra.Command{
 
 		envEndpoints := new(utils.MgwEndpoints)
 		envEndpoints.AdapterEndpoint = mgwAdapterHost + impl.DefaultMgwAdapterEndpointSuffix
+		envEndpoints.TransportSettings = utils.TransportSettings{
+			CABundle:             adapterCABundle,
+			ClientCertificate:    adapterClientCertificate,
+			ClientKey:            adapterClientKey,
+			Proxy:                adapterProxy,
+			TLSRenegotiationMode: adapterTLSRenegotiationMode,
+		}
 		err := impl.AddEnv(envToBeAdded, envEndpoints)
 		if err != nil {
 			utils.HandleErrorAndExit("Error adding environment", err)
@@ -60,6 +78,15 @@ func init() {
 	AddCmd.AddCommand(AddEnvCmd)
 
 	AddEnvCmd.Flags().StringVarP(&mgwAdapterHost, "adapter", "a", "", "The adapter host url with port")
+	AddEnvCmd.Flags().StringVar(&adapterCABundle, "ca-bundle", "",
+		"PEM file of the CA certificates trusted for the adapter")
+	AddEnvCmd.Flags().StringVar(&adapterClientCertificate, "client-cert", "",
+		"PEM file of the client certificate for mutual TLS with the adapter")
+	AddEnvCmd.Flags().StringVar(&adapterClientKey, "client-key", "",
+		"PEM file of the client key for mutual TLS with the adapter")
+	AddEnvCmd.Flags().StringVar(&adapterProxy, "proxy", "", "HTTP proxy URL for the adapter")
+	AddEnvCmd.Flags().StringVar(&adapterTLSRenegotiationMode, "tls-renegotiation-mode", "",
+		"TLS renegotiation mode of the adapter (never, once or freely)")
 
 	_ = AddEnvCmd.MarkFlagRequired("adapter")
 }
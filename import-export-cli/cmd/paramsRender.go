@@ -0,0 +1,77 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	paramsRenderFile        string
+	paramsRenderEnvironment string
+	paramsRenderShowSecrets bool
+)
+
+const (
+	// ParamsRender command related usage info
+	ParamsRenderCmdLiteral   = "render"
+	paramsRenderCmdShortDesc = "Render the params of an environment"
+	paramsRenderCmdLongDesc  = "Print the params of an environment after the inherited configs are merged and the " +
+		"templates, environment variables and secret references are resolved. The secrets are masked unless " +
+		"--show-secrets is provided."
+)
+
+const paramsRenderCmdExamples = utils.ProjectName + ` ` + ParamsCmdLiteral + ` ` + ParamsRenderCmdLiteral + ` --env prod
+` + utils.ProjectName + ` ` + ParamsCmdLiteral + ` ` + ParamsRenderCmdLiteral + ` --env prod --params ~/PizzaShackAPI/api_params.yaml
+` + utils.ProjectName + ` ` + ParamsCmdLiteral + ` ` + ParamsRenderCmdLiteral + ` --env staging --params ~/deployment --show-secrets --allow-exec-secrets
+NOTE: The flag (--env) is mandatory.`
+
+// ParamsRenderCmd represents the params render command
+var ParamsRenderCmd = &cobra.Command{
+	Use:     ParamsRenderCmdLiteral + " --env <environment> [--params <path-to-params-file-or-deployment-directory>]",
+	Short:   paramsRenderCmdShortDesc,
+	Long:    paramsRenderCmdLongDesc,
+	Example: paramsRenderCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ParamsRenderCmdLiteral + " called")
+		rendered, err := params.RenderApiParams(paramsRenderFile, paramsRenderEnvironment, paramsRenderShowSecrets)
+		if err != nil {
+			utils.HandleErrorAndExit("Error rendering params", err)
+		}
+		fmt.Print(rendered)
+	},
+}
+
+// init using Cobra
+func init() {
+	ParamsCmd.AddCommand(ParamsRenderCmd)
+	ParamsRenderCmd.Flags().StringVarP(&paramsRenderEnvironment, "env", "e", "",
+		"Environment of which the params should be rendered")
+	ParamsRenderCmd.Flags().StringVarP(&paramsRenderFile, "params", "", utils.ParamFileAPI, "Provide an API "+
+		"Manager params file or a directory generated using \"gen deployment-dir\" command")
+	ParamsRenderCmd.Flags().BoolVarP(&paramsRenderShowSecrets, "show-secrets", "", false,
+		"Print the secrets resolved from the secret references instead of masking them")
+	ParamsRenderCmd.Flags().BoolVar(&utils.AllowExecSecrets, "allow-exec-secrets", false,
+		"Run the commands of the ${exec:<command>} secret references in the params file")
+	_ = ParamsRenderCmd.MarkFlagRequired("env")
+}
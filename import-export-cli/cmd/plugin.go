@@ -0,0 +1,174 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Plugin command related usage Info
+const PluginCmdLiteral = "plugin"
+const pluginCmdShortDesc = "Manage the plugins of " + utils.ProjectName
+
+const pluginCmdLongDesc = `Plugins are executables named ` + utils.PluginPrefix + `<name> in the ` +
+	utils.PluginsDirName + ` directory of the config directory or in PATH, which are run as ` + utils.ProjectName +
+	` <name>. The arguments are passed to the plugin as they are. The environment given with --environment (-e), or ` +
+	`the environment of the current context, is passed to the plugin with an access token of the user logged into ` +
+	`it, the endpoints of the environment and the output format of the current context as the environment variables ` +
+	utils.PluginEnvVarEnvironment + `, ` + utils.PluginEnvVarAccessToken + `, ` + utils.PluginEnvVarApiManagerEndpoint +
+	` etc. and ` + utils.PluginEnvVarOutputFormat + `.`
+
+const pluginCmdExamples = utils.ProjectName + ` ` + PluginCmdLiteral + ` ` + PluginListCmdLiteral
+
+// PluginCmd represents the plugin command
+var PluginCmd = &cobra.Command{
+	Use:     PluginCmdLiteral,
+	Short:   pluginCmdShortDesc,
+	Long:    pluginCmdLongDesc,
+	Example: pluginCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PluginCmdLiteral + " called")
+
+	},
+}
+
+// addPluginCmd adds a command to the root command for the plugin named by the first argument, if the argument is not
+// a flag or a built-in command. Only the plugin of that name is looked up, so that the plugin directories are not
+// listed when a built-in command is run.
+func addPluginCmd(args []string) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || isBuiltInCmd(args[0]) {
+		return
+	}
+	if plugin, ok := utils.FindPlugin(utils.GetPluginDirs(), args[0]); ok {
+		RootCmd.AddCommand(newPluginCmd(plugin))
+	}
+}
+
+// isBuiltInCmd returns true if the root command has a subcommand or an alias of the given name
+func isBuiltInCmd(name string) bool {
+	for _, cmd := range RootCmd.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return name == "help" || name == "completion"
+}
+
+// newPluginCmd returns a command running the plugin, which receives all the arguments and flags as they are
+func newPluginCmd(plugin utils.Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                plugin.Name,
+		Short:              "Run the plugin " + plugin.Path,
+		DisableFlagParsing: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			utils.Logln(utils.LogPrefixInfo + plugin.Name + " plugin called")
+			executePluginCmd(plugin, args)
+		},
+	}
+}
+
+// executePluginCmd runs the plugin with the details of the current environment, and exits with the exit status of
+// the plugin
+func executePluginCmd(plugin utils.Plugin, args []string) {
+	env := getEnvironmentFromArgs(args)
+	var tenantDomain, outputFormat string
+	if _, context := utils.GetCurrentContext(utils.MainConfigFilePath); context != nil {
+		if env == "" {
+			env = context.Environment
+		}
+		if env == context.Environment {
+			tenantDomain = context.Tenant
+		}
+		outputFormat = context.Output
+	}
+
+	var envEndpoints *utils.EnvEndpoints
+	var accessToken string
+	if env != "" {
+		var err error
+		envEndpoints, err = utils.GetEndpointsOfEnvironment(env, utils.MainConfigFilePath)
+		if err != nil {
+			utils.HandleErrorAndExit("Error running the plugin "+plugin.Name, err)
+		}
+		accessToken = getPluginAccessToken(env)
+	}
+
+	err := impl.RunPlugin(plugin, args, impl.GetPluginEnvVars(env, accessToken, tenantDomain, outputFormat,
+		envEndpoints))
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.ExitCode())
+	}
+	if err != nil {
+		utils.HandleErrorAndExit("Error running the plugin "+plugin.Name, err)
+	}
+}
+
+// getEnvironmentFromArgs returns the value of the --environment (-e) flag in the arguments of a plugin
+func getEnvironmentFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		for _, flag := range []string{"--environment", "-e"} {
+			if arg == flag && i+1 < len(args) {
+				return args[i+1]
+			}
+			if strings.HasPrefix(arg, flag+"=") {
+				return strings.TrimPrefix(arg, flag+"=")
+			}
+		}
+	}
+	return ""
+}
+
+// getPluginAccessToken returns an access token of the user logged into the environment, or a blank string if no user
+// is logged in. The plugins are not prompted for a login, as they could be run non-interactively.
+func getPluginAccessToken(env string) string {
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil || !store.HasAPIM(env) {
+		utils.Logln(utils.LogPrefixInfo + "No user is logged into APIM in " + env + ". The plugin is run without " +
+			"an access token")
+		return ""
+	}
+	cred, err := store.GetAPIMCredentials(env)
+	if err == nil {
+		var accessToken string
+		accessToken, err = credentials.GetOAuthAccessToken(cred, env)
+		if err == nil {
+			return accessToken
+		}
+	}
+	fmt.Fprintln(os.Stderr, "WARNING: Unable to get an access token of "+env+" for the plugin:", err)
+	return ""
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(PluginCmd)
+}
@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const defaultPluginsTableFormat = "table {{.Name}}\t{{.Path}}"
+
+var pluginsCmdFormat string
+
+const (
+	// PluginList command related usage info
+	PluginListCmdLiteral   = "list"
+	pluginListCmdShortDesc = "Display the list of plugins"
+	pluginListCmdLongDesc  = "Display the list of plugins found in the " + utils.PluginsDirName +
+		" directory of the config directory and in PATH. A plugin shadows the plugins of the same name found later."
+)
+
+const pluginListCmdExamples = utils.ProjectName + ` ` + PluginCmdLiteral + ` ` + PluginListCmdLiteral + `
+` + utils.ProjectName + ` ` + PluginCmdLiteral + ` ` + PluginListCmdLiteral + ` --format "{{ jsonPretty . }}"`
+
+// PluginListCmd represents the plugin list command
+var PluginListCmd = &cobra.Command{
+	Use:     PluginListCmdLiteral,
+	Short:   pluginListCmdShortDesc,
+	Long:    pluginListCmdLongDesc,
+	Example: pluginListCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PluginCmdLiteral + " " + PluginListCmdLiteral + " called")
+		plugins := utils.FindPlugins(utils.GetPluginDirs())
+		for _, plugin := range plugins {
+			if isBuiltInCmd(plugin.Name) {
+				utils.Logln(utils.LogPrefixWarning + "The plugin " + plugin.Path +
+					" is not run as it has the name of a built-in command")
+			}
+		}
+		impl.PrintPlugins(plugins, pluginsCmdFormat, defaultPluginsTableFormat)
+	},
+}
+
+// init using Cobra
+func init() {
+	PluginCmd.AddCommand(PluginListCmd)
+	PluginListCmd.Flags().StringVarP(&pluginsCmdFormat, "format", "", defaultPluginsTableFormat,
+		"Pretty-print plugins using go templates")
+}
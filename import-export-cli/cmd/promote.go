@@ -0,0 +1,94 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	promoteFromEnvironment        string
+	promoteToEnvironment          string
+	promoteSkipCleanup            bool
+	promoteWithThrottlingPolicies bool
+)
+
+// Promote command related usage Info
+const PromoteCmdLiteral = "promote"
+const promoteCmdShortDesc = "Promote an API/API Product/Application from an environment to another"
+
+const promoteCmdLongDesc = `Export an API, an API Product or an Application from the environment specified by flag (--from) and import it to the environment specified by flag (--to) in a single step`
+
+const promoteCmdExamples = utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPICmdLiteral + ` -n TwitterAPI -v 1.0.0 --from dev --to prod --params api_params.yaml
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPIProductCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 --from dev --to prod --import-apis
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAppCmdLiteral + ` -n SampleApp -o admin --from dev --to prod`
+
+// PromoteCmd represents the promote command
+var PromoteCmd = &cobra.Command{
+	Use:     PromoteCmdLiteral,
+	Short:   promoteCmdShortDesc,
+	Long:    promoteCmdLongDesc,
+	Example: promoteCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PromoteCmdLiteral + " called")
+
+	},
+}
+
+// getPromoteAccessTokens returns the access tokens of the environments from and to which an artifact is promoted
+func getPromoteAccessTokens() (string, string) {
+	fromCred, err := GetCredentials(promoteFromEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials of "+promoteFromEnvironment, err)
+	}
+	fromAccessToken, err := credentials.GetOAuthAccessToken(fromCred, promoteFromEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while getting an access token for "+promoteFromEnvironment, err)
+	}
+	toCred, err := GetCredentials(promoteToEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting credentials of "+promoteToEnvironment, err)
+	}
+	toAccessToken, err := credentials.GetOAuthAccessToken(toCred, promoteToEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while getting an access token for "+promoteToEnvironment, err)
+	}
+	return fromAccessToken, toAccessToken
+}
+
+// addPromoteFlags adds the flags common to all the promote commands
+func addPromoteFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&promoteFromEnvironment, "from", "", "",
+		"Environment from which the artifact should be exported")
+	cmd.Flags().StringVarP(&promoteToEnvironment, "to", "", "",
+		"Environment to which the artifact should be imported")
+	cmd.Flags().BoolVarP(&promoteWithThrottlingPolicies, "with-throttling-policies", "", false,
+		"Promote the throttling policies used by the artifact before promoting it")
+	cmd.Flags().BoolVarP(&promoteSkipCleanup, "skip-cleanup", "", false, "Leave "+
+		"all temporary files created during the promotion")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(PromoteCmd)
+}
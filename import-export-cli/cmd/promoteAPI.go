@@ -0,0 +1,104 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	promoteAPIName             string
+	promoteAPIVersion          string
+	promoteAPIProvider         string
+	promoteAPIRevisionNum      string
+	promoteAPILatestRevision   bool
+	promoteAPIPreserveStatus   bool
+	promoteAPIParamsFile       string
+	promoteAPIPreserveProvider bool
+	promoteAPIRotateRevision   bool
+	promoteAPISkipDeployments  bool
+	promoteAPIWithAPIPolicies  bool
+)
+
+const (
+	// PromoteAPI command related usage info
+	PromoteAPICmdLiteral   = "api"
+	promoteAPICmdShortDesc = "Promote API"
+	promoteAPICmdLongDesc  = "Export an API from an environment and import it to another environment, applying the " +
+		"params of the target environment"
+)
+
+const promoteAPICmdExamples = utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPICmdLiteral + ` -n TwitterAPI -v 1.0.0 --from dev --to prod
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPICmdLiteral + ` -n TwitterAPI -v 1.0.0 -r admin --rev 3 --from dev --to prod --params api_params.yaml
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPICmdLiteral + ` -n FacebookAPI -v 2.1.0 --latest --from staging --to prod --params ~/deployment --rotate-revision
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPICmdLiteral + ` -n FacebookAPI -v 2.1.0 --from staging --to prod --with-api-policies --with-throttling-policies
+NOTE: All the 4 flags (--name (-n), --version (-v), --from and --to) are mandatory. If neither --rev nor --latest is provided,
+the working copy of the API is promoted without the deployment environments.`
+
+// PromoteAPICmd represents the promote api command
+var PromoteAPICmd = &cobra.Command{
+	Use: PromoteAPICmdLiteral + " (--name <name-of-the-api> --version <version-of-the-api> --from " +
+		"<environment-from-which-the-api-should-be-exported> --to <environment-to-which-the-api-should-be-imported>)",
+	Short:   promoteAPICmdShortDesc,
+	Long:    promoteAPICmdLongDesc,
+	Example: promoteAPICmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PromoteAPICmdLiteral + " called")
+		fromAccessToken, toAccessToken := getPromoteAccessTokens()
+		err := impl.PromoteAPI(fromAccessToken, toAccessToken, promoteFromEnvironment, promoteToEnvironment,
+			promoteAPIName, promoteAPIVersion, promoteAPIProvider, promoteAPIRevisionNum, promoteAPIParamsFile,
+			promoteAPILatestRevision, promoteAPIPreserveStatus, promoteAPIPreserveProvider, promoteAPIRotateRevision,
+			promoteAPISkipDeployments, promoteSkipCleanup, promoteAPIWithAPIPolicies, promoteWithThrottlingPolicies)
+		if err != nil {
+			utils.HandleErrorAndExit("Error promoting API", err)
+		}
+	},
+}
+
+// init using Cobra
+func init() {
+	PromoteCmd.AddCommand(PromoteAPICmd)
+	PromoteAPICmd.Flags().StringVarP(&promoteAPIName, "name", "n", "",
+		"Name of the API to be promoted")
+	PromoteAPICmd.Flags().StringVarP(&promoteAPIVersion, "version", "v", "",
+		"Version of the API to be promoted")
+	PromoteAPICmd.Flags().StringVarP(&promoteAPIProvider, "provider", "r", "",
+		"Provider of the API")
+	PromoteAPICmd.Flags().StringVarP(&promoteAPIRevisionNum, "rev", "", "",
+		"Revision number of the API to be promoted")
+	PromoteAPICmd.Flags().BoolVarP(&promoteAPILatestRevision, "latest", "", false,
+		"Promote the latest revision of the API")
+	PromoteAPICmd.Flags().BoolVarP(&promoteAPIPreserveStatus, "preserve-status", "", true,
+		"Preserve API status when promoting. Otherwise API will be promoted in CREATED status")
+	PromoteAPICmd.Flags().StringVarP(&promoteAPIParamsFile, "params", "", "", "Provide an API Manager params file "+
+		"or a directory generated using \"gen deployment-dir\" command")
+	PromoteAPICmd.Flags().BoolVar(&promoteAPIPreserveProvider, "preserve-provider", true,
+		"Preserve existing provider of API after importing")
+	PromoteAPICmd.Flags().BoolVar(&promoteAPIRotateRevision, "rotate-revision", false, "Rotate the "+
+		"revisions of the target environment when the maximum number of revisions is reached")
+	PromoteAPICmd.Flags().BoolVar(&promoteAPISkipDeployments, "skip-deployments", false, "Update only "+
+		"the working copy and skip deployment steps in import")
+	PromoteAPICmd.Flags().BoolVarP(&promoteAPIWithAPIPolicies, "with-api-policies", "", false,
+		"Promote the common API policies attached to the API before promoting it")
+	addPromoteFlags(PromoteAPICmd)
+	_ = PromoteAPICmd.MarkFlagRequired("name")
+	_ = PromoteAPICmd.MarkFlagRequired("version")
+}
@@ -0,0 +1,108 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	promoteAPIProductName             string
+	promoteAPIProductVersion          string
+	promoteAPIProductProvider         string
+	promoteAPIProductRevisionNum      string
+	promoteAPIProductLatestRevision   bool
+	promoteAPIProductPreserveStatus   bool
+	promoteAPIProductParamsFile       string
+	promoteAPIProductImportAPIs       bool
+	promoteAPIProductUpdateAPIs       bool
+	promoteAPIProductPreserveProvider bool
+	promoteAPIProductRotateRevision   bool
+	promoteAPIProductSkipDeployments  bool
+)
+
+const (
+	// PromoteAPIProduct command related usage info
+	PromoteAPIProductCmdLiteral   = "api-product"
+	promoteAPIProductCmdShortDesc = "Promote API Product"
+	promoteAPIProductCmdLongDesc  = "Export an API Product from an environment and import it to another environment, " +
+		"applying the params of the target environment"
+)
+
+const promoteAPIProductCmdExamples = utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPIProductCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 --from dev --to prod
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPIProductCmdLiteral + ` -n LeasingAPIProduct -v 1.0.0 --rev 2 --from dev --to prod --import-apis --params api_product_params.yaml
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAPIProductCmdLiteral + ` -n CreditAPIProduct -v 1.0.0 --latest --from staging --to prod --update-apis --with-throttling-policies
+NOTE: All the 4 flags (--name (-n), --version (-v), --from and --to) are mandatory.`
+
+// PromoteAPIProductCmd represents the promote api-product command
+var PromoteAPIProductCmd = &cobra.Command{
+	Use: PromoteAPIProductCmdLiteral + " (--name <name-of-the-api-product> --version <version-of-the-api-product> " +
+		"--from <environment-from-which-the-api-product-should-be-exported> --to " +
+		"<environment-to-which-the-api-product-should-be-imported>)",
+	Short:   promoteAPIProductCmdShortDesc,
+	Long:    promoteAPIProductCmdLongDesc,
+	Example: promoteAPIProductCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PromoteAPIProductCmdLiteral + " called")
+		fromAccessToken, toAccessToken := getPromoteAccessTokens()
+		err := impl.PromoteAPIProduct(fromAccessToken, toAccessToken, promoteFromEnvironment, promoteToEnvironment,
+			promoteAPIProductName, promoteAPIProductVersion, promoteAPIProductProvider, promoteAPIProductRevisionNum,
+			promoteAPIProductParamsFile, promoteAPIProductLatestRevision, promoteAPIProductPreserveStatus,
+			promoteAPIProductImportAPIs, promoteAPIProductUpdateAPIs, promoteAPIProductPreserveProvider,
+			promoteAPIProductRotateRevision, promoteAPIProductSkipDeployments, promoteSkipCleanup,
+			promoteWithThrottlingPolicies)
+		if err != nil {
+			utils.HandleErrorAndExit("Error promoting API Product", err)
+		}
+	},
+}
+
+// init using Cobra
+func init() {
+	PromoteCmd.AddCommand(PromoteAPIProductCmd)
+	PromoteAPIProductCmd.Flags().StringVarP(&promoteAPIProductName, "name", "n", "",
+		"Name of the API Product to be promoted")
+	PromoteAPIProductCmd.Flags().StringVarP(&promoteAPIProductVersion, "version", "v", "",
+		"Version of the API Product to be promoted")
+	PromoteAPIProductCmd.Flags().StringVarP(&promoteAPIProductProvider, "provider", "r", "",
+		"Provider of the API Product")
+	PromoteAPIProductCmd.Flags().StringVarP(&promoteAPIProductRevisionNum, "rev", "", "",
+		"Revision number of the API Product to be promoted")
+	PromoteAPIProductCmd.Flags().BoolVarP(&promoteAPIProductLatestRevision, "latest", "", false,
+		"Promote the latest revision of the API Product")
+	PromoteAPIProductCmd.Flags().BoolVarP(&promoteAPIProductPreserveStatus, "preserve-status", "", true,
+		"Preserve API Product status when promoting. Otherwise API Product will be promoted in CREATED status")
+	PromoteAPIProductCmd.Flags().StringVarP(&promoteAPIProductParamsFile, "params", "", "", "Provide an API "+
+		"Manager params file or a directory generated using \"gen deployment-dir\" command")
+	PromoteAPIProductCmd.Flags().BoolVarP(&promoteAPIProductImportAPIs, "import-apis", "", false, "Import "+
+		"dependent APIs associated with the API Product")
+	PromoteAPIProductCmd.Flags().BoolVarP(&promoteAPIProductUpdateAPIs, "update-apis", "", false, "Update "+
+		"existing dependent APIs associated with the API Product")
+	PromoteAPIProductCmd.Flags().BoolVar(&promoteAPIProductPreserveProvider, "preserve-provider", true,
+		"Preserve existing provider of API Product after importing")
+	PromoteAPIProductCmd.Flags().BoolVar(&promoteAPIProductRotateRevision, "rotate-revision", false, "Rotate "+
+		"the revisions of the target environment when the maximum number of revisions is reached")
+	PromoteAPIProductCmd.Flags().BoolVar(&promoteAPIProductSkipDeployments, "skip-deployments", false, "Update "+
+		"only the working copy and skip deployment steps in import")
+	addPromoteFlags(PromoteAPIProductCmd)
+	_ = PromoteAPIProductCmd.MarkFlagRequired("name")
+	_ = PromoteAPIProductCmd.MarkFlagRequired("version")
+}
@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	promoteAppName              string
+	promoteAppOwner             string
+	promoteAppWithKeys          bool
+	promoteAppPreserveOwner     bool
+	promoteAppSkipSubscriptions bool
+)
+
+const (
+	// PromoteApp command related usage info
+	PromoteAppCmdLiteral   = "app"
+	promoteAppCmdShortDesc = "Promote App"
+	promoteAppCmdLongDesc  = "Export an Application from an environment and import it to another environment"
+)
+
+const promoteAppCmdExamples = utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAppCmdLiteral + ` -n SampleApp -o admin --from dev --to prod
+` + utils.ProjectName + ` ` + PromoteCmdLiteral + ` ` + PromoteAppCmdLiteral + ` -n SampleApp -o admin --from dev --to prod --with-keys --preserve-owner --with-throttling-policies
+NOTE: All the 4 flags (--name (-n), --owner (-o), --from and --to) are mandatory.`
+
+// PromoteAppCmd represents the promote app command
+var PromoteAppCmd = &cobra.Command{
+	Use: PromoteAppCmdLiteral + " (--name <name-of-the-application> --owner <owner-of-the-application> --from " +
+		"<environment-from-which-the-app-should-be-exported> --to <environment-to-which-the-app-should-be-imported>)",
+	Short:   promoteAppCmdShortDesc,
+	Long:    promoteAppCmdLongDesc,
+	Example: promoteAppCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + PromoteAppCmdLiteral + " called")
+		fromAccessToken, toAccessToken := getPromoteAccessTokens()
+		err := impl.PromoteApplication(fromAccessToken, toAccessToken, promoteFromEnvironment, promoteToEnvironment,
+			promoteAppName, promoteAppOwner, promoteAppWithKeys, promoteAppPreserveOwner, promoteAppSkipSubscriptions,
+			promoteSkipCleanup, promoteWithThrottlingPolicies)
+		if err != nil {
+			utils.HandleErrorAndExit("Error promoting Application", err)
+		}
+	},
+}
+
+// init using Cobra
+func init() {
+	PromoteCmd.AddCommand(PromoteAppCmd)
+	PromoteAppCmd.Flags().StringVarP(&promoteAppName, "name", "n", "",
+		"Name of the Application to be promoted")
+	PromoteAppCmd.Flags().StringVarP(&promoteAppOwner, "owner", "o", "",
+		"Owner of the Application to be promoted")
+	PromoteAppCmd.Flags().BoolVarP(&promoteAppWithKeys, "with-keys", "", false,
+		"Promote the keys of the Application")
+	PromoteAppCmd.Flags().BoolVarP(&promoteAppPreserveOwner, "preserve-owner", "", false,
+		"Preserves app owner")
+	PromoteAppCmd.Flags().BoolVarP(&promoteAppSkipSubscriptions, "skip-subscriptions", "s", false,
+		"Skip subscriptions of the Application")
+	addPromoteFlags(PromoteAppCmd)
+	_ = PromoteAppCmd.MarkFlagRequired("name")
+	_ = PromoteAppCmd.MarkFlagRequired("owner")
+}
@@ -0,0 +1,46 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Regenerate command related usage Info
+const RegenerateCmdLiteral = "regenerate"
+const regenerateCmdShortDesc = "Regenerate the keys of an Application"
+const regenerateCmdLongDesc = `Regenerate the consumer secret of an Application in the environment specified by flag (--environment, -e)`
+
+const regenerateCmdExamples = utils.ProjectName + ` ` + RegenerateCmdLiteral + ` ` + RegenerateKeysCmdLiteral + ` -n SampleApp -e dev`
+
+// RegenerateCmd represents the regenerate command
+var RegenerateCmd = &cobra.Command{
+	Use:     RegenerateCmdLiteral,
+	Short:   regenerateCmdShortDesc,
+	Long:    regenerateCmdLongDesc,
+	Example: regenerateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RegenerateCmdLiteral + " called")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(RegenerateCmd)
+}
@@ -0,0 +1,102 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var regenerateKeysCmdEnvironment string
+var regenerateKeysCmdAppName string
+var regenerateKeysCmdKeyType string
+var regenerateKeysCmdKeyManager string
+var regenerateKeysCmdRevokeToken string
+
+// RegenerateKeysCmd related info
+const RegenerateKeysCmdLiteral = "keys"
+const regenerateKeysCmdShortDesc = "Regenerate the consumer secret of an Application"
+const regenerateKeysCmdLongDesc = "Regenerate the consumer secret of the keys of an Application generated in a key " +
+	"manager. An access token issued with the previous secret can be revoked with the new secret by giving it with " +
+	"the flag --revoke-token."
+
+const regenerateKeysCmdExamples = utils.ProjectName + ` ` + RegenerateCmdLiteral + ` ` + RegenerateKeysCmdLiteral + ` -n SampleApp -e dev
+` + utils.ProjectName + ` ` + RegenerateCmdLiteral + ` ` + RegenerateKeysCmdLiteral + ` -n SampleApp --key-type SANDBOX --key-manager Keycloak -e dev
+` + utils.ProjectName + ` ` + RegenerateCmdLiteral + ` ` + RegenerateKeysCmdLiteral + ` -n SampleApp --revoke-token <access-token> -e dev
+NOTE: The flags (--name (-n) and --environment (-e)) are mandatory.`
+
+// regenerateKeysCmd represents the regenerate keys command
+var regenerateKeysCmd = &cobra.Command{
+	Use:     RegenerateKeysCmdLiteral,
+	Short:   regenerateKeysCmdShortDesc,
+	Long:    regenerateKeysCmdLongDesc,
+	Example: regenerateKeysCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RegenerateCmdLiteral + " " + RegenerateKeysCmdLiteral + " called")
+		cred, err := GetCredentials(regenerateKeysCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeRegenerateKeysCmd(cred)
+	},
+}
+
+func executeRegenerateKeysCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, regenerateKeysCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while regenerating the keys", err)
+	}
+	keys, err := impl.RegenerateConsumerSecret(accessToken, regenerateKeysCmdEnvironment, regenerateKeysCmdAppName,
+		regenerateKeysCmdKeyType, regenerateKeysCmdKeyManager)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while regenerating the consumer secret of "+regenerateKeysCmdAppName, err)
+	}
+	fmt.Println("Consumer secret of " + regenerateKeysCmdAppName + " regenerated successfully!")
+	fmt.Println("Consumer Key: " + keys.ConsumerKey)
+	fmt.Println("Consumer Secret: " + keys.ConsumerSecret)
+
+	if regenerateKeysCmdRevokeToken != "" {
+		err = impl.RevokeApplicationToken(regenerateKeysCmdEnvironment, keys.ConsumerKey, keys.ConsumerSecret,
+			regenerateKeysCmdRevokeToken)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while revoking the access token", err)
+		}
+		fmt.Println("Access token revoked successfully!")
+	}
+}
+
+func init() {
+	RegenerateCmd.AddCommand(regenerateKeysCmd)
+	regenerateKeysCmd.Flags().StringVarP(&regenerateKeysCmdAppName, "name", "n", "",
+		"Name of the Application")
+	regenerateKeysCmd.Flags().StringVarP(&regenerateKeysCmdKeyType, "key-type", "", utils.ProductionKeyType,
+		"Type of the keys (PRODUCTION or SANDBOX)")
+	regenerateKeysCmd.Flags().StringVarP(&regenerateKeysCmdKeyManager, "key-manager", "", utils.DefaultKeyManager,
+		"Name of the key manager of the keys")
+	regenerateKeysCmd.Flags().StringVarP(&regenerateKeysCmdRevokeToken, "revoke-token", "", "",
+		"Access token to be revoked after the consumer secret is regenerated")
+	regenerateKeysCmd.Flags().StringVarP(&regenerateKeysCmdEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = regenerateKeysCmd.MarkFlagRequired("name")
+	utils.MarkEnvFlagRequired(regenerateKeysCmd)
+}
@@ -0,0 +1,90 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var revokeTokenCmdEnvironment string
+var revokeTokenCmdAppName string
+var revokeTokenCmdKeyType string
+var revokeTokenCmdKeyManager string
+var revokeTokenCmdToken string
+
+// RevokeTokenCmd related info
+const RevokeTokenCmdLiteral = "token"
+const revokeTokenCmdShortDesc = "Revoke an access token of an Application"
+const revokeTokenCmdLongDesc = "Revoke an access token issued to the keys of an Application generated in a key " +
+	"manager. The consumer key and secret of the keys are retrieved from the DevPortal to revoke the token."
+
+const revokeTokenCmdExamples = utils.ProjectName + ` ` + RevokeCmdLiteral + ` ` + RevokeTokenCmdLiteral + ` -n SampleApp --token <access-token> -e dev
+` + utils.ProjectName + ` ` + RevokeCmdLiteral + ` ` + RevokeTokenCmdLiteral + ` -n SampleApp --token <access-token> --key-type SANDBOX --key-manager Keycloak -e dev
+NOTE: The flags (--name (-n), --token and --environment (-e)) are mandatory.`
+
+// revokeTokenCmd represents the revoke token command
+var revokeTokenCmd = &cobra.Command{
+	Use:     RevokeTokenCmdLiteral,
+	Short:   revokeTokenCmdShortDesc,
+	Long:    revokeTokenCmdLongDesc,
+	Example: revokeTokenCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + RevokeCmdLiteral + " " + RevokeTokenCmdLiteral + " called")
+		cred, err := GetCredentials(revokeTokenCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeRevokeTokenCmd(cred)
+	},
+}
+
+func executeRevokeTokenCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, revokeTokenCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while revoking the access token", err)
+	}
+	err = impl.RevokeApplicationTokenOfApp(accessToken, revokeTokenCmdEnvironment, revokeTokenCmdAppName,
+		revokeTokenCmdKeyType, revokeTokenCmdKeyManager, revokeTokenCmdToken)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while revoking the access token of "+revokeTokenCmdAppName, err)
+	}
+	fmt.Println("Access token of " + revokeTokenCmdAppName + " revoked successfully!")
+}
+
+func init() {
+	RevokeCmd.AddCommand(revokeTokenCmd)
+	revokeTokenCmd.Flags().StringVarP(&revokeTokenCmdAppName, "name", "n", "",
+		"Name of the Application")
+	revokeTokenCmd.Flags().StringVarP(&revokeTokenCmdToken, "token", "", "",
+		"Access token to be revoked")
+	revokeTokenCmd.Flags().StringVarP(&revokeTokenCmdKeyType, "key-type", "", utils.ProductionKeyType,
+		"Type of the keys (PRODUCTION or SANDBOX)")
+	revokeTokenCmd.Flags().StringVarP(&revokeTokenCmdKeyManager, "key-manager", "", utils.DefaultKeyManager,
+		"Name of the key manager of the keys")
+	revokeTokenCmd.Flags().StringVarP(&revokeTokenCmdEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = revokeTokenCmd.MarkFlagRequired("name")
+	_ = revokeTokenCmd.MarkFlagRequired("token")
+	utils.MarkEnvFlagRequired(revokeTokenCmd)
+}
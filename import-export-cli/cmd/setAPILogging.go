@@ -82,7 +82,7 @@ func init() {
 		"", "Log Level")
 	setApiLoggingCmd.Flags().StringVarP(&setApiLoggingEnvironment, "environment", "e",
 		"", "Environment of the API which the log level should be set")
-	_ = setApiLoggingCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(setApiLoggingCmd)
 	_ = setApiLoggingCmd.MarkFlagRequired("api-id")
 	_ = setApiLoggingCmd.MarkFlagRequired("log-level")
 }
@@ -0,0 +1,84 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var setAppThrottlingPolicyCmdEnvironment string
+var setAppThrottlingPolicyCmdAppName string
+var setAppThrottlingPolicyCmdPolicy string
+
+// SetAppThrottlingPolicyCmd related info
+const SetAppThrottlingPolicyCmdLiteral = "app-throttling-policy"
+const setAppThrottlingPolicyCmdShortDesc = "Set the throttling policy of an Application"
+const setAppThrottlingPolicyCmdLongDesc = "Set the application throttling policy (tier) of an Application in the " +
+	"environment specified by flag (--environment, -e)"
+
+const setAppThrottlingPolicyCmdExamples = utils.ProjectName + ` ` + SetCmdLiteral + ` ` + SetAppThrottlingPolicyCmdLiteral + ` -n SampleApp --throttling-policy 20PerMin -e dev
+NOTE: All the flags (--name (-n), --throttling-policy and --environment (-e)) are mandatory.`
+
+// setAppThrottlingPolicyCmd represents the set app-throttling-policy command
+var setAppThrottlingPolicyCmd = &cobra.Command{
+	Use:     SetAppThrottlingPolicyCmdLiteral,
+	Short:   setAppThrottlingPolicyCmdShortDesc,
+	Long:    setAppThrottlingPolicyCmdLongDesc,
+	Example: setAppThrottlingPolicyCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + SetCmdLiteral + " " + SetAppThrottlingPolicyCmdLiteral + " called")
+		cred, err := GetCredentials(setAppThrottlingPolicyCmdEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting credentials", err)
+		}
+		executeSetAppThrottlingPolicyCmd(cred)
+	},
+}
+
+func executeSetAppThrottlingPolicyCmd(credential credentials.Credential) {
+	accessToken, err := credentials.GetOAuthAccessToken(credential, setAppThrottlingPolicyCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens while setting the throttling policy", err)
+	}
+	err = impl.SetApplicationThrottlingPolicy(accessToken, setAppThrottlingPolicyCmdEnvironment,
+		setAppThrottlingPolicyCmdAppName, setAppThrottlingPolicyCmdPolicy)
+	if err != nil {
+		utils.HandleErrorAndExit("Error while setting the throttling policy of "+setAppThrottlingPolicyCmdAppName, err)
+	}
+	fmt.Println("Throttling policy of " + setAppThrottlingPolicyCmdAppName + " set to " +
+		setAppThrottlingPolicyCmdPolicy + " successfully!")
+}
+
+func init() {
+	SetCmd.AddCommand(setAppThrottlingPolicyCmd)
+	setAppThrottlingPolicyCmd.Flags().StringVarP(&setAppThrottlingPolicyCmdAppName, "name", "n", "",
+		"Name of the Application")
+	setAppThrottlingPolicyCmd.Flags().StringVarP(&setAppThrottlingPolicyCmdPolicy, "throttling-policy", "", "",
+		"Application throttling policy")
+	setAppThrottlingPolicyCmd.Flags().StringVarP(&setAppThrottlingPolicyCmdEnvironment, "environment", "e", "",
+		"Environment of the Application")
+	_ = setAppThrottlingPolicyCmd.MarkFlagRequired("name")
+	_ = setAppThrottlingPolicyCmd.MarkFlagRequired("throttling-policy")
+	utils.MarkEnvFlagRequired(setAppThrottlingPolicyCmd)
+}
@@ -89,7 +89,7 @@ func init() {
 		"", "Denied Threads")
 	setCorrelationLoggingCmd.Flags().StringVarP(&setCorrelationLoggingEnvironment, "environment", "e",
 		"", "Environment where the correlation component configuration should be set")
-	_ = setCorrelationLoggingCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(setCorrelationLoggingCmd)
 	_ = setCorrelationLoggingCmd.MarkFlagRequired("component-name")
 	_ = setCorrelationLoggingCmd.MarkFlagRequired("enabled")
 }
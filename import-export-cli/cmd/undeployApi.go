@@ -127,5 +127,5 @@ func init() {
 	_ = UndeployAPICmd.MarkFlagRequired("name")
 	_ = UndeployAPICmd.MarkFlagRequired("version")
 	_ = UndeployAPICmd.MarkFlagRequired("rev")
-	_ = UndeployAPICmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(UndeployAPICmd)
 }
@@ -115,5 +115,5 @@ func init() {
 	_ = UndeployAPIProductCmd.MarkFlagRequired("name")
 	_ = UndeployAPIProductCmd.MarkFlagRequired("version")
 	_ = UndeployAPIProductCmd.MarkFlagRequired("rev")
-	_ = UndeployAPIProductCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(UndeployAPIProductCmd)
 }
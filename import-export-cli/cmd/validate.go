@@ -0,0 +1,50 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Validate command related usage Info
+const ValidateCmdLiteral = "validate"
+const validateCmdShortDesc = "Validate the definitions of APIs"
+
+const validateCmdLongDesc = `Validate the definitions of API projects after the overlays of an environment are applied`
+
+const validateCmdExamples = utils.ProjectName + ` ` + ValidateCmdLiteral + ` ` + ValidateAPICmdLiteral +
+	` -f ~/PizzaShackAPI --params ~/PizzaShackAPI/api_params.yaml -e production`
+
+// ValidateCmd represents the validate command
+var ValidateCmd = &cobra.Command{
+	Use:     ValidateCmdLiteral,
+	Short:   validateCmdShortDesc,
+	Long:    validateCmdLongDesc,
+	Example: validateCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ValidateCmdLiteral + " called")
+
+	},
+}
+
+// init using Cobra
+func init() {
+	RootCmd.AddCommand(ValidateCmd)
+}
@@ -0,0 +1,93 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	validateAPIFile        string
+	validateAPIParamsFile  string
+	validateAPIEnvironment string
+	validateAPIOutput      string
+)
+
+const (
+	// ValidateAPI command related usage info
+	ValidateAPICmdLiteral   = "api"
+	validateAPICmdShortDesc = "Validate the definition of an API"
+	validateAPICmdLongDesc  = "Apply the OpenAPI overlays of an environment given in the params to the definition " +
+		"of an API project, validate the overlaid definition and print it. The definition of the project is " +
+		"validated as it is if the params are not provided."
+)
+
+const validateAPICmdExamples = utils.ProjectName + ` ` + ValidateCmdLiteral + ` ` + ValidateAPICmdLiteral + ` -f ~/PizzaShackAPI
+` + utils.ProjectName + ` ` + ValidateCmdLiteral + ` ` + ValidateAPICmdLiteral + ` -f ~/PizzaShackAPI_1.0.0.zip --params ~/PizzaShackAPI/api_params.yaml -e production
+` + utils.ProjectName + ` ` + ValidateCmdLiteral + ` ` + ValidateAPICmdLiteral + ` -f ~/PizzaShackAPI --params ~/deployment -e production -o ~/swagger-production.yaml
+NOTE: The flag (--file (-f)) is mandatory. The flag (--environment (-e)) is mandatory when the flag (--params) is provided.`
+
+// ValidateAPICmd represents the validate api command
+var ValidateAPICmd = &cobra.Command{
+	Use: ValidateAPICmdLiteral + " --file <path-to-api> [--params <path-to-params-file-or-deployment-directory> " +
+		"--environment <environment>]",
+	Short:   validateAPICmdShortDesc,
+	Long:    validateAPICmdLongDesc,
+	Example: validateAPICmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + ValidateAPICmdLiteral + " called")
+		if validateAPIParamsFile != "" && validateAPIEnvironment == "" {
+			utils.HandleErrorAndExit("The environment should be provided with the params", nil)
+		}
+		definition, err := impl.ValidateAPIDefinition(validateAPIFile, validateAPIParamsFile, validateAPIEnvironment)
+		if err != nil {
+			utils.HandleErrorAndExit("Error validating the API definition", err)
+		}
+		if validateAPIOutput == "" {
+			fmt.Print(definition)
+			return
+		}
+		err = ioutil.WriteFile(validateAPIOutput, []byte(definition), 0644)
+		if err != nil {
+			utils.HandleErrorAndExit("Error writing the API definition to "+validateAPIOutput, err)
+		}
+		fmt.Println("API definition is valid and written to " + validateAPIOutput)
+	},
+}
+
+// init using Cobra
+func init() {
+	ValidateCmd.AddCommand(ValidateAPICmd)
+	ValidateAPICmd.Flags().StringVarP(&validateAPIFile, "file", "f", "",
+		"Name of the API project directory or archive to be validated")
+	ValidateAPICmd.Flags().StringVarP(&validateAPIParamsFile, "params", "", "",
+		"Provide an API Manager params file or a directory generated using \"gen deployment-dir\" command")
+	ValidateAPICmd.Flags().StringVarP(&validateAPIEnvironment, "environment", "e", "",
+		"Environment of which the overlays should be applied")
+	ValidateAPICmd.Flags().StringVarP(&validateAPIOutput, "output", "o", "",
+		"Path of the file to write the overlaid definition instead of printing it")
+	ValidateAPICmd.Flags().BoolVar(&utils.AllowExecSecrets, "allow-exec-secrets", false,
+		"Run the commands of the ${exec:<command>} secret references in the params file")
+	_ = ValidateAPICmd.MarkFlagRequired("file")
+}
@@ -38,6 +38,7 @@ const deployCmdLongDesc = `Deploys projects to the specified environment specifi
 Only the changed projects compared to the revision at the last successful deployment will be deployed. 
 If any project(s) got failed during the deployment, by default, the operation will rollback the environment to the last successful state. 
 If this needs to be avoided, use --skip-rollback=true
+The projects of the exported admin resources (Key Managers, Gateway Environments, Shared Scopes, API Categories, Deny Policies and the Tenant Config) are deployed before the APIs, API Products and Applications.
 NOTE: --environment (-e) flag is mandatory`
 
 const deployCmdExamples = utils.ProjectName + ` ` + vcsCmdLiteral + ` ` + deployCmdLiteral + ` -e dev
@@ -91,6 +92,8 @@ func init() {
 	DeployCmd.Flags().BoolVarP(&flagVCSDeploySkipRollback, "skipRollback", "", false,
 		"Specifies whether rolling back to the last successful revision during an error situation should be skipped")
 	DeployCmd.Flags().MarkDeprecated("skipRollback", "Use skip-rollback flag")
+	DeployCmd.Flags().BoolVar(&utils.AllowExecSecrets, "allow-exec-secrets", false,
+		"Run the commands of the ${exec:<command>} secret references in the params file")
 
-	_ = DeployCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(DeployCmd)
 }
@@ -67,6 +67,7 @@ var VCSStatusCmd = &cobra.Command{
 		} else {
 			// Normal print without json
 			fmt.Println("Projects to Deploy (" + strconv.Itoa(totalProjectsToUpdate) + ")")
+			printProjectsToUpdate(utils.ProjectTypeAdminResource, updatedProjectsPerType[utils.ProjectTypeAdminResource])
 			printProjectsToUpdate(utils.ProjectTypeApi, updatedProjectsPerType[utils.ProjectTypeApi])
 			printProjectsToUpdate(utils.ProjectTypeApiProduct, updatedProjectsPerType[utils.ProjectTypeApiProduct])
 			printProjectsToUpdate(utils.ProjectTypeApplication, updatedProjectsPerType[utils.ProjectTypeApplication])
@@ -120,5 +121,5 @@ func init() {
 	VCSStatusCmd.Flags().StringVarP(&flagVCSStatusFormat, "format", "", "",
 		"Pretty-print status (only supported \"{{ jsonPretty . }}\" and \"{{ json . }}\")")
 
-	_ = VCSStatusCmd.MarkFlagRequired("environment")
+	utils.MarkEnvFlagRequired(VCSStatusCmd)
 }
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"time"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -41,10 +42,18 @@ type Credential struct {
 	ClientId string `json:"clientId"`
 	// ClientSecret for cli
 	ClientSecret string `json:"clientSecret"`
-	// PersonalAccessToken of API Manager
+	// PersonalAccessToken of API Manager, or the access token received through the SSO login
 	PersonalAccessToken string `json:"accessToken"`
+	// RefreshToken received through the SSO login, which is used to refresh the access token
+	RefreshToken string `json:"refreshToken,omitempty"`
+	// TokenExpiry is the Unix time at which the access token received through the SSO login expires
+	TokenExpiry int64 `json:"tokenExpiry,omitempty"`
 }
 
+// tokenExpiryLeeway is the time before the expiry at which the access token received through the SSO login is
+// refreshed
+const tokenExpiryLeeway = 30 * time.Second
+
 // Credentials of cli
 type Credentials struct {
 	// Environments specific credentials
@@ -85,6 +94,9 @@ func GetDefaultCredentialStore() (Store, error) {
 
 // GetOAuthAccessToken generates an accesstoken for CLI
 func GetOAuthAccessToken(credential Credential, env string) (string, error) {
+	if credential.RefreshToken != "" && time.Now().Add(tokenExpiryLeeway).Unix() >= credential.TokenExpiry {
+		return refreshSSOAccessToken(credential, env)
+	}
 	if credential.PersonalAccessToken != "" {
 		return credential.PersonalAccessToken, nil
 	} else {
@@ -102,6 +114,35 @@ func GetOAuthAccessToken(credential Credential, env string) (string, error) {
 	return "", errors.New("access_token not found")
 }
 
+// refreshSSOAccessToken refreshes the expired access token received through the SSO login, and stores the new tokens
+func refreshSSOAccessToken(credential Credential, env string) (string, error) {
+	utils.Logln(utils.LogPrefixInfo + "Refreshing the access token of " + env)
+	tokenEndpoint := utils.GetInternalTokenEndpointOfEnv(env, utils.MainConfigFilePath)
+	tokens, err := utils.RefreshOAuthTokens(credential.RefreshToken, credential.ClientId, credential.ClientSecret,
+		tokenEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("refreshing the access token failed, login again using --sso: %w", err)
+	}
+	if tokens.RefreshToken == "" {
+		tokens.RefreshToken = credential.RefreshToken
+	}
+	store, err := GetDefaultCredentialStore()
+	if err != nil {
+		return "", err
+	}
+	err = store.SetAPIMSSOCredentials(env, credential.Username, credential.ClientId, credential.ClientSecret,
+		tokens.AccessToken, tokens.RefreshToken, GetTokenExpiry(tokens.ExpiresIn))
+	if err != nil {
+		return "", err
+	}
+	return tokens.AccessToken, nil
+}
+
+// GetTokenExpiry returns the Unix time at which a token expiring in the given number of seconds expires
+func GetTokenExpiry(expiresIn int32) int64 {
+	return time.Now().Add(time.Duration(expiresIn) * time.Second).Unix()
+}
+
 // GetBasicAuth returns basic auth username:password encoded in base64
 func GetBasicAuth(credential Credential) string {
 	return Base64Encode(fmt.Sprintf("%s:%s", credential.Username, credential.Password))
@@ -109,7 +150,7 @@ func GetBasicAuth(credential Credential) string {
 
 // Revoke access Token when user is logging out from environment
 func RevokeAccessToken(credential Credential, env string, token string) error {
-	if credential.PersonalAccessToken != "" {
+	if credential.PersonalAccessToken != "" && credential.RefreshToken == "" {
 		return nil
 	} else {
 		//get revoke endpoint
@@ -119,10 +160,14 @@ func RevokeAccessToken(credential Credential, env string, token string) error {
 		// set headers to request
 		headers := make(map[string]string)
 		headers[utils.HeaderContentType] = utils.HeaderValueXWWWFormUrlEncoded
-		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " + b64EncodedClientIDClientSecret
-
 		//Create body for the request
 		body := utils.HeaderToken + token + utils.TokenTypeForRevocation
+		if credential.ClientSecret != "" {
+			headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " + b64EncodedClientIDClientSecret
+		} else {
+			// Public clients of the SSO login identify themselves in the body
+			body += "&client_id=" + credential.ClientId
+		}
 
 		utils.Logln(utils.LogPrefixInfo + "connecting to " + tokenRevokeEndpoint)
 		resp, err := utils.InvokePOSTRequest(tokenRevokeEndpoint, headers, body)
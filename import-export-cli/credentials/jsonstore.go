@@ -105,8 +105,13 @@ func (s *JsonStore) GetAPIMCredentials(env string) (Credential, error) {
 		if err != nil {
 			return Credential{}, err
 		}
+		refreshToken, err := Base64Decode(environment.APIM.RefreshToken)
+		if err != nil {
+			return Credential{}, err
+		}
 		credential := Credential{
-			username, password, clientID, clientSecret, personalAccessToken,
+			username, password, clientID, clientSecret, personalAccessToken, refreshToken,
+			environment.APIM.TokenExpiry,
 		}
 		return credential, nil
 	}
@@ -132,6 +137,24 @@ func (s *JsonStore) SetAPIMCredentials(env, username, password, clientId, client
 	return nil
 }
 
+// SetAPIMSSOCredentials sets credentials for apim using the client ID, client secret and the tokens received through
+// the SSO login. Unlike the other setters, it does not print the plain text warning, as the tokens are also stored
+// when they are silently refreshed.
+func (s *JsonStore) SetAPIMSSOCredentials(env, username, clientID, clientSecret, accessToken, refreshToken string,
+	tokenExpiry int64) error {
+	environment := s.credentials.Environments[env]
+	environment.APIM = Credential{
+		Username:            Base64Encode(username),
+		ClientId:            Base64Encode(clientID),
+		ClientSecret:        Base64Encode(clientSecret),
+		PersonalAccessToken: Base64Encode(accessToken),
+		RefreshToken:        Base64Encode(refreshToken),
+		TokenExpiry:         tokenExpiry,
+	}
+	s.credentials.Environments[env] = environment
+	return s.persist()
+}
+
 // GetMICredentials returns credentials for micro integrator from the store or an error
 func (s *JsonStore) GetMICredentials(env string) (MiCredential, error) {
 	if environment, ok := s.credentials.Environments[env]; ok {
@@ -33,6 +33,8 @@ type Store interface {
 	GetMGToken(env string) (MgAdapterEnv, error)
 	// SetAPIMCredentials sets credentials for micro integrator using username, password, clientID and client secret
 	SetAPIMCredentials(env, username, password, clientID, clientSecret, accessToken string) error
+	// SetAPIMSSOCredentials sets credentials for apim using the client and the tokens received through the SSO login
+	SetAPIMSSOCredentials(env, username, clientID, clientSecret, accessToken, refreshToken string, tokenExpiry int64) error
 	// SetMICredentials sets credentials for micro integrator using username, password and access token
 	SetMICredentials(env, username, password, accessToken string) error
 	// SetMGToken sets the Access Token for a Microgateway Adapter env
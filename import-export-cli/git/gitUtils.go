@@ -366,6 +366,17 @@ func deployProjectDeletions(accessToken, environment string, deletedProjectsPerT
 		}
 	}
 
+	// Deleting admin resource projects last, as the APIs and the Applications could refer to them
+	adminResourceProjectsToDelete := deletedProjectsPerType[utils.ProjectTypeAdminResource]
+	if len(adminResourceProjectsToDelete) != 0 {
+		fmt.Println("\nAdmin Resources (" + strconv.Itoa(len(adminResourceProjectsToDelete)) + ") ...")
+		for i, projectParam := range adminResourceProjectsToDelete {
+			fmt.Println(strconv.Itoa(i+1) + ": " + projectParam.NickName + ": (" + projectParam.RelativePath + ")")
+			err := impl.DeleteAdminResourceOfProject(accessToken, environment, projectParam.AbsolutePath)
+			handleIfError(err, failedProjects, projectParam)
+		}
+	}
+
 	return failedProjects
 }
 
@@ -406,6 +417,28 @@ func deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environm
 	var deletedProjectsPerType = make(map[string][]*params.ProjectParams)
 	mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 
+	// deploying admin resource projects first, as the APIs and the Applications could refer to them
+	adminResourceProjects := updatedProjectsPerType[utils.ProjectTypeAdminResource]
+	if len(adminResourceProjects) != 0 {
+		fmt.Println("\nAdmin Resources (" + strconv.Itoa(len(adminResourceProjects)) + ") ...")
+		for i, projectParam := range adminResourceProjects {
+			// if the project is a deleted one, we do it later. So keep it for now.
+			if projectParam.Deleted {
+				handleProjectDeletion(i, projectParam, deletedProjectsPerType)
+				hasDeletedProjects = true
+				continue
+			}
+			importParams := projectParam.MetaData.DeployConfig.Import
+			fmt.Println(strconv.Itoa(i+1) + ": " + projectParam.NickName + ": (" + projectParam.RelativePath + ")")
+			err := impl.ImportAdminResourceToEnv(accessToken, environment, "", projectParam.AbsolutePath,
+				importParams.Update)
+			if err != nil {
+				fmt.Println("\terror... ", err)
+				failedProjects[projectParam.Type] = append(failedProjects[projectParam.Type], projectParam)
+			}
+		}
+	}
+
 	// deploying API projects
 	apiProjects := updatedProjectsPerType[utils.ProjectTypeApi]
 	if len(apiProjects) != 0 {
@@ -755,6 +788,9 @@ func checkProjectTypeOfSpecificPath(repoBasePath, fullPath string,
 		if strings.HasSuffix(fullPath, utils.MetaFileApplication) {
 			projectParams.Type = utils.ProjectTypeApplication
 		}
+		if strings.HasSuffix(fullPath, utils.MetaFileAdminResource) {
+			projectParams.Type = utils.ProjectTypeAdminResource
+		}
 		//This means project type is set from any of the above condition.
 		//  Then set the correct basePath of the project.
 		if projectParams.Type != utils.ProjectTypeNone {
@@ -798,6 +834,13 @@ func checkProjectTypeOfSpecificPath(repoBasePath, fullPath string,
 			if err != nil {
 				utils.HandleErrorAndExit("Error while parsing "+utils.MetaFileApplication+" file:"+fullPathWithFileName, err)
 			}
+		case utils.MetaFileAdminResource:
+			metaData, err := LoadMetaDataFile(fullPathWithFileName)
+			projectParams.MetaData = metaData
+			projectParams.Type = utils.ProjectTypeAdminResource
+			if err != nil {
+				utils.HandleErrorAndExit("Error while parsing "+utils.MetaFileAdminResource+" file:"+fullPathWithFileName, err)
+			}
 		}
 		if projectParams.Type != utils.ProjectTypeNone {
 			//breaks from for loop
@@ -867,6 +910,13 @@ func aggregateSourceAndDeploymentStatusResults(sourceRepoUpdatedProjectsPerType,
 	var totalNumberOfProjects = 0
 	finalAggregatedProjectsPerType := make(map[string][]*params.ProjectParams)
 
+	finalAggregatedProjectsPerType[utils.ProjectTypeAdminResource] = []*params.ProjectParams{}
+	var updatedAdminResourceProjects []string // This will be used only for search to know whether a project is already there
+	addProjectsToUniqueList(sourceRepoUpdatedProjectsPerType, finalAggregatedProjectsPerType,
+		&updatedAdminResourceProjects, utils.ProjectTypeAdminResource, &totalNumberOfProjects)
+	addProjectsToUniqueList(deploymentRepoUpdatedProjectsPerType, finalAggregatedProjectsPerType,
+		&updatedAdminResourceProjects, utils.ProjectTypeAdminResource, &totalNumberOfProjects)
+
 	finalAggregatedProjectsPerType[utils.ProjectTypeApi] = []*params.ProjectParams{}
 	var updatedApiProjects []string // This will be used only for search to know whether a project is already there
 	addProjectsToUniqueList(sourceRepoUpdatedProjectsPerType, finalAggregatedProjectsPerType,
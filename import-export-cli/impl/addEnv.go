@@ -66,10 +66,15 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 		return errors.New("Environment '" + envName + "' already exists in " + mainConfigFilePath)
 	}
 
+	if err := utils.ValidateTransportSettings(envEndpoints.TransportSettings); err != nil {
+		return err
+	}
+
 	mainConfig := utils.GetMainConfigFromFile(mainConfigFilePath)
 
 	var validatedEnvEndpoints = utils.EnvEndpoints{
-		TokenEndpoint: envEndpoints.TokenEndpoint,
+		TokenEndpoint:     envEndpoints.TokenEndpoint,
+		TransportSettings: envEndpoints.TransportSettings,
 	}
 
 	if envEndpoints.ApiManagerEndpoint != "" {
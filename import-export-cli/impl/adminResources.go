@@ -0,0 +1,694 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Types of the admin resources, which are also the names of their export and import commands
+const (
+	AdminResourceTypeKeyManager         = "key-manager"
+	AdminResourceTypeGatewayEnvironment = "gateway-environment"
+	AdminResourceTypeScope              = "scope"
+	AdminResourceTypeApiCategory        = "api-category"
+	AdminResourceTypeDenyPolicy         = "deny-policy"
+	AdminResourceTypeTenantConfig       = "tenant-config"
+)
+
+const (
+	exportAdminResourceType    = "admin resource"
+	exportAdminResourceVersion = "v4"
+	// adminResourcesListLimit is the number of resources requested from the paginated lists
+	adminResourcesListLimit = 1000
+)
+
+var (
+	// adminResourceSecretVariableRegex matches a secret field exported as a reference to an environment variable
+	adminResourceSecretVariableRegex = regexp.MustCompile(`^\${(\w+)}$`)
+	// adminResourceSecretVariableCharsRegex matches the characters which are not allowed in environment variables
+	adminResourceSecretVariableCharsRegex = regexp.MustCompile(`\W+`)
+)
+
+// adminResourceKind describes how a type of admin resources is stored in the REST APIs
+type adminResourceKind struct {
+	// subtype is the subtype of the exported envelope
+	subtype string
+	// fileNamePrefix prefixes the names of the exported archives
+	fileNamePrefix string
+	// resource is the path of the resources relative to the REST API
+	resource string
+	// publisher is true if the resources are managed through the Publisher REST API instead of the Admin REST API
+	publisher bool
+	// singleton is true if an environment has only one resource of the type, which is updated in place
+	singleton bool
+	// idField is the field holding the ID generated by the server
+	idField string
+	// nameFields are the fields identifying a resource across environments
+	nameFields []string
+	// readOnlyFields are dropped when a resource is exported, as the server generates them
+	readOnlyFields []string
+	// secretFields are the paths of the fields holding credentials, whose nested fields are separated by '.'. They are
+	// exported as environment variables, which are substituted when the resource is imported.
+	secretFields []string
+	// fetchById is true if the list returns summaries, so that each resource is fetched by its ID
+	fetchById bool
+	// recreateOnUpdate is true if the resources can not be updated in place, so that they are deleted and created. The
+	// deleted resource is restored if it can not be created.
+	recreateOnUpdate bool
+}
+
+var adminResourceKinds = map[string]adminResourceKind{
+	AdminResourceTypeKeyManager: {
+		subtype:        "key manager",
+		fileNamePrefix: "KeyManager",
+		resource:       "key-managers",
+		idField:        "id",
+		nameFields:     []string{"name"},
+		secretFields:   []string{"additionalProperties.client_id", "additionalProperties.client_secret"},
+		fetchById:      true,
+	},
+	AdminResourceTypeGatewayEnvironment: {
+		subtype:        "gateway environment",
+		fileNamePrefix: "GatewayEnvironment",
+		resource:       "environments",
+		idField:        "id",
+		nameFields:     []string{"name"},
+	},
+	AdminResourceTypeScope: {
+		subtype:        "scope",
+		fileNamePrefix: "Scope",
+		resource:       "scopes",
+		publisher:      true,
+		idField:        "id",
+		nameFields:     []string{"name"},
+		readOnlyFields: []string{"usageCount"},
+	},
+	AdminResourceTypeApiCategory: {
+		subtype:        "api category",
+		fileNamePrefix: "ApiCategory",
+		resource:       "api-categories",
+		idField:        "id",
+		nameFields:     []string{"name"},
+		readOnlyFields: []string{"numberOfAPIs"},
+	},
+	AdminResourceTypeDenyPolicy: {
+		subtype:          "deny policy",
+		fileNamePrefix:   "DenyPolicy",
+		resource:         "deny-policies",
+		idField:          "conditionId",
+		nameFields:       []string{"conditionType", "conditionValue"},
+		recreateOnUpdate: true,
+	},
+	AdminResourceTypeTenantConfig: {
+		subtype:        "tenant config",
+		fileNamePrefix: "TenantConfig",
+		resource:       "tenant-config",
+		singleton:      true,
+	},
+}
+
+// GetAdminResourceTypes returns the types of the admin resources which can be exported and imported
+func GetAdminResourceTypes() []string {
+	types := make([]string, 0, len(adminResourceKinds))
+	for resourceType := range adminResourceKinds {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ExportAdminResourceFromEnv exports an admin resource of an environment in an envelope
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to export the resource from
+// @param resourceType : Type of the resource, which is one of the AdminResourceType constants
+// @param name : Name of the resource. A deny policy is identified by its condition value, optionally prefixed by its
+// condition type and ':'. Ignored for the tenant config
+// @return envelope of the resource
+// @return error
+func ExportAdminResourceFromEnv(accessToken, environment, resourceType, name string) (*utils.ExportAdminResource,
+	error) {
+	kind, err := getAdminResourceKind(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := getAdminResourceEndpoint(environment, kind)
+
+	var data map[string]interface{}
+	if kind.singleton {
+		data, err = getAdminResource(accessToken, endpoint, kind)
+	} else {
+		data, err = findAdminResource(accessToken, endpoint, kind, func(resource map[string]interface{}) bool {
+			return matchesAdminResourceName(kind, resource, name)
+		})
+		if err == nil && data == nil {
+			err = fmt.Errorf("cannot find the %s %s in %s", kind.subtype, name, environment)
+		}
+		if err == nil && kind.fetchById {
+			data, err = getAdminResource(accessToken, endpoint+"/"+fmt.Sprint(data[kind.idField]), kind)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if kind.idField != "" {
+		delete(data, kind.idField)
+	}
+	for _, field := range kind.readOnlyFields {
+		delete(data, field)
+	}
+	parameteriseAdminResourceSecrets(kind, data)
+	content, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &utils.ExportAdminResource{
+		Type:    exportAdminResourceType,
+		Subtype: kind.subtype,
+		Version: exportAdminResourceVersion,
+		Data:    content,
+	}, nil
+}
+
+// WriteAdminResourceToArchive writes the envelope and the meta file of an exported admin resource to a project
+// directory, and archives it in the export directory
+// @param exportDirectory : Directory to write the archive to
+// @param resource : Envelope of the exported resource
+// @param exportFormat : Format of the envelope file, which is YAML or JSON
+// @return path of the archive
+// @return error
+func WriteAdminResourceToArchive(exportDirectory string, resource *utils.ExportAdminResource,
+	exportFormat string) (string, error) {
+	kind, err := getAdminResourceKindOfSubtype(resource.Subtype)
+	if err != nil {
+		return "", err
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal(resource.Data, &data); err != nil {
+		return "", err
+	}
+	name := getAdminResourceName(kind, data)
+	projectName := kind.fileNamePrefix
+	if !kind.singleton {
+		projectName += "-" + toAdminResourceFileName(name)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "apim")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpDir)
+	projectDir := filepath.Join(tmpDir, projectName)
+	if err := utils.CreateDirIfNotExist(projectDir); err != nil {
+		return "", err
+	}
+
+	content, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	resourceFile := utils.AdminResourceFileJson
+	if exportFormat == utils.DefaultExportFormat {
+		resourceFile = utils.AdminResourceFileYaml
+		content, err = utils.JsonToYaml(content)
+		if err != nil {
+			return "", err
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(projectDir, resourceFile), content, 0644); err != nil {
+		return "", err
+	}
+
+	metaData := utils.MetaData{
+		Name: name,
+		DeployConfig: utils.DeployConfig{
+			Import: utils.ImportConfig{
+				Update: true,
+			},
+		},
+	}
+	metaContent, err := json.Marshal(metaData)
+	if err != nil {
+		return "", err
+	}
+	metaContent, err = utils.JsonToYaml(metaContent)
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(filepath.Join(projectDir, utils.MetaFileAdminResource), metaContent,
+		0644); err != nil {
+		return "", err
+	}
+
+	if err := utils.CreateDirIfNotExist(exportDirectory); err != nil {
+		return "", err
+	}
+	archivePath := filepath.Join(exportDirectory, projectName+".zip")
+	if err := utils.Zip(projectDir, archivePath); err != nil {
+		return "", err
+	}
+	return archivePath, nil
+}
+
+// LoadAdminResource loads the envelope of an admin resource from an exported archive, a project directory or an
+// envelope file. The environment variables in the envelope, which are defined as ${var}, are substituted.
+// @param path : Path of the archive, the directory or the file
+// @return envelope of the resource
+// @return error
+func LoadAdminResource(path string) (*utils.ExportAdminResource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	resourceFile := path
+	if info.IsDir() || strings.HasSuffix(strings.ToLower(path), ".zip") {
+		projectDir := path
+		if !info.IsDir() {
+			projectDir, err = utils.GetTempCloneFromDirOrZip(path)
+			if err != nil {
+				return nil, err
+			}
+			defer os.RemoveAll(filepath.Dir(projectDir))
+		}
+		resourceFile = filepath.Join(projectDir, utils.AdminResourceFileYaml)
+		if !utils.IsFileExist(resourceFile) {
+			resourceFile = filepath.Join(projectDir, utils.AdminResourceFileJson)
+		}
+	}
+
+	content, err := ioutil.ReadFile(resourceFile)
+	if err != nil {
+		return nil, err
+	}
+	substituted, err := utils.EnvSubstituteForCurlyBraces(string(content))
+	if err != nil {
+		return nil, err
+	}
+	jsonContent, err := utils.YamlToJson([]byte(substituted))
+	if err != nil {
+		return nil, err
+	}
+	resource := &utils.ExportAdminResource{}
+	if err := json.Unmarshal(jsonContent, resource); err != nil {
+		return nil, err
+	}
+	if resource.Type != exportAdminResourceType || len(resource.Data) == 0 {
+		return nil, errors.New(resourceFile + " is not an exported admin resource")
+	}
+	return resource, nil
+}
+
+// ImportAdminResourceToEnv creates or updates an admin resource of an environment from an exported archive, a
+// project directory or an envelope file. A relative path is also looked up in the export directory of the admin
+// resources.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to import the resource to
+// @param resourceType : Expected type of the resource. Any type is accepted if it is blank
+// @param importPath : Path of the archive, the directory or the file
+// @param update : Update the resource if it exists in the environment
+// @return error
+func ImportAdminResourceToEnv(accessToken, environment, resourceType, importPath string, update bool) error {
+	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedAdminResourcesDirName)
+	importPath, err := resolvePolicyImportFilePath(importPath, exportDirectory)
+	if err != nil {
+		return err
+	}
+	resource, err := LoadAdminResource(importPath)
+	if err != nil {
+		return err
+	}
+	kind, err := getAdminResourceKindOfSubtype(resource.Subtype)
+	if err != nil {
+		return err
+	}
+	if resourceType != "" && adminResourceKinds[resourceType].subtype != kind.subtype {
+		return fmt.Errorf("%s is a %s, not a %s", importPath, kind.subtype, adminResourceKinds[resourceType].subtype)
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal(resource.Data, &data); err != nil {
+		return err
+	}
+	if err := checkAdminResourceSecrets(kind, data); err != nil {
+		return err
+	}
+	endpoint := getAdminResourceEndpoint(environment, kind)
+	headers := getAdminResourceHeaders(accessToken)
+
+	if kind.singleton {
+		utils.Logln(utils.LogPrefixInfo+"ImportAdminResource: URL:", endpoint)
+		resp, err := utils.InvokePUTRequestWithoutQueryParams(endpoint, headers, string(resource.Data))
+		if err != nil {
+			return err
+		}
+		return checkAdminResourceResponse(resp, "update the "+kind.subtype, http.StatusOK)
+	}
+
+	name := getAdminResourceName(kind, data)
+	existing, err := findAdminResource(accessToken, endpoint, kind, func(r map[string]interface{}) bool {
+		return getAdminResourceName(kind, r) == name
+	})
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if !update {
+			return fmt.Errorf("the %s %s already exists in %s. Use the update flag to update it", kind.subtype,
+				name, environment)
+		}
+		resourceEndpoint := endpoint + "/" + fmt.Sprint(existing[kind.idField])
+		if !kind.recreateOnUpdate {
+			utils.Logln(utils.LogPrefixInfo+"ImportAdminResource: URL:", resourceEndpoint)
+			resp, err := utils.InvokePUTRequestWithoutQueryParams(resourceEndpoint, headers, string(resource.Data))
+			if err != nil {
+				return err
+			}
+			return checkAdminResourceResponse(resp, "update the "+kind.subtype+" "+name, http.StatusOK)
+		}
+		// The server rejects a duplicate of the resource, so that it is deleted before it is created
+		resp, err := utils.InvokeDELETERequest(resourceEndpoint, headers)
+		if err != nil {
+			return err
+		}
+		if err := checkAdminResourceResponse(resp, "replace the "+kind.subtype+" "+name, http.StatusOK,
+			http.StatusNoContent); err != nil {
+			return err
+		}
+		if err := createAdminResource(endpoint, headers, kind, name, resource.Data); err != nil {
+			if restoreErr := restoreAdminResource(endpoint, headers, kind, name, existing); restoreErr != nil {
+				return fmt.Errorf("%w. Restoring the previous %s also failed: %v", err, kind.subtype, restoreErr)
+			}
+			return fmt.Errorf("%w. The previous %s is restored", err, kind.subtype)
+		}
+		return nil
+	}
+	return createAdminResource(endpoint, headers, kind, name, resource.Data)
+}
+
+// GetAdminResourceSecretVariables returns the environment variables which should be set to import an exported admin
+// resource, as its secret fields are exported as references to them
+// @param resource : Envelope of the exported resource
+// @return names of the environment variables
+// @return error
+func GetAdminResourceSecretVariables(resource *utils.ExportAdminResource) ([]string, error) {
+	kind, err := getAdminResourceKindOfSubtype(resource.Subtype)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal(resource.Data, &data); err != nil {
+		return nil, err
+	}
+	var variables []string
+	for _, field := range kind.secretFields {
+		parent, key := getAdminResourceField(data, field)
+		if value, isString := parent[key].(string); isString {
+			if match := adminResourceSecretVariableRegex.FindStringSubmatch(value); match != nil {
+				variables = append(variables, match[1])
+			}
+		}
+	}
+	return variables, nil
+}
+
+// parameteriseAdminResourceSecrets replaces the values of the secret fields of a resource by references to
+// environment variables, named after the resource and the field. The value returned by the server is not exported
+// even if it is masked, as the mask can not be imported either.
+func parameteriseAdminResourceSecrets(kind adminResourceKind, data map[string]interface{}) {
+	for _, field := range kind.secretFields {
+		parent, key := getAdminResourceField(data, field)
+		if value, isString := parent[key].(string); isString && value != "" {
+			parent[key] = "${" + getAdminResourceSecretVariable(kind, data, key) + "}"
+		}
+	}
+}
+
+// checkAdminResourceSecrets returns an error if a secret field of a resource to be imported holds a value masked by
+// the server, which would replace the actual secret
+func checkAdminResourceSecrets(kind adminResourceKind, data map[string]interface{}) error {
+	for _, field := range kind.secretFields {
+		parent, key := getAdminResourceField(data, field)
+		if value, isString := parent[key].(string); isString && value != "" && strings.Trim(value, "*") == "" {
+			return fmt.Errorf("the field %s of the %s %s is masked. Provide the actual value, or a reference to an "+
+				"environment variable as ${%s}", field, kind.subtype, getAdminResourceName(kind, data),
+				getAdminResourceSecretVariable(kind, data, key))
+		}
+	}
+	return nil
+}
+
+// getAdminResourceField returns the object holding a field of the given path and the key of the field in it. The
+// object is nil if a parent of the field does not exist.
+func getAdminResourceField(data map[string]interface{}, path string) (map[string]interface{}, string) {
+	keys := strings.Split(path, ".")
+	parent := data
+	for _, key := range keys[:len(keys)-1] {
+		parent, _ = parent[key].(map[string]interface{})
+	}
+	return parent, keys[len(keys)-1]
+}
+
+// getAdminResourceSecretVariable returns the environment variable of a secret field of a resource, such as
+// KEYMANAGER_KEYCLOAK_CLIENT_SECRET
+func getAdminResourceSecretVariable(kind adminResourceKind, data map[string]interface{}, key string) string {
+	variable := kind.fileNamePrefix + "_" + getAdminResourceName(kind, data) + "_" + key
+	return strings.ToUpper(adminResourceSecretVariableCharsRegex.ReplaceAllString(variable, "_"))
+}
+
+// createAdminResource creates a resource of the given content
+func createAdminResource(endpoint string, headers map[string]string, kind adminResourceKind, name string,
+	content []byte) error {
+	utils.Logln(utils.LogPrefixInfo+"ImportAdminResource: URL:", endpoint)
+	resp, err := utils.InvokePOSTRequest(endpoint, headers, string(content))
+	if err != nil {
+		return err
+	}
+	return checkAdminResourceResponse(resp, "create the "+kind.subtype+" "+name, http.StatusCreated, http.StatusOK)
+}
+
+// restoreAdminResource creates a deleted resource again from its details listed before it was deleted
+func restoreAdminResource(endpoint string, headers map[string]string, kind adminResourceKind, name string,
+	deleted map[string]interface{}) error {
+	data := make(map[string]interface{}, len(deleted))
+	for field, value := range deleted {
+		data[field] = value
+	}
+	delete(data, kind.idField)
+	for _, field := range kind.readOnlyFields {
+		delete(data, field)
+	}
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return createAdminResource(endpoint, headers, kind, name, content)
+}
+
+// DeleteAdminResourceOfProject deletes the admin resource of an exported archive, a project directory or an envelope
+// file from an environment. The tenant config can not be deleted, and a resource missing in the environment is
+// skipped.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to delete the resource from
+// @param path : Path of the archive, the directory or the file
+// @return error
+func DeleteAdminResourceOfProject(accessToken, environment, path string) error {
+	resource, err := LoadAdminResource(path)
+	if err != nil {
+		return err
+	}
+	kind, err := getAdminResourceKindOfSubtype(resource.Subtype)
+	if err != nil {
+		return err
+	}
+	if kind.singleton {
+		return errors.New("the " + kind.subtype + " can not be deleted")
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal(resource.Data, &data); err != nil {
+		return err
+	}
+	name := getAdminResourceName(kind, data)
+	endpoint := getAdminResourceEndpoint(environment, kind)
+	existing, err := findAdminResource(accessToken, endpoint, kind, func(r map[string]interface{}) bool {
+		return getAdminResourceName(kind, r) == name
+	})
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		fmt.Println("The " + kind.subtype + " " + name + " does not exist in " + environment)
+		return nil
+	}
+	resp, err := utils.InvokeDELETERequest(endpoint+"/"+fmt.Sprint(existing[kind.idField]),
+		getAdminResourceHeaders(accessToken))
+	if err != nil {
+		return err
+	}
+	if err := checkAdminResourceResponse(resp, "delete the "+kind.subtype+" "+name, http.StatusOK,
+		http.StatusNoContent); err != nil {
+		return err
+	}
+	fmt.Println("The " + kind.subtype + " " + name + " deleted successfully!")
+	return nil
+}
+
+// findAdminResource returns the first resource of the list which satisfies the condition, or nil if there is none
+func findAdminResource(accessToken, endpoint string, kind adminResourceKind,
+	condition func(map[string]interface{}) bool) (map[string]interface{}, error) {
+	queryParams := make(map[string]string)
+	if kind.publisher {
+		queryParams["limit"] = strconv.Itoa(adminResourcesListLimit)
+	}
+	utils.Logln(utils.LogPrefixInfo+"ListAdminResources: URL:", endpoint)
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, endpoint,
+		getAdminResourceHeaders(accessToken))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAdminResourceResponse(resp, "list the "+kind.subtype+" resources", http.StatusOK); err != nil {
+		return nil, err
+	}
+	list := struct {
+		List []map[string]interface{} `json:"list"`
+	}{}
+	if err := json.Unmarshal(resp.Body(), &list); err != nil {
+		return nil, err
+	}
+	for _, resource := range list.List {
+		if condition(resource) {
+			return resource, nil
+		}
+	}
+	return nil, nil
+}
+
+// getAdminResource gets a single resource from the endpoint
+func getAdminResource(accessToken, endpoint string, kind adminResourceKind) (map[string]interface{}, error) {
+	utils.Logln(utils.LogPrefixInfo+"GetAdminResource: URL:", endpoint)
+	resp, err := utils.InvokeGETRequest(endpoint, getAdminResourceHeaders(accessToken))
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAdminResourceResponse(resp, "get the "+kind.subtype, http.StatusOK); err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal(resp.Body(), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func getAdminResourceKind(resourceType string) (adminResourceKind, error) {
+	kind, ok := adminResourceKinds[resourceType]
+	if !ok {
+		return kind, fmt.Errorf("invalid admin resource type '%s'. It should be one of %s", resourceType,
+			strings.Join(GetAdminResourceTypes(), ", "))
+	}
+	return kind, nil
+}
+
+func getAdminResourceKindOfSubtype(subtype string) (adminResourceKind, error) {
+	for _, kind := range adminResourceKinds {
+		if kind.subtype == subtype {
+			return kind, nil
+		}
+	}
+	return adminResourceKind{}, fmt.Errorf("unsupported admin resource subtype '%s'", subtype)
+}
+
+func getAdminResourceEndpoint(environment string, kind adminResourceKind) string {
+	if kind.publisher {
+		return utils.AppendSlashToString(utils.GetPublisherEndpointOfEnv(environment,
+			utils.MainConfigFilePath)) + kind.resource
+	}
+	return utils.AppendSlashToString(utils.GetAdminEndpointOfEnv(environment, utils.MainConfigFilePath)) +
+		kind.resource
+}
+
+func getAdminResourceHeaders(accessToken string) map[string]string {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	headers[utils.HeaderAccept] = utils.HeaderValueApplicationJSON
+	return headers
+}
+
+// getAdminResourceName returns the name identifying the resource, which joins the values of the name fields by ':'
+func getAdminResourceName(kind adminResourceKind, data map[string]interface{}) string {
+	if kind.singleton {
+		return kind.fileNamePrefix
+	}
+	values := make([]string, 0, len(kind.nameFields))
+	for _, field := range kind.nameFields {
+		value, isString := data[field].(string)
+		if !isString {
+			content, _ := json.Marshal(data[field])
+			value = string(content)
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, ":")
+}
+
+// matchesAdminResourceName returns true if the resource has the given name. The value of the last name field alone
+// also matches a resource identified by several fields.
+func matchesAdminResourceName(kind adminResourceKind, data map[string]interface{}, name string) bool {
+	if getAdminResourceName(kind, data) == name {
+		return true
+	}
+	if len(kind.nameFields) > 1 {
+		value, isString := data[kind.nameFields[len(kind.nameFields)-1]].(string)
+		return isString && value == name
+	}
+	return false
+}
+
+// toAdminResourceFileName replaces the characters of a resource name which are not allowed in file names
+func toAdminResourceFileName(name string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", ":", "_", "*", "_", "?", "_", "\"", "_", "<", "_",
+		">", "_", "|", "_", " ", "_").Replace(name)
+}
+
+func checkAdminResourceResponse(resp *resty.Response, action string, expectedStatusCodes ...int) error {
+	for _, statusCode := range expectedStatusCodes {
+		if resp.StatusCode() == statusCode {
+			return nil
+		}
+	}
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		return errors.New("authorization failed while trying to " + action)
+	}
+	return errors.New("Unable to " + action + ". Status: " + resp.Status() + " " + string(resp.Body()))
+}
@@ -0,0 +1,209 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const adminResourcesTestEnv = "dev"
+
+// adminResourcesTestServer records the requests sent to the key managers and the deny policies of the Admin REST API
+type adminResourcesTestServer struct {
+	requests []string
+	bodies   map[string]map[string]interface{}
+	// rejectedCreations is the number of the deny policies to be rejected before the others are created
+	rejectedCreations int
+}
+
+func newAdminResourcesTestServer(t *testing.T) *adminResourcesTestServer {
+	recorder := &adminResourcesTestServer{bodies: make(map[string]map[string]interface{})}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + strings.TrimPrefix(r.URL.Path, "/api/am/admin/v4")
+		recorder.requests = append(recorder.requests, request)
+		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+			body := make(map[string]interface{})
+			content, _ := ioutil.ReadAll(r.Body)
+			assert.Nil(t, json.Unmarshal(content, &body))
+			recorder.bodies[request] = body
+		}
+		switch request {
+		case "GET /key-managers":
+			w.Write([]byte(`{"count": 1, "list": [{"id": "km-1", "name": "Keycloak", "type": "KeyCloak"}]}`))
+		case "GET /key-managers/km-1":
+			w.Write([]byte(`{"id": "km-1", "name": "Keycloak", "type": "KeyCloak", "enabled": true,
+				"additionalProperties": {"client_id": "apim", "client_secret": "*****"}}`))
+		case "GET /deny-policies":
+			w.Write([]byte(`{"count": 1, "list": [{"conditionId": "dp-1", "conditionType": "API",
+				"conditionValue": "/pizzashack/1.0.0", "conditionStatus": true}]}`))
+		case "PUT /key-managers/km-1", "PUT /tenant-config", "DELETE /deny-policies/dp-1":
+			w.WriteHeader(http.StatusOK)
+		case "POST /deny-policies":
+			if recorder.rejectedCreations > 0 {
+				recorder.rejectedCreations--
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{}`))
+		case "POST /key-managers":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("Unexpected request %s", request)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setTestEnvironment(t, adminResourcesTestEnv, server.URL)
+	return recorder
+}
+
+func writeTestAdminResource(t *testing.T, subtype, data string) string {
+	path := filepath.Join(t.TempDir(), utils.AdminResourceFileYaml)
+	content := "type: admin resource\nsubtype: " + subtype + "\nversion: v4\ndata:\n" + data
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestExportAdminResourceToArchive(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+
+	resource, err := ExportAdminResourceFromEnv("token", adminResourcesTestEnv, AdminResourceTypeKeyManager,
+		"Keycloak")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /key-managers", "GET /key-managers/km-1"}, recorder.requests)
+	assert.Equal(t, "key manager", resource.Subtype)
+	assert.NotContains(t, string(resource.Data), "km-1", "The ID should not be exported")
+	assert.NotContains(t, string(resource.Data), "apim", "The client ID should not be exported")
+	variables, err := GetAdminResourceSecretVariables(resource)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"KEYMANAGER_KEYCLOAK_CLIENT_ID", "KEYMANAGER_KEYCLOAK_CLIENT_SECRET"}, variables)
+	t.Setenv("KEYMANAGER_KEYCLOAK_CLIENT_ID", "apim")
+	t.Setenv("KEYMANAGER_KEYCLOAK_CLIENT_SECRET", "secret")
+
+	archivePath, err := WriteAdminResourceToArchive(t.TempDir(), resource, utils.DefaultExportFormat)
+	assert.Nil(t, err)
+	assert.Equal(t, "KeyManager-Keycloak.zip", filepath.Base(archivePath))
+
+	loaded, err := LoadAdminResource(archivePath)
+	assert.Nil(t, err)
+	assert.Equal(t, resource.Subtype, loaded.Subtype)
+	assert.Contains(t, string(loaded.Data), `"client_secret":"secret"`, "The secret should be substituted")
+}
+
+func TestExportMissingAdminResource(t *testing.T) {
+	newAdminResourcesTestServer(t)
+
+	_, err := ExportAdminResourceFromEnv("token", adminResourcesTestEnv, AdminResourceTypeKeyManager, "Okta")
+	assert.NotNil(t, err)
+}
+
+func TestImportExistingAdminResource(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+	path := writeTestAdminResource(t, "key manager", "  name: Keycloak\n  type: KeyCloak\n  enabled: false\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, AdminResourceTypeKeyManager, path, false)
+	assert.NotNil(t, err, "An existing resource should not be updated without the update flag")
+
+	err = ImportAdminResourceToEnv("token", adminResourcesTestEnv, AdminResourceTypeKeyManager, path, true)
+	assert.Nil(t, err)
+	assert.Equal(t, false, recorder.bodies["PUT /key-managers/km-1"]["enabled"])
+}
+
+func TestImportAdminResourceWithMaskedSecret(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+	path := writeTestAdminResource(t, "key manager", "  name: Okta\n  type: Okta\n  additionalProperties:\n"+
+		"    client_id: apim\n    client_secret: '*****'\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, "", path, false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "${KEYMANAGER_OKTA_CLIENT_SECRET}")
+	assert.Empty(t, recorder.requests, "The masked secret should not be sent")
+}
+
+func TestImportNewAdminResource(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+	path := writeTestAdminResource(t, "key manager", "  name: Okta\n  type: Okta\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, "", path, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "Okta", recorder.bodies["POST /key-managers"]["name"])
+}
+
+func TestImportAdminResourceOfAnotherType(t *testing.T) {
+	newAdminResourcesTestServer(t)
+	path := writeTestAdminResource(t, "key manager", "  name: Okta\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, AdminResourceTypeScope, path, false)
+	assert.NotNil(t, err)
+}
+
+func TestImportExistingDenyPolicyIsRecreated(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+	path := writeTestAdminResource(t, "deny policy",
+		"  conditionType: API\n  conditionValue: /pizzashack/1.0.0\n  conditionStatus: false\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, AdminResourceTypeDenyPolicy, path, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GET /deny-policies", "DELETE /deny-policies/dp-1", "POST /deny-policies"},
+		recorder.requests)
+}
+
+func TestImportDenyPolicyRestoresDeletedPolicy(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+	recorder.rejectedCreations = 1
+	path := writeTestAdminResource(t, "deny policy",
+		"  conditionType: API\n  conditionValue: /pizzashack/1.0.0\n  conditionStatus: invalid\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, AdminResourceTypeDenyPolicy, path, true)
+	assert.NotNil(t, err, "Should return the error of the rejected policy")
+	assert.Equal(t, []string{"GET /deny-policies", "DELETE /deny-policies/dp-1", "POST /deny-policies",
+		"POST /deny-policies"}, recorder.requests)
+	restored := recorder.bodies["POST /deny-policies"]
+	assert.Equal(t, true, restored["conditionStatus"], "Should restore the deleted policy")
+	assert.NotContains(t, restored, "conditionId", "Should not send the ID of the deleted policy")
+}
+
+func TestExportDenyPolicyWithoutId(t *testing.T) {
+	newAdminResourcesTestServer(t)
+
+	resource, err := ExportAdminResourceFromEnv("token", adminResourcesTestEnv, AdminResourceTypeDenyPolicy,
+		"/pizzashack/1.0.0")
+	assert.Nil(t, err)
+	assert.NotContains(t, string(resource.Data), "dp-1", "The ID should not be exported")
+}
+
+func TestImportTenantConfig(t *testing.T) {
+	recorder := newAdminResourcesTestServer(t)
+	path := writeTestAdminResource(t, "tenant config", "  EnableMonetization: true\n")
+
+	err := ImportAdminResourceToEnv("token", adminResourcesTestEnv, AdminResourceTypeTenantConfig, path, false)
+	assert.Nil(t, err)
+	assert.Equal(t, true, recorder.bodies["PUT /tenant-config"]["EnableMonetization"])
+}
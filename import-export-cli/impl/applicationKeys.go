@@ -0,0 +1,231 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// RegenerateConsumerSecret regenerates the consumer secret of the OAuth keys of an application in the DevPortal. The
+// access tokens issued with the previous secret remain valid until they expire or are revoked.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @param keyType : Type of the keys, which is PRODUCTION or SANDBOX
+// @param keyManager : Key manager of the keys
+// @return consumer key and the regenerated consumer secret
+// @return error
+func RegenerateConsumerSecret(accessToken, environment, appName, keyType,
+	keyManager string) (*utils.ConsumerSecretRegenResponse, error) {
+	appId, err := getDevPortalAppId(accessToken, environment, appName)
+	if err != nil {
+		return nil, err
+	}
+	key, err := getOAuthKey(accessToken, environment, appId, keyType, keyManager)
+	if err != nil {
+		return nil, err
+	}
+
+	regenerateEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId + "/oauth-keys/" + key.KeyMappingID + "/regenerate-secret"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokePOSTRequestWithoutBody(regenerateEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getDevPortalResponseError(resp, "regenerate the consumer secret of "+appName)
+	}
+	regenerated := &utils.ConsumerSecretRegenResponse{}
+	err = json.Unmarshal(resp.Body(), regenerated)
+	return regenerated, err
+}
+
+// RevokeApplicationToken revokes an access token issued to the OAuth keys of an application
+// @param environment : Environment of the application
+// @param consumerKey : Consumer key of the application
+// @param consumerSecret : Consumer secret of the application
+// @param token : Access token to be revoked
+// @return error
+func RevokeApplicationToken(environment, consumerKey, consumerSecret, token string) error {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " +
+		utils.GetBase64EncodedCredentials(consumerKey, consumerSecret)
+	headers[utils.HeaderContentType] = utils.HeaderValueXWWWFormUrlEncoded
+	body := url.Values{}
+	body.Set("token", token)
+	body.Set("token_type_hint", "access_token")
+	resp, err := utils.InvokePOSTRequest(utils.GetTokenRevokeEndpoint(environment, utils.MainConfigFilePath), headers,
+		body.Encode())
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getDevPortalResponseError(resp, "revoke the access token")
+	}
+	return nil
+}
+
+// RevokeApplicationTokenOfApp revokes an access token issued to the OAuth keys of an application, which are retrieved
+// from the DevPortal
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @param keyType : Type of the keys, which is PRODUCTION or SANDBOX
+// @param keyManager : Key manager of the keys
+// @param token : Access token to be revoked
+// @return error
+func RevokeApplicationTokenOfApp(accessToken, environment, appName, keyType, keyManager, token string) error {
+	appId, err := getDevPortalAppId(accessToken, environment, appName)
+	if err != nil {
+		return err
+	}
+	key, err := getOAuthKey(accessToken, environment, appId, keyType, keyManager)
+	if err != nil {
+		return err
+	}
+	if key.ConsumerSecret == "" {
+		return errors.New("the DevPortal does not return the consumer secret of the " + strings.ToLower(keyType) +
+			" keys of " + appName)
+	}
+	return RevokeApplicationToken(environment, key.ConsumerKey, key.ConsumerSecret, token)
+}
+
+// MapApplicationKeys maps the OAuth keys created out of band in a key manager to an application in the DevPortal
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @param keyType : Type of the keys, which is PRODUCTION or SANDBOX
+// @param keyManager : Key manager where the keys are created
+// @param consumerKey : Consumer key of the OAuth client
+// @param consumerSecret : Consumer secret of the OAuth client
+// @return mapped keys
+// @return error
+func MapApplicationKeys(accessToken, environment, appName, keyType, keyManager, consumerKey,
+	consumerSecret string) (*utils.OAuthKey, error) {
+	appId, err := getDevPortalAppId(accessToken, environment, appName)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(utils.KeyMappingRequest{
+		ConsumerKey:    consumerKey,
+		ConsumerSecret: consumerSecret,
+		KeyType:        strings.ToUpper(keyType),
+		KeyManager:     keyManager,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mapKeysEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId + "/map-keys"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePOSTRequest(mapKeysEndpoint, headers, string(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+		return nil, getDevPortalResponseError(resp, "map the keys to "+appName)
+	}
+	mapped := &utils.OAuthKey{}
+	err = json.Unmarshal(resp.Body(), mapped)
+	return mapped, err
+}
+
+// SetApplicationThrottlingPolicy changes the throttling policy of an application in the DevPortal. The other details
+// of the application are sent back as they are.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the application
+// @param appName : Name of the application
+// @param throttlingPolicy : Application throttling policy
+// @return error
+func SetApplicationThrottlingPolicy(accessToken, environment, appName, throttlingPolicy string) error {
+	appId, err := getDevPortalAppId(accessToken, environment, appName)
+	if err != nil {
+		return err
+	}
+	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(applicationEndpoint, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getDevPortalResponseError(resp, "retrieve the application "+appName)
+	}
+	application := make(map[string]interface{})
+	if err := json.Unmarshal(resp.Body(), &application); err != nil {
+		return err
+	}
+	application["throttlingPolicy"] = throttlingPolicy
+	body, err := json.Marshal(application)
+	if err != nil {
+		return err
+	}
+
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err = utils.InvokePutRequest(nil, applicationEndpoint, headers, string(body))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getDevPortalResponseError(resp, "update the throttling policy of "+appName)
+	}
+	return nil
+}
+
+// getOAuthKey returns the OAuth keys of an application of the given type, generated in or mapped from the key manager
+func getOAuthKey(accessToken, environment, appId, keyType, keyManager string) (*utils.OAuthKey, error) {
+	oauthKeysEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
+		"/" + appId + "/oauth-keys"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequest(oauthKeysEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getDevPortalResponseError(resp, "retrieve the keys of the application "+appId)
+	}
+	keyList := &utils.OAuthKeyList{}
+	if err := json.Unmarshal(resp.Body(), keyList); err != nil {
+		return nil, err
+	}
+	for i, key := range keyList.List {
+		if strings.EqualFold(key.KeyType, keyType) && (keyManager == "" || key.KeyManager == keyManager) {
+			return &keyList.List[i], nil
+		}
+	}
+	if keyManager == "" {
+		return nil, errors.New("the application has no " + strings.ToLower(keyType) + " keys")
+	}
+	return nil, errors.New("the application has no " + strings.ToLower(keyType) + " keys of the key manager " +
+		keyManager)
+}
@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestRevokeApplicationTokenOfApp(t *testing.T) {
+	revoked := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/applications"):
+			w.Write([]byte(`{"count": 1, "list": [{"applicationId": "app-1", "name": "SampleApp"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/applications/app-1/oauth-keys"):
+			w.Write([]byte(`{"count": 2, "list": [
+				{"keyType": "SANDBOX", "keyManager": "Resident Key Manager", "consumerKey": "sandbox-key",
+					"consumerSecret": "sandbox-secret"},
+				{"keyType": "PRODUCTION", "keyManager": "Resident Key Manager", "consumerKey": "key",
+					"consumerSecret": "secret"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/revoke"):
+			consumerKey, consumerSecret, _ := r.BasicAuth()
+			assert.Equal(t, "key", consumerKey)
+			assert.Equal(t, "secret", consumerSecret)
+			assert.Nil(t, r.ParseForm())
+			assert.Equal(t, "access-token", r.PostForm.Get("token"))
+			revoked = true
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	setTestEnvironment(t, subscriptionsTestEnv, server.URL)
+
+	err := RevokeApplicationTokenOfApp("token", subscriptionsTestEnv, "SampleApp", utils.ProductionKeyType,
+		utils.DefaultKeyManager, "access-token")
+	assert.Nil(t, err)
+	assert.True(t, revoked, "Should revoke the token with the production keys of the application")
+
+	err = RevokeApplicationTokenOfApp("token", subscriptionsTestEnv, "SampleApp", utils.ProductionKeyType,
+		"Keycloak", "access-token")
+	assert.EqualError(t, err, "the application has no production keys of the key manager Keycloak")
+}
@@ -0,0 +1,449 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	envCheckCheckHeader   = "CHECK"
+	envCheckTargetHeader  = "TARGET"
+	envCheckStatusHeader  = "STATUS"
+	envCheckMessageHeader = "MESSAGE"
+
+	defaultEnvCheckTableFormat = "table {{.Check}}\t{{.Target}}\t{{.Status}}\t{{.Message}}"
+
+	// EnvCheckJsonFormatType prints the whole report as a JSON document
+	EnvCheckJsonFormatType = "json"
+)
+
+// Statuses of the checks of an environment
+const (
+	EnvCheckStatusPass = "PASS"
+	EnvCheckStatusWarn = "WARN"
+	EnvCheckStatusFail = "FAIL"
+	EnvCheckStatusSkip = "SKIP"
+)
+
+// Checks run against an environment
+const (
+	envCheckReachability = "reachability"
+	envCheckTLS          = "tls"
+	envCheckRegistration = "client-registration"
+	envCheckToken        = "token"
+	envCheckVersion      = "api-version"
+)
+
+// envCheckRESTAPIVersions are the versions of the REST APIs of API Manager 4.5.0, which the CLI is built for. A
+// server serving an older minor version of a REST API is reported as a warning, and another major version fails.
+var envCheckRESTAPIVersions = map[string]string{
+	"publisher": "v4.5",
+	"devportal": "v3.5",
+	"admin":     "v4.5",
+}
+
+// EnvCertificateExpiryWarningPeriod is the period before the expiry of a certificate in which the TLS check warns
+var EnvCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
+// EnvCheckResult is the result of a check of an environment
+type EnvCheckResult struct {
+	Check   string `json:"check"`
+	Target  string `json:"target"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// EnvCheckReport is the result of all the checks of an environment. The status of the report is FAIL if any of the
+// checks failed, WARN if any of them warned and PASS otherwise.
+type EnvCheckReport struct {
+	Environment string           `json:"environment"`
+	Status      string           `json:"status"`
+	Results     []EnvCheckResult `json:"checks"`
+}
+
+// envCheckEndpoint is an endpoint of an environment
+type envCheckEndpoint struct {
+	name string
+	url  string
+}
+
+// add adds the result of a check to the report and updates the status of the report
+func (report *EnvCheckReport) add(check, target, status, message string) {
+	report.Results = append(report.Results, EnvCheckResult{Check: check, Target: target, Status: status,
+		Message: message})
+	if status == EnvCheckStatusFail || (status == EnvCheckStatusWarn && report.Status != EnvCheckStatusFail) {
+		report.Status = status
+	}
+}
+
+// CheckEnv checks the health of an environment and its compatibility with the CLI. It checks the reachability and
+// the TLS certificates of the endpoints of the environment, the client registration and the token acquisition with
+// the stored credentials and the versions of the REST APIs served by the server.
+// @param env : Environment to be checked
+// @param credential : Stored credentials of the environment, or nil if the user has not logged in
+// @param cliVersion : Version of the CLI
+// @param registerClient : Register the DCR client of the user, instead of only checking the registration endpoint
+// @return report of the checks
+// @return error
+func CheckEnv(env string, credential *credentials.Credential, cliVersion string,
+	registerClient bool) (*EnvCheckReport, error) {
+	envEndpoints, err := utils.GetEndpointsOfEnvironment(env, utils.MainConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := utils.GetTLSConfigOfEnv(&envEndpoints.TransportSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &EnvCheckReport{Environment: env, Status: EnvCheckStatusPass}
+	endpoints := []envCheckEndpoint{
+		{"apim", envEndpoints.ApiManagerEndpoint},
+		{"publisher", envEndpoints.PublisherEndpoint},
+		{"devportal", envEndpoints.DevPortalEndpoint},
+		{"admin", envEndpoints.AdminEndpoint},
+		{"token", envEndpoints.TokenEndpoint},
+		{"registration", envEndpoints.RegistrationEndpoint},
+		{"mi", envEndpoints.MiManagementEndpoint},
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.url == "" {
+			report.add(envCheckReachability, endpoint.name, EnvCheckStatusSkip, "endpoint is not configured")
+			continue
+		}
+		checkEndpointReachability(report, endpoint)
+		checkEndpointTLS(report, endpoint, tlsConfig)
+	}
+
+	if !utils.APIMExistsInEnv(env, utils.MainConfigFilePath) {
+		report.add(envCheckToken, env, EnvCheckStatusSkip, "API Manager is not configured in the environment")
+		return report, nil
+	}
+
+	accessToken := checkCredentials(report, env, credential, registerClient)
+	checkAPIVersion(report, "publisher", utils.GetPublisherEndpointOfEnv(env, utils.MainConfigFilePath),
+		accessToken, cliVersion)
+	checkAPIVersion(report, "devportal", strings.TrimSuffix(utils.GetDevPortalApiListEndpointOfEnv(env,
+		utils.MainConfigFilePath), "/apis"), accessToken, cliVersion)
+	checkAPIVersion(report, "admin", utils.GetAdminEndpointOfEnv(env, utils.MainConfigFilePath), accessToken,
+		cliVersion)
+	return report, nil
+}
+
+// checkEndpointReachability sends a request to the endpoint. Any HTTP response passes the check.
+func checkEndpointReachability(report *EnvCheckReport, endpoint envCheckEndpoint) {
+	utils.Logln(utils.LogPrefixInfo + "connecting to " + endpoint.url)
+	resp, err := utils.InvokeGETRequest(endpoint.url, nil)
+	if err != nil {
+		report.add(envCheckReachability, endpoint.name, EnvCheckStatusFail, err.Error())
+		return
+	}
+	report.add(envCheckReachability, endpoint.name, EnvCheckStatusPass, endpoint.url+" responded with "+
+		resp.Status())
+}
+
+// checkEndpointTLS connects to a HTTPS endpoint and checks the validity and the expiry of its certificate
+func checkEndpointTLS(report *EnvCheckReport, endpoint envCheckEndpoint, tlsConfig *tls.Config) {
+	endpointURL, err := url.Parse(endpoint.url)
+	if err != nil {
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusFail, err.Error())
+		return
+	}
+	if endpointURL.Scheme != "https" {
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusSkip, "endpoint does not use HTTPS")
+		return
+	}
+	address := endpointURL.Host
+	if endpointURL.Port() == "" {
+		address = net.JoinHostPort(endpointURL.Hostname(), "443")
+	}
+
+	config := tlsConfig.Clone()
+	config.ServerName = endpointURL.Hostname()
+	dialer := &net.Dialer{Timeout: time.Duration(utils.HttpRequestTimeout) * time.Millisecond}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, config)
+	if err != nil {
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusFail, err.Error())
+		return
+	}
+	defer conn.Close()
+
+	certificates := conn.ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusFail, "server did not present a certificate")
+		return
+	}
+	certificate := certificates[0]
+	expiry := certificate.NotAfter.Format(time.RFC3339)
+	remaining := time.Until(certificate.NotAfter)
+	switch {
+	case remaining <= 0:
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusFail, "certificate expired at "+expiry)
+	case time.Now().Before(certificate.NotBefore):
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusFail, "certificate is not valid before "+
+			certificate.NotBefore.Format(time.RFC3339))
+	case config.InsecureSkipVerify:
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusWarn, "certificate is not verified as --insecure "+
+			"is set, expires at "+expiry)
+	case remaining < EnvCertificateExpiryWarningPeriod:
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusWarn, fmt.Sprintf("certificate expires in %d days "+
+			"at %s", int(remaining.Hours()/24), expiry))
+	default:
+		report.add(envCheckTLS, endpoint.name, EnvCheckStatusPass, "certificate is valid until "+expiry)
+	}
+}
+
+// checkCredentials checks the client registration and gets an access token with the stored credentials. The DCR
+// client of the user is registered, as the login does, only if registerClient is true. Otherwise the registration
+// endpoint is only checked to accept the credentials.
+// @return access token, or blank if it could not be acquired
+func checkCredentials(report *EnvCheckReport, env string, credential *credentials.Credential,
+	registerClient bool) string {
+	if credential == nil {
+		report.add(envCheckRegistration, env, EnvCheckStatusSkip, "not logged in to the environment")
+		report.add(envCheckToken, env, EnvCheckStatusSkip, "not logged in to the environment")
+		return ""
+	}
+
+	registrationEndpoint := utils.GetRegistrationEndpointOfEnv(env, utils.MainConfigFilePath)
+	if credential.Password == "" {
+		report.add(envCheckRegistration, env, EnvCheckStatusSkip, "credentials of the SSO or the personal "+
+			"access token login do not use the client registration")
+	} else if registerClient {
+		clientName := utils.GetRegisteredClientName(credential.Username)
+		if _, _, err := utils.RegisterClient(credential.Username, credential.Password,
+			registrationEndpoint); err != nil {
+			report.add(envCheckRegistration, env, EnvCheckStatusFail, "registering the DCR client "+clientName+
+				" failed: "+err.Error())
+		} else {
+			report.add(envCheckRegistration, env, EnvCheckStatusPass, "registered the DCR client "+clientName+
+				" as "+credential.Username+", or reused it if it was already registered")
+		}
+	} else {
+		checkRegistrationEndpoint(report, env, registrationEndpoint, credential)
+	}
+
+	accessToken, err := credentials.GetOAuthAccessToken(*credential, env)
+	if err != nil {
+		report.add(envCheckToken, env, EnvCheckStatusFail, err.Error())
+		return ""
+	}
+	report.add(envCheckToken, env, EnvCheckStatusPass, "acquired an access token as "+credential.Username)
+	return accessToken
+}
+
+// checkRegistrationEndpoint sends a request to the registration endpoint with the credentials of the user, which
+// does not register a client. The check fails only if the endpoint can not be reached or rejects the credentials.
+func checkRegistrationEndpoint(report *EnvCheckReport, env, registrationEndpoint string,
+	credential *credentials.Credential) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " +
+		utils.GetBase64EncodedCredentials(credential.Username, credential.Password)
+	utils.Logln(utils.LogPrefixInfo + "connecting to " + registrationEndpoint)
+	resp, err := utils.InvokeGETRequest(registrationEndpoint, headers)
+	if err != nil {
+		report.add(envCheckRegistration, env, EnvCheckStatusFail, err.Error())
+		return
+	}
+	switch resp.StatusCode() {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		report.add(envCheckRegistration, env, EnvCheckStatusFail, registrationEndpoint+" rejected the "+
+			"credentials of "+credential.Username+" with "+resp.Status())
+	default:
+		report.add(envCheckRegistration, env, EnvCheckStatusPass, registrationEndpoint+" responded with "+
+			resp.Status()+" to "+credential.Username+". Use --register-client to register the DCR client")
+	}
+}
+
+// checkAPIVersion checks whether the version of a REST API served by the server is supported by the CLI. The version
+// is read from the OpenAPI definition of the REST API, and compared with the version of API Manager the CLI is
+// built for. The definition is read without an access token if there is none.
+func checkAPIVersion(report *EnvCheckReport, restAPI, restAPIEndpoint, accessToken, cliVersion string) {
+	apiVersion := path.Base(restAPIEndpoint)
+	target := restAPI + " " + apiVersion
+	headers := make(map[string]string)
+	if accessToken != "" {
+		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	}
+
+	definitionEndpoint := utils.AppendSlashToString(restAPIEndpoint) + "swagger.yaml"
+	utils.Logln(utils.LogPrefixInfo + "connecting to " + definitionEndpoint)
+	resp, err := utils.InvokeGETRequest(definitionEndpoint, headers)
+	if err != nil {
+		report.add(envCheckVersion, target, EnvCheckStatusFail, err.Error())
+		return
+	}
+	switch {
+	case resp.StatusCode() == http.StatusUnauthorized && accessToken == "":
+		report.add(envCheckVersion, target, EnvCheckStatusSkip, "an access token is required")
+		return
+	case resp.StatusCode() == http.StatusNotFound:
+		report.add(envCheckVersion, target, EnvCheckStatusFail, fmt.Sprintf("server does not support the %s REST "+
+			"API %s used by %s %s", restAPI, apiVersion, utils.ProjectName, cliVersion))
+		return
+	case resp.StatusCode() != http.StatusOK:
+		report.add(envCheckVersion, target, EnvCheckStatusFail, definitionEndpoint+" responded with "+
+			resp.Status())
+		return
+	}
+
+	definition := struct {
+		Info struct {
+			Version string `yaml:"version"`
+		} `yaml:"info"`
+	}{}
+	if err := yaml.Unmarshal(resp.Body(), &definition); err != nil || definition.Info.Version == "" {
+		report.add(envCheckVersion, target, EnvCheckStatusFail, "cannot read the version of the "+restAPI+
+			" REST API from "+definitionEndpoint)
+		return
+	}
+	serverVersion := definition.Info.Version
+	serverMajor, serverMinor, serverParsed := parseRESTAPIVersion(serverVersion)
+	cliMajor, cliMinor, _ := parseRESTAPIVersion(envCheckRESTAPIVersions[restAPI])
+	switch {
+	case !serverParsed || serverMajor != cliMajor:
+		report.add(envCheckVersion, target, EnvCheckStatusFail, fmt.Sprintf("server serves the %s REST API %s, "+
+			"but %s %s uses %s", restAPI, serverVersion, utils.ProjectName, cliVersion,
+			envCheckRESTAPIVersions[restAPI]))
+	case serverMinor < cliMinor:
+		report.add(envCheckVersion, target, EnvCheckStatusWarn, fmt.Sprintf("server serves the %s REST API %s, "+
+			"which is older than %s used by %s %s. Some of the commands may not be supported", restAPI,
+			serverVersion, envCheckRESTAPIVersions[restAPI], utils.ProjectName, cliVersion))
+	default:
+		report.add(envCheckVersion, target, EnvCheckStatusPass, fmt.Sprintf("server serves the %s REST API %s, "+
+			"which supports %s used by %s %s", restAPI, serverVersion, envCheckRESTAPIVersions[restAPI],
+			utils.ProjectName, cliVersion))
+	}
+}
+
+// parseRESTAPIVersion parses a version of a REST API such as v4.5 into its major and minor versions. A missing minor
+// version is parsed as 0.
+// @return major version
+// @return minor version
+// @return true if the version could be parsed
+func parseRESTAPIVersion(version string) (int, int, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor := 0
+	if len(parts) > 1 {
+		if minor, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0, false
+		}
+	}
+	return major, minor, true
+}
+
+// envCheckResult contains information about a check of an environment
+type envCheckResult struct {
+	check   string
+	target  string
+	status  string
+	message string
+}
+
+// Check is the name of the check
+func (r envCheckResult) Check() string {
+	return r.check
+}
+
+// Target is the endpoint or the REST API checked
+func (r envCheckResult) Target() string {
+	return r.target
+}
+
+// Status of the check
+func (r envCheckResult) Status() string {
+	return r.status
+}
+
+// Message describing the result of the check
+func (r envCheckResult) Message() string {
+	return r.message
+}
+
+// MarshalJSON returns marshaled methods
+func (r *envCheckResult) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(r)
+}
+
+// PrintEnvCheckReport prints the results of the checks of an environment as a table, or the whole report as JSON if
+// the format is json
+func PrintEnvCheckReport(report *EnvCheckReport, format string) {
+	if format == EnvCheckJsonFormatType {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Println("Error executing template:", err.Error())
+			return
+		}
+		fmt.Println(string(data))
+		return
+	}
+	if format == "" {
+		format = defaultEnvCheckTableFormat
+	}
+
+	// create env check context with standard output
+	envCheckContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection of results
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, r := range report.Results {
+			if err := t.Execute(w, &envCheckResult{r.Check, r.Target, r.Status, r.Message}); err != nil {
+				return err
+			}
+			// write a new line after executing template
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	envCheckTableHeaders := map[string]string{
+		"Check":   envCheckCheckHeader,
+		"Target":  envCheckTargetHeader,
+		"Status":  envCheckStatusHeader,
+		"Message": envCheckMessageHeader,
+	}
+
+	// execute context
+	if err := envCheckContext.Write(renderer, envCheckTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -0,0 +1,184 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const envCheckTestEnv = "env-check-test"
+
+// getEnvCheckResult returns the result of a check of the given target in the report
+func getEnvCheckResult(t *testing.T, report *EnvCheckReport, check, target string) EnvCheckResult {
+	for _, result := range report.Results {
+		if result.Check == check && result.Target == target {
+			return result
+		}
+	}
+	t.Fatalf("result of the check %s of %s is missing", check, target)
+	return EnvCheckResult{}
+}
+
+func TestCheckEnvWithoutCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/am/devportal/v3/swagger.yaml":
+			assert.Empty(t, r.Header.Get(utils.HeaderAuthorization))
+			w.Write([]byte("info:\n  version: v3.5\n"))
+		case "/api/am/publisher/v4/swagger.yaml", "/api/am/admin/v4/swagger.yaml":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	setTestEnvironment(t, envCheckTestEnv, server.URL)
+
+	report, err := CheckEnv(envCheckTestEnv, nil, "v1.0.0", false)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvCheckStatusPass, report.Status)
+	assert.Equal(t, EnvCheckStatusPass, getEnvCheckResult(t, report, envCheckReachability, "apim").Status)
+	assert.Equal(t, EnvCheckStatusSkip, getEnvCheckResult(t, report, envCheckTLS, "apim").Status)
+	assert.Equal(t, EnvCheckStatusSkip, getEnvCheckResult(t, report, envCheckReachability, "mi").Status)
+	assert.Equal(t, EnvCheckStatusSkip, getEnvCheckResult(t, report, envCheckToken, envCheckTestEnv).Status)
+	assert.Equal(t, EnvCheckStatusPass, getEnvCheckResult(t, report, envCheckVersion, "devportal v3").Status)
+	assert.Equal(t, EnvCheckStatusSkip, getEnvCheckResult(t, report, envCheckVersion, "publisher v4").Status)
+}
+
+func TestCheckEnvWithPersonalAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/am/publisher/v4/swagger.yaml":
+			assert.Equal(t, "Bearer pat", r.Header.Get(utils.HeaderAuthorization))
+			w.Write([]byte("info:\n  version: v4.5\n"))
+		case "/api/am/devportal/v3/swagger.yaml":
+			w.Write([]byte("info:\n  version: v3.6\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	setTestEnvironment(t, envCheckTestEnv, server.URL)
+
+	report, err := CheckEnv(envCheckTestEnv, &credentials.Credential{Username: "admin", PersonalAccessToken: "pat"},
+		"v1.0.0", false)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvCheckStatusFail, report.Status)
+	assert.Equal(t, EnvCheckStatusSkip, getEnvCheckResult(t, report, envCheckRegistration, envCheckTestEnv).Status)
+	assert.Equal(t, EnvCheckStatusPass, getEnvCheckResult(t, report, envCheckToken, envCheckTestEnv).Status)
+	assert.Equal(t, EnvCheckStatusPass, getEnvCheckResult(t, report, envCheckVersion, "publisher v4").Status)
+	assert.Equal(t, EnvCheckStatusPass, getEnvCheckResult(t, report, envCheckVersion, "devportal v3").Status,
+		"A newer minor version should be supported")
+	assert.Equal(t, EnvCheckStatusFail, getEnvCheckResult(t, report, envCheckVersion, "admin v4").Status)
+}
+
+func TestCheckEnvWithUnsupportedAPIVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/am/publisher/v4/swagger.yaml":
+			w.Write([]byte("info:\n  version: v4.2\n"))
+		case "/api/am/devportal/v3/swagger.yaml":
+			w.Write([]byte("info:\n  version: v2.5\n"))
+		case "/api/am/admin/v4/swagger.yaml":
+			w.Write([]byte("openapi: 3.0.1\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	setTestEnvironment(t, envCheckTestEnv, server.URL)
+
+	report, err := CheckEnv(envCheckTestEnv, &credentials.Credential{Username: "admin", PersonalAccessToken: "pat"},
+		"v1.0.0", false)
+	assert.Nil(t, err)
+	publisher := getEnvCheckResult(t, report, envCheckVersion, "publisher v4")
+	assert.Equal(t, EnvCheckStatusWarn, publisher.Status, "An older minor version should warn")
+	assert.Contains(t, publisher.Message, "v4.2")
+	assert.Equal(t, EnvCheckStatusFail, getEnvCheckResult(t, report, envCheckVersion, "devportal v3").Status,
+		"Another major version should fail")
+	assert.Equal(t, EnvCheckStatusFail, getEnvCheckResult(t, report, envCheckVersion, "admin v4").Status,
+		"A definition without a version should fail")
+}
+
+func TestCheckEnvWithUnreachableEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	serverURL := server.URL
+	server.Close()
+	setTestEnvironment(t, envCheckTestEnv, serverURL)
+
+	report, err := CheckEnv(envCheckTestEnv, nil, "v1.0.0", false)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvCheckStatusFail, report.Status)
+	assert.Equal(t, EnvCheckStatusFail, getEnvCheckResult(t, report, envCheckReachability, "apim").Status)
+	assert.Equal(t, EnvCheckStatusFail, getEnvCheckResult(t, report, envCheckVersion, "devportal v3").Status)
+}
+
+func TestCheckEnvWithUnreachableRegistrationEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	serverURL := server.URL
+	server.Close()
+	setTestEnvironment(t, envCheckTestEnv, serverURL)
+
+	report, err := CheckEnv(envCheckTestEnv, &credentials.Credential{Username: "admin", Password: "admin"},
+		"v1.0.0", true)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvCheckStatusFail, report.Status)
+	registration := getEnvCheckResult(t, report, envCheckRegistration, envCheckTestEnv)
+	assert.Equal(t, EnvCheckStatusFail, registration.Status, "A failed connection should fail the check")
+	assert.Contains(t, registration.Message, "rest_api_import_export_admin")
+	assert.Equal(t, EnvCheckStatusFail, getEnvCheckResult(t, report, envCheckToken, envCheckTestEnv).Status)
+}
+
+func TestCheckEnvWithoutRegisteringClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/client-registration/v0.17/register" {
+			assert.Equal(t, http.MethodGet, r.Method, "The client should not be registered")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	setTestEnvironment(t, envCheckTestEnv, server.URL)
+
+	report, err := CheckEnv(envCheckTestEnv, &credentials.Credential{Username: "admin", Password: "wrong"},
+		"v1.0.0", false)
+	assert.Nil(t, err)
+	registration := getEnvCheckResult(t, report, envCheckRegistration, envCheckTestEnv)
+	assert.Equal(t, EnvCheckStatusFail, registration.Status, "Rejected credentials should fail the check")
+	assert.Contains(t, registration.Message, "rejected the credentials of admin")
+}
+
+func TestCheckEnvTLSWithInsecure(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	setTestEnvironment(t, envCheckTestEnv, server.URL)
+	utils.Insecure = true
+	defer func() { utils.Insecure = false }()
+
+	report, err := CheckEnv(envCheckTestEnv, nil, "v1.0.0", false)
+	assert.Nil(t, err)
+	assert.Equal(t, EnvCheckStatusWarn, getEnvCheckResult(t, report, envCheckTLS, "apim").Status)
+}
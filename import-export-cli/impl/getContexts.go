@@ -0,0 +1,138 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	contextsCurrentHeader     = "CURRENT"
+	contextsNameHeader        = "NAME"
+	contextsEnvironmentHeader = "ENVIRONMENT"
+	contextsUserHeader        = "USER"
+	contextsTenantHeader      = "TENANT"
+	contextsOutputHeader      = "OUTPUT"
+)
+
+// contextInfo contains information about a context of the CLI
+type contextInfo struct {
+	current     bool
+	name        string
+	environment string
+	user        string
+	tenant      string
+	output      string
+}
+
+// Current returns * for the current context
+func (c contextInfo) Current() string {
+	if c.current {
+		return "*"
+	}
+	return ""
+}
+
+// Name of the context
+func (c contextInfo) Name() string {
+	return c.name
+}
+
+// Environment of the context
+func (c contextInfo) Environment() string {
+	return c.environment
+}
+
+// User of the context
+func (c contextInfo) User() string {
+	return c.user
+}
+
+// Tenant of the context
+func (c contextInfo) Tenant() string {
+	return c.tenant
+}
+
+// Output format of the context
+func (c contextInfo) Output() string {
+	return c.output
+}
+
+// MarshalJSON returns marshaled methods
+func (c *contextInfo) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(c)
+}
+
+// PrintContexts prints the contexts sorted by their names, marking the current context
+func PrintContexts(contexts map[string]utils.Context, currentContext, format, defaultContextsTableFormat string) {
+	if format == "" {
+		format = defaultContextsTableFormat
+	}
+
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// create contexts context with standard output
+	contextsContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, name := range names {
+			c := contexts[name]
+			info := &contextInfo{
+				current:     name == currentContext,
+				name:        name,
+				environment: c.Environment,
+				user:        c.User,
+				tenant:      c.Tenant,
+				output:      c.Output,
+			}
+			if err := t.Execute(w, info); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	contextsTableHeaders := map[string]string{
+		"Current":     contextsCurrentHeader,
+		"Name":        contextsNameHeader,
+		"Environment": contextsEnvironmentHeader,
+		"User":        contextsUserHeader,
+		"Tenant":      contextsTenantHeader,
+		"Output":      contextsOutputHeader,
+	}
+
+	// execute context
+	if err := contextsContext.Write(renderer, contextsTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
@@ -32,6 +32,7 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/overlay"
 	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
 
 	v2 "github.com/wso2/product-apim-tooling/import-export-cli/specs/v2"
@@ -254,7 +255,7 @@ func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPat
 
 // envParamsFileProcess function is used to process the environment parameters when they are provided as a file
 func envParamsFileProcess(importPath, paramsPath, importEnvironment string) error {
-	apiParams, err := params.LoadApiParamsFromFile(paramsPath)
+	apiParams, err := params.LoadApiParamsFromFile(paramsPath, importEnvironment)
 	if err != nil {
 		return err
 	}
@@ -263,6 +264,10 @@ func envParamsFileProcess(importPath, paramsPath, importEnvironment string) erro
 	if envParams == nil {
 		return errors.New("Environment '" + importEnvironment + "' does not exist in " + paramsPath)
 	} else {
+		err = applyEnvOverlays(importPath, envParams)
+		if err != nil {
+			return err
+		}
 
 		// Create a source directory and add source content to it and then zip it
 		sourceFilePath := filepath.Join(importPath, "SourceArchive")
@@ -291,7 +296,7 @@ func envParamsFileProcess(importPath, paramsPath, importEnvironment string) erro
 // envParamsDirectoryProcess function is used to process the environment parameters when they are provided as a
 // directory
 func envParamsDirectoryProcess(importPath, paramsPath, importEnvironment string) error {
-	apiParams, err := params.LoadApiParamsFromDirectory(paramsPath)
+	apiParams, err := params.LoadApiParamsFromDirectory(paramsPath, importEnvironment)
 	if err != nil {
 		return err
 	}
@@ -300,6 +305,10 @@ func envParamsDirectoryProcess(importPath, paramsPath, importEnvironment string)
 	if envParams == nil {
 		return errors.New("Environment '" + importEnvironment + "' does not exist in " + paramsPath)
 	} else {
+		err = applyEnvOverlays(importPath, envParams)
+		if err != nil {
+			return err
+		}
 
 		// Create a source directory and add source content to it and then zip it
 		sourceFilePath := filepath.Join(importPath, "SourceArchive")
@@ -359,10 +368,25 @@ func handleCustomizedParameters(importPath, paramsPath, importEnvironment string
 	return nil
 }
 
+// applyEnvOverlays applies the OpenAPI overlays of the environment to the definition of the API project in importPath
+func applyEnvOverlays(importPath string, environmentParams *params.Environment) error {
+	if len(environmentParams.Overlays) == 0 {
+		return nil
+	}
+	definitionPath := filepath.Join(importPath, utils.InitProjectDefinitionsSwagger)
+	if !utils.IsFileExist(definitionPath) {
+		return errors.New("overlays are given in the parameters, but the API does not have an OpenAPI definition " +
+			"in " + utils.InitProjectDefinitionsSwagger)
+	}
+	utils.Logln(utils.LogPrefixInfo+"Applying the overlays", environmentParams.Overlays, "to", definitionPath)
+	return overlay.ApplyToFile(definitionPath, environmentParams.Overlays)
+}
+
 // Process env params and create the intermediate_params.yaml file to pass to the server
 func handleEnvParams(tempDirectory string, destDirectory string, environmentParams *params.Environment) error {
-	// read api params from external parameters file
-	if len(environmentParams.Config) == 0 {
+	// read api params from external parameters file. An environment may only have overlays, which are already applied
+	// to the definition.
+	if len(environmentParams.Config) == 0 && len(environmentParams.Overlays) == 0 {
 		return errors.New("configs value is empty in the provided parameters")
 	}
 
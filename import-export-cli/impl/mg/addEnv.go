@@ -38,9 +38,15 @@ func AddEnv(envName string, mgwEndpoints *utils.MgwEndpoints) error {
 		return errors.New("MgwAdapter Environment '" + envName + "' already exists in " + mainConfigFilePath)
 	}
 
+	if err := utils.ValidateTransportSettings(mgwEndpoints.TransportSettings); err != nil {
+		return err
+	}
+
 	mainConfig := utils.GetMainConfigFromFile(mainConfigFilePath)
 
-	var validatedMgwEndpoints = utils.MgwEndpoints{}
+	var validatedMgwEndpoints = utils.MgwEndpoints{
+		TransportSettings: mgwEndpoints.TransportSettings,
+	}
 	if mgwEndpoints.AdapterEndpoint == "" {
 		return errors.New("Adapter url cannot be blank")
 	} else {
@@ -0,0 +1,144 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	pluginNameHeader = "NAME"
+	pluginPathHeader = "PATH"
+)
+
+// pluginInfo contains information about a plugin of the CLI
+type pluginInfo struct {
+	name string
+	path string
+}
+
+// Name of the plugin
+func (p pluginInfo) Name() string {
+	return p.name
+}
+
+// Path of the plugin executable
+func (p pluginInfo) Path() string {
+	return p.path
+}
+
+// MarshalJSON returns marshaled methods
+func (p *pluginInfo) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(p)
+}
+
+// PrintPlugins prints the plugins, and warns about the executables shadowed by the plugins of the same name
+func PrintPlugins(plugins []utils.Plugin, format, defaultPluginsTableFormat string) {
+	if format == "" {
+		format = defaultPluginsTableFormat
+	}
+
+	// create plugins context with standard output
+	pluginsContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, plugin := range plugins {
+			if err := t.Execute(w, &pluginInfo{name: plugin.Name, path: plugin.Path}); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	pluginsTableHeaders := map[string]string{
+		"Name": pluginNameHeader,
+		"Path": pluginPathHeader,
+	}
+
+	// execute context
+	if err := pluginsContext.Write(renderer, pluginsTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+
+	for _, plugin := range plugins {
+		for _, shadowed := range plugin.Shadowed {
+			fmt.Fprintf(os.Stderr, "WARNING: %s is shadowed by %s\n", shadowed, plugin.Path)
+		}
+	}
+}
+
+// GetPluginEnvVars returns the environment of the plugin processes, which is the environment of the CLI with the
+// details of the current environment added. The blank values are not added.
+// @param env : Name of the environment
+// @param accessToken : Access token of the user logged into the environment
+// @param tenantDomain : Tenant domain of the current context
+// @param outputFormat : Output format of the current context
+// @param envEndpoints : Endpoints of the environment in the main config
+// @return environment variables in the form key=value
+func GetPluginEnvVars(env, accessToken, tenantDomain, outputFormat string, envEndpoints *utils.EnvEndpoints) []string {
+	envVars := os.Environ()
+	addEnvVar := func(key, value string) {
+		if value != "" {
+			envVars = append(envVars, key+"="+value)
+		}
+	}
+	addEnvVar(utils.PluginEnvVarConfigDir, utils.ConfigDirPath)
+	addEnvVar(utils.PluginEnvVarInsecure, strconv.FormatBool(utils.Insecure))
+	addEnvVar(utils.PluginEnvVarEnvironment, env)
+	addEnvVar(utils.PluginEnvVarAccessToken, accessToken)
+	addEnvVar(utils.PluginEnvVarTenantDomain, tenantDomain)
+	addEnvVar(utils.PluginEnvVarOutputFormat, outputFormat)
+	if envEndpoints != nil {
+		addEnvVar(utils.PluginEnvVarApiManagerEndpoint, envEndpoints.ApiManagerEndpoint)
+		addEnvVar(utils.PluginEnvVarPublisherEndpoint, envEndpoints.PublisherEndpoint)
+		addEnvVar(utils.PluginEnvVarDevPortalEndpoint, envEndpoints.DevPortalEndpoint)
+		addEnvVar(utils.PluginEnvVarAdminEndpoint, envEndpoints.AdminEndpoint)
+		addEnvVar(utils.PluginEnvVarRegistrationEndpoint, envEndpoints.RegistrationEndpoint)
+		addEnvVar(utils.PluginEnvVarTokenEndpoint, envEndpoints.TokenEndpoint)
+		addEnvVar(utils.PluginEnvVarMiManagementEndpoint, envEndpoints.MiManagementEndpoint)
+	}
+	return envVars
+}
+
+// RunPlugin runs the plugin executable with the given arguments and environment, connected to the standard streams
+// of the CLI
+// @param plugin : Plugin to run
+// @param args : Arguments passed to the plugin
+// @param envVars : Environment of the plugin process
+// @return error, which is an *exec.ExitError if the plugin exits with a non-zero status
+func RunPlugin(plugin utils.Plugin, args, envVars []string) error {
+	utils.Logln(utils.LogPrefixInfo + "Running the plugin " + plugin.Path)
+	pluginCmd := exec.Command(plugin.Path, args...)
+	pluginCmd.Env = envVars
+	pluginCmd.Stdin = os.Stdin
+	pluginCmd.Stdout = os.Stdout
+	pluginCmd.Stderr = os.Stderr
+	return pluginCmd.Run()
+}
@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestGetPluginEnvVars(t *testing.T) {
+	envEndpoints := &utils.EnvEndpoints{
+		ApiManagerEndpoint: "https://localhost:9443",
+		TokenEndpoint:      "https://localhost:8243/token",
+	}
+	envVars := GetPluginEnvVars("dev", "access-token", "", "json", envEndpoints)
+
+	assert.Contains(t, envVars, utils.PluginEnvVarEnvironment+"=dev")
+	assert.Contains(t, envVars, utils.PluginEnvVarAccessToken+"=access-token")
+	assert.Contains(t, envVars, utils.PluginEnvVarOutputFormat+"=json")
+	assert.Contains(t, envVars, utils.PluginEnvVarApiManagerEndpoint+"=https://localhost:9443")
+	assert.Contains(t, envVars, utils.PluginEnvVarTokenEndpoint+"=https://localhost:8243/token")
+	for _, envVar := range envVars {
+		assert.NotContains(t, envVar, utils.PluginEnvVarTenantDomain+"=", "Blank values should not be passed")
+		assert.NotContains(t, envVar, utils.PluginEnvVarPublisherEndpoint+"=", "Blank values should not be passed")
+	}
+}
+
+func TestGetPluginEnvVarsWithoutEnvironment(t *testing.T) {
+	envVars := GetPluginEnvVars("", "", "", "", nil)
+
+	for _, envVar := range envVars {
+		assert.NotContains(t, envVar, utils.PluginEnvVarEnvironment+"=")
+		assert.NotContains(t, envVar, utils.PluginEnvVarAccessToken+"=")
+	}
+}
@@ -0,0 +1,414 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const defaultAPIPolicyVersion = "v1"
+
+// defaultThrottlingPolicies are available in every environment, hence they are never promoted
+var defaultThrottlingPolicies = map[string]bool{
+	"Unlimited": true, "Gold": true, "Silver": true, "Bronze": true, "Unauthenticated": true,
+	"AsyncUnlimited": true, "AsyncGold": true, "AsyncSilver": true, "AsyncBronze": true,
+	"AsyncWHUnlimited": true, "AsyncWHGold": true, "AsyncWHSilver": true, "AsyncWHBronze": true,
+	"10KPerMin": true, "20KPerMin": true, "50KPerMin": true,
+	"10PerMin": true, "20PerMin": true, "50PerMin": true,
+}
+
+// apiPolicyReference identifies an API policy attached to an API
+type apiPolicyReference struct {
+	name    string
+	version string
+}
+
+// throttlingPolicyReference identifies a throttling policy used by an API, an API Product or an Application. The
+// policy type is one of the policy types of the export policy rate-limiting command.
+type throttlingPolicyReference struct {
+	policyType string
+	name       string
+}
+
+type attachedAPIPolicies struct {
+	Request  []attachedAPIPolicy `json:"request"`
+	Response []attachedAPIPolicy `json:"response"`
+	Fault    []attachedAPIPolicy `json:"fault"`
+}
+
+type attachedAPIPolicy struct {
+	PolicyName    string `json:"policyName"`
+	PolicyVersion string `json:"policyVersion"`
+}
+
+// promotedArtifactDefinition contains the fields of the definitions of APIs, API Products and Applications which
+// refer the policies they depend on
+type promotedArtifactDefinition struct {
+	Data struct {
+		Policies            []string            `json:"policies"`
+		APIThrottlingPolicy string              `json:"apiThrottlingPolicy"`
+		APIPolicies         attachedAPIPolicies `json:"apiPolicies"`
+		Operations          []struct {
+			ThrottlingPolicy  string              `json:"throttlingPolicy"`
+			OperationPolicies attachedAPIPolicies `json:"operationPolicies"`
+		} `json:"operations"`
+		ApplicationInfo struct {
+			ThrottlingPolicy string `json:"throttlingPolicy"`
+		} `json:"applicationInfo"`
+		SubscribedAPIs []struct {
+			ThrottlingPolicy string `json:"throttlingPolicy"`
+		} `json:"subscribedAPIs"`
+	} `json:"data"`
+}
+
+// PromoteAPI exports an API from an environment and imports it to another environment, applying the params of the
+// target environment. The API policies and the throttling policies the API depends on are promoted first if required.
+// @param fromAccessToken : Access token of the environment from which the API is promoted
+// @param toAccessToken : Access token of the environment to which the API is promoted
+// @param revisionNum : Revision of the API to be promoted. The working copy is promoted if it is empty.
+// @param apiParamsPath : Params file or deployment directory of the API
+func PromoteAPI(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, name, version, provider, revisionNum,
+	apiParamsPath string, latestRevision, preserveStatus, preserveProvider, rotateRevision, skipDeployments,
+	skipCleanup, withAPIPolicies, withThrottlingPolicies bool) error {
+	fmt.Println("Exporting API " + name + " " + version + " from " + fromEnvironment + "...")
+	resp, err := ExportAPIFromEnv(fromAccessToken, name, version, revisionNum, provider, utils.DefaultExportFormat,
+		fromEnvironment, preserveStatus, latestRevision)
+	if err != nil {
+		return err
+	}
+	projectPath, cleanup, err := extractPromotedArtifact(name+"_"+version+".zip", resp, skipCleanup)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if withAPIPolicies || withThrottlingPolicies {
+		_, definition, err := GetAPIDefinition(projectPath)
+		if err != nil {
+			return err
+		}
+		err = promoteDependencies(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, definition,
+			withAPIPolicies, withThrottlingPolicies)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("Importing API " + name + " " + version + " to " + toEnvironment + "...")
+	return ImportAPIToEnv(toAccessToken, toEnvironment, projectPath, apiParamsPath, true, preserveProvider, skipCleanup,
+		rotateRevision, skipDeployments)
+}
+
+// PromoteAPIProduct exports an API Product from an environment and imports it to another environment, applying the
+// params of the target environment. The throttling policies the API Product depends on are promoted first if required.
+// @param fromAccessToken : Access token of the environment from which the API Product is promoted
+// @param toAccessToken : Access token of the environment to which the API Product is promoted
+// @param revisionNum : Revision of the API Product to be promoted. The working copy is promoted if it is empty.
+// @param apiProductParamsPath : Params file or deployment directory of the API Product
+func PromoteAPIProduct(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, name, version, provider,
+	revisionNum, apiProductParamsPath string, latestRevision, preserveStatus, importAPIs, updateAPIs, preserveProvider,
+	rotateRevision, skipDeployments, skipCleanup, withThrottlingPolicies bool) error {
+	fmt.Println("Exporting API Product " + name + " " + version + " from " + fromEnvironment + "...")
+	resp, err := ExportAPIProductFromEnv(fromAccessToken, name, version, revisionNum, provider,
+		utils.DefaultExportFormat, fromEnvironment, latestRevision, preserveStatus)
+	if err != nil {
+		return err
+	}
+	projectPath, cleanup, err := extractPromotedArtifact(name+"_"+version+".zip", resp, skipCleanup)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if withThrottlingPolicies {
+		_, definition, err := GetAPIProductDefinition(projectPath)
+		if err != nil {
+			return err
+		}
+		err = promoteDependencies(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, definition,
+			false, withThrottlingPolicies)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("Importing API Product " + name + " " + version + " to " + toEnvironment + "...")
+	return ImportAPIProductToEnv(toAccessToken, toEnvironment, projectPath, apiProductParamsPath, importAPIs,
+		updateAPIs, true, preserveProvider, skipCleanup, rotateRevision, skipDeployments)
+}
+
+// PromoteApplication exports an Application from an environment and imports it to another environment. The
+// throttling policies of the Application and its subscriptions are promoted first if required.
+// @param fromAccessToken : Access token of the environment from which the Application is promoted
+// @param toAccessToken : Access token of the environment to which the Application is promoted
+// @param withKeys : Promote the keys of the Application
+func PromoteApplication(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, name, owner string, withKeys,
+	preserveOwner, skipSubscriptions, skipCleanup, withThrottlingPolicies bool) error {
+	fmt.Println("Exporting Application " + name + " of " + owner + " from " + fromEnvironment + "...")
+	resp, err := ExportAppFromEnv(fromAccessToken, name, owner, utils.DefaultExportFormat, fromEnvironment, withKeys)
+	if err != nil {
+		return err
+	}
+	projectPath, cleanup, err := extractPromotedArtifact(replaceUserStoreDomainDelimiter(owner)+"_"+name+".zip", resp,
+		skipCleanup)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if withThrottlingPolicies {
+		_, definition, err := GetApplicationDefinition(projectPath)
+		if err != nil {
+			return err
+		}
+		err = promoteDependencies(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, definition,
+			false, withThrottlingPolicies)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("Importing Application " + name + " to " + toEnvironment + "...")
+	_, err = ImportApplicationToEnv(toAccessToken, toEnvironment, projectPath, owner, true, preserveOwner,
+		skipSubscriptions, !withKeys, skipCleanup)
+	return err
+}
+
+// extractPromotedArtifact extracts an exported artifact to a temporary project directory, and returns the directory
+// with a function to remove it once the artifact is imported
+func extractPromotedArtifact(zipFileName string, resp *resty.Response, skipCleanup bool) (string, func(), error) {
+	if resp.StatusCode() != http.StatusOK {
+		return "", nil, fmt.Errorf("exporting failed with status %s: %s", resp.Status(), string(resp.Body()))
+	}
+	zipFile, err := utils.WriteResponseToTempZip(zipFileName, resp)
+	if err != nil {
+		return "", nil, err
+	}
+	projectPath, err := utils.GetTempCloneFromDirOrZip(zipFile)
+	if err != nil {
+		_ = os.RemoveAll(filepath.Dir(zipFile))
+		return "", nil, err
+	}
+	cleanup := func() {
+		if skipCleanup {
+			utils.Logln(utils.LogPrefixInfo+"Leaving", zipFile, "and", projectPath)
+			return
+		}
+		for _, tmpDir := range []string{filepath.Dir(zipFile), filepath.Dir(projectPath)} {
+			utils.Logln(utils.LogPrefixInfo+"Deleting", tmpDir)
+			if err := os.RemoveAll(tmpDir); err != nil {
+				utils.Logln(utils.LogPrefixError + err.Error())
+			}
+		}
+	}
+	return projectPath, cleanup, nil
+}
+
+// collectPromotedDependencies returns the API policies and the throttling policies referred in the definition of an
+// API, an API Product or an Application, except the default throttling policies
+func collectPromotedDependencies(definition []byte) ([]apiPolicyReference, []throttlingPolicyReference, error) {
+	artifact := &promotedArtifactDefinition{}
+	if err := json.Unmarshal(definition, artifact); err != nil {
+		return nil, nil, err
+	}
+
+	var apiPolicies []apiPolicyReference
+	addAPIPolicies := func(attached attachedAPIPolicies) {
+		for _, flow := range [][]attachedAPIPolicy{attached.Request, attached.Response, attached.Fault} {
+			for _, policy := range flow {
+				reference := apiPolicyReference{name: policy.PolicyName, version: policy.PolicyVersion}
+				if reference.version == "" {
+					reference.version = defaultAPIPolicyVersion
+				}
+				if reference.name == "" || containsAPIPolicy(apiPolicies, reference) {
+					continue
+				}
+				apiPolicies = append(apiPolicies, reference)
+			}
+		}
+	}
+
+	var throttlingPolicies []throttlingPolicyReference
+	addThrottlingPolicy := func(policyType, name string) {
+		reference := throttlingPolicyReference{policyType: policyType, name: name}
+		if name == "" || defaultThrottlingPolicies[name] || containsThrottlingPolicy(throttlingPolicies, reference) {
+			return
+		}
+		throttlingPolicies = append(throttlingPolicies, reference)
+	}
+
+	data := artifact.Data
+	for _, policy := range data.Policies {
+		addThrottlingPolicy(CmdPolicyTypeSubscription, policy)
+	}
+	addThrottlingPolicy(CmdPolicyTypeAdvanced, data.APIThrottlingPolicy)
+	addAPIPolicies(data.APIPolicies)
+	for _, operation := range data.Operations {
+		addThrottlingPolicy(CmdPolicyTypeAdvanced, operation.ThrottlingPolicy)
+		addAPIPolicies(operation.OperationPolicies)
+	}
+	addThrottlingPolicy(CmdPolicyTypeApplication, data.ApplicationInfo.ThrottlingPolicy)
+	for _, subscription := range data.SubscribedAPIs {
+		addThrottlingPolicy(CmdPolicyTypeSubscription, subscription.ThrottlingPolicy)
+	}
+	return apiPolicies, throttlingPolicies, nil
+}
+
+func containsAPIPolicy(policies []apiPolicyReference, policy apiPolicyReference) bool {
+	for _, p := range policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+func containsThrottlingPolicy(policies []throttlingPolicyReference, policy throttlingPolicyReference) bool {
+	for _, p := range policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// promoteDependencies promotes the throttling policies and the API policies referred in the definition of an
+// artifact, before the artifact itself is imported
+func promoteDependencies(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment string, definition []byte,
+	withAPIPolicies, withThrottlingPolicies bool) error {
+	apiPolicies, throttlingPolicies, err := collectPromotedDependencies(definition)
+	if err != nil {
+		return err
+	}
+	if withThrottlingPolicies {
+		for _, policy := range throttlingPolicies {
+			err := promoteThrottlingPolicy(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, policy)
+			if err != nil {
+				return fmt.Errorf("promoting the throttling policy %s failed: %w", policy.name, err)
+			}
+		}
+	}
+	if withAPIPolicies {
+		for _, policy := range apiPolicies {
+			err := promoteAPIPolicy(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment, policy)
+			if err != nil {
+				return fmt.Errorf("promoting the API policy %s %s failed: %w", policy.name, policy.version, err)
+			}
+		}
+	}
+	return nil
+}
+
+// promoteThrottlingPolicy exports a throttling policy and imports it to the target environment, overwriting the
+// policy of the same name in the target environment
+func promoteThrottlingPolicy(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment string,
+	policy throttlingPolicyReference) error {
+	fmt.Println("Promoting throttling policy " + policy.name + "...")
+	resp, err := ExportThrottlingPolicyFromEnv(fromAccessToken, fromEnvironment, policy.name, policy.policyType,
+		utils.DefaultExportFormat)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("exporting failed with status %s: %s", resp.Status(), string(resp.Body()))
+	}
+	tmpDir, err := ioutil.TempDir("", "apim")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	fileName, content := resolveThrottlePolicy(utils.DefaultExportFormat, resp)
+	policyFile := filepath.Join(tmpDir, fileName)
+	if err := ioutil.WriteFile(policyFile, content, 0644); err != nil {
+		return err
+	}
+	return ImportThrottlingPolicyToEnv(toAccessToken, toEnvironment, policyFile, true)
+}
+
+// promoteAPIPolicy exports a common API policy and imports it to the target environment, unless the target
+// environment already has it. The policies specific to the API are not exported, since they are promoted with the API.
+func promoteAPIPolicy(fromAccessToken, toAccessToken, fromEnvironment, toEnvironment string,
+	policy apiPolicyReference) error {
+	exists, err := apiPolicyExists(toAccessToken, toEnvironment, policy)
+	if err != nil {
+		return err
+	}
+	if exists {
+		utils.Logln(utils.LogPrefixInfo+"API policy", policy.name, policy.version, "already exists in", toEnvironment)
+		return nil
+	}
+	fmt.Println("Promoting API policy " + policy.name + " " + policy.version + "...")
+	resp, err := ExportAPIPolicyFromEnv(fromAccessToken, fromEnvironment, policy.name, policy.version,
+		utils.DefaultExportFormat)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		utils.Logln(utils.LogPrefixInfo+"API policy", policy.name, policy.version, "is not a common policy of",
+			fromEnvironment)
+		return nil
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("exporting failed with status %s: %s", resp.Status(), string(resp.Body()))
+	}
+	tmpDir, err := ioutil.TempDir("", "apim")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	policyFile := filepath.Join(tmpDir, policy.name+"_"+policy.version+".zip")
+	if err := ioutil.WriteFile(policyFile, resp.Body(), 0644); err != nil {
+		return err
+	}
+	return ImportAPIPolicyToEnv(toAccessToken, toEnvironment, policyFile)
+}
+
+// apiPolicyExists checks whether an environment has a common API policy of the given name and version
+func apiPolicyExists(accessToken, environment string, policy apiPolicyReference) (bool, error) {
+	apiPolicyEndpoint := utils.AppendSlashToString(utils.GetPublisherEndpointOfEnv(environment,
+		utils.MainConfigFilePath)) + "operation-policies"
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequestWithQueryParam("query", "name:"+policy.name+" version:"+policy.version,
+		apiPolicyEndpoint, headers)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return false, fmt.Errorf("searching API policies failed with status %s", resp.Status())
+	}
+	policies := &utils.APIPoliciesList{}
+	if err := json.Unmarshal(resp.Body(), policies); err != nil {
+		return false, err
+	}
+	for _, p := range policies.List {
+		if p.Name == policy.name && p.Version == policy.version {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,119 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"testing"
+
+	"github.com/renstrom/dedent"
+)
+
+func TestCollectPromotedDependenciesOfAPI(t *testing.T) {
+	definition := dedent.Dedent(`
+		{
+			"type": "api",
+			"data": {
+				"name": "PizzaShackAPI",
+				"policies": ["Gold", "PremiumTier"],
+				"apiThrottlingPolicy": "AdvancedAPITier",
+				"apiPolicies": {
+					"request": [{"policyName": "addHeader", "policyVersion": "v1"}]
+				},
+				"operations": [
+					{
+						"target": "/order",
+						"throttlingPolicy": "10KPerMin",
+						"operationPolicies": {
+							"request": [{"policyName": "addHeader", "policyVersion": "v1"}],
+							"response": [{"policyName": "removeHeader"}],
+							"fault": []
+						}
+					},
+					{
+						"target": "/menu",
+						"throttlingPolicy": "AdvancedOperationTier",
+						"operationPolicies": {"request": [], "response": [], "fault": []}
+					}
+				]
+			}
+		}`)
+	apiPolicies, throttlingPolicies, err := collectPromotedDependencies([]byte(definition))
+	if err != nil {
+		t.Fatalf("Error: %s\n", err.Error())
+	}
+
+	expectedAPIPolicies := []apiPolicyReference{{name: "addHeader", version: "v1"}, {name: "removeHeader", version: "v1"}}
+	if len(apiPolicies) != len(expectedAPIPolicies) {
+		t.Fatalf("Expected %v, got %v instead\n", expectedAPIPolicies, apiPolicies)
+	}
+	for i := range expectedAPIPolicies {
+		if apiPolicies[i] != expectedAPIPolicies[i] {
+			t.Errorf("Expected %v, got %v instead\n", expectedAPIPolicies[i], apiPolicies[i])
+		}
+	}
+
+	// The default policies Gold and 10KPerMin are not promoted
+	expectedThrottlingPolicies := []throttlingPolicyReference{
+		{policyType: CmdPolicyTypeSubscription, name: "PremiumTier"},
+		{policyType: CmdPolicyTypeAdvanced, name: "AdvancedAPITier"},
+		{policyType: CmdPolicyTypeAdvanced, name: "AdvancedOperationTier"},
+	}
+	if len(throttlingPolicies) != len(expectedThrottlingPolicies) {
+		t.Fatalf("Expected %v, got %v instead\n", expectedThrottlingPolicies, throttlingPolicies)
+	}
+	for i := range expectedThrottlingPolicies {
+		if throttlingPolicies[i] != expectedThrottlingPolicies[i] {
+			t.Errorf("Expected %v, got %v instead\n", expectedThrottlingPolicies[i], throttlingPolicies[i])
+		}
+	}
+}
+
+func TestCollectPromotedDependenciesOfApplication(t *testing.T) {
+	definition := dedent.Dedent(`
+		{
+			"type": "application",
+			"data": {
+				"applicationInfo": {"name": "SampleApp", "throttlingPolicy": "PremiumAppTier"},
+				"subscribedAPIs": [
+					{"apiId": {"apiName": "PizzaShackAPI"}, "throttlingPolicy": "PremiumTier"},
+					{"apiId": {"apiName": "OrderAPI"}, "throttlingPolicy": "PremiumTier"},
+					{"apiId": {"apiName": "MenuAPI"}, "throttlingPolicy": "Unlimited"}
+				]
+			}
+		}`)
+	apiPolicies, throttlingPolicies, err := collectPromotedDependencies([]byte(definition))
+	if err != nil {
+		t.Fatalf("Error: %s\n", err.Error())
+	}
+	if len(apiPolicies) != 0 {
+		t.Errorf("Expected no API policies, got %v instead\n", apiPolicies)
+	}
+	expectedThrottlingPolicies := []throttlingPolicyReference{
+		{policyType: CmdPolicyTypeApplication, name: "PremiumAppTier"},
+		{policyType: CmdPolicyTypeSubscription, name: "PremiumTier"},
+	}
+	if len(throttlingPolicies) != len(expectedThrottlingPolicies) {
+		t.Fatalf("Expected %v, got %v instead\n", expectedThrottlingPolicies, throttlingPolicies)
+	}
+	for i := range expectedThrottlingPolicies {
+		if throttlingPolicies[i] != expectedThrottlingPolicies[i] {
+			t.Errorf("Expected %v, got %v instead\n", expectedThrottlingPolicies[i], throttlingPolicies[i])
+		}
+	}
+}
@@ -0,0 +1,471 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	subscriptionIdHeader               = "ID"
+	subscriptionApiNameHeader          = "API NAME"
+	subscriptionApiVersionHeader       = "API VERSION"
+	subscriptionApplicationHeader      = "APPLICATION"
+	subscriptionThrottlingPolicyHeader = "THROTTLING POLICY"
+	subscriptionStatusHeader           = "STATUS"
+
+	defaultSubscriptionTableFormat = "table {{.Id}}\t{{.ApiName}}\t{{.ApiVersion}}\t{{.Application}}\t" +
+		"{{.ThrottlingPolicy}}\t{{.Status}}"
+)
+
+// subscriptionsPageSize is the number of subscriptions retrieved in a request when all the subscriptions are listed
+const subscriptionsPageSize = 100
+
+// Subscription states which can be set with the change-status subscription command
+const (
+	SubscriptionStatusBlocked         = "BLOCKED"
+	SubscriptionStatusProdOnlyBlocked = "PROD_ONLY_BLOCKED"
+	SubscriptionStatusUnblocked       = "UNBLOCKED"
+)
+
+// subscription contains information about utils.Subscription
+type subscription struct {
+	id               string
+	apiName          string
+	apiVersion       string
+	application      string
+	throttlingPolicy string
+	status           string
+}
+
+// creates a new subscription definition from utils.Subscription
+func newSubscriptionDefinitionFromSubscription(s utils.Subscription) *subscription {
+	return &subscription{s.SubscriptionID, s.APIInfo.Name, s.APIInfo.Version, s.ApplicationInfo.Name,
+		s.ThrottlingPolicy, s.Status}
+}
+
+// Id of subscription
+func (s subscription) Id() string {
+	return s.id
+}
+
+// ApiName of subscription
+func (s subscription) ApiName() string {
+	return s.apiName
+}
+
+// ApiVersion of subscription
+func (s subscription) ApiVersion() string {
+	return s.apiVersion
+}
+
+// Application of subscription
+func (s subscription) Application() string {
+	return s.application
+}
+
+// ThrottlingPolicy of subscription
+func (s subscription) ThrottlingPolicy() string {
+	return s.throttlingPolicy
+}
+
+// Status of subscription
+func (s subscription) Status() string {
+	return s.status
+}
+
+// MarshalJSON marshals subscription using custom marshaller which uses methods instead of fields
+func (s *subscription) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(s)
+}
+
+// GetSubscriptionListFromEnv returns the subscriptions of an application, or the subscriptions to an API or API
+// Product, in the DevPortal of an environment. If both are given, the subscription of the application to the API or API
+// Product is returned.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment to get the list of subscriptions
+// @param appName : Name of the application
+// @param apiName : Name of the API or API Product
+// @param apiVersion : Version of the API or API Product
+// @param apiProvider : Provider of the API or API Product
+// @param limit : Max number of results to return
+// @return array of Subscription objects
+// @return error
+func GetSubscriptionListFromEnv(accessToken, environment, appName, apiName, apiVersion, apiProvider,
+	limit string) ([]utils.Subscription, error) {
+	if appName == "" && apiName == "" {
+		return nil, errors.New("either the application or the API should be given")
+	}
+	queryParams := make(map[string]string)
+	var appId string
+	if appName != "" {
+		var err error
+		appId, err = getDevPortalAppId(accessToken, environment, appName)
+		if err != nil {
+			return nil, err
+		}
+		queryParams["applicationId"] = appId
+	}
+	if apiName != "" {
+		api, err := getDevPortalApi(accessToken, environment, apiName, apiVersion, apiProvider)
+		if err != nil {
+			return nil, err
+		}
+		// The DevPortal filters by only one of the application and the API
+		if appId == "" {
+			queryParams[utils.ApiId] = api.ID
+		} else {
+			subscriptions, err := getAllSubscriptions(accessToken, environment, queryParams)
+			if err != nil {
+				return nil, err
+			}
+			subscriptions = filterSubscriptionsOfApi(subscriptions, api.ID)
+			if max, err := strconv.Atoi(limit); err == nil && max >= 0 && len(subscriptions) > max {
+				subscriptions = subscriptions[:max]
+			}
+			return subscriptions, nil
+		}
+	}
+	if limit != "" {
+		queryParams["limit"] = limit
+	}
+	return getSubscriptions(accessToken, environment, queryParams)
+}
+
+// AddSubscription subscribes an application to an API or API Product in the DevPortal of an environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the application and the API or API Product
+// @param appName : Name of the application
+// @param apiName : Name of the API or API Product
+// @param apiVersion : Version of the API or API Product
+// @param apiProvider : Provider of the API or API Product
+// @param throttlingPolicy : Subscription throttling policy. The first policy of the API is used if it is blank
+// @return created subscription
+// @return error
+func AddSubscription(accessToken, environment, appName, apiName, apiVersion, apiProvider,
+	throttlingPolicy string) (*utils.Subscription, error) {
+	appId, err := getDevPortalAppId(accessToken, environment, appName)
+	if err != nil {
+		return nil, err
+	}
+	api, err := getDevPortalApi(accessToken, environment, apiName, apiVersion, apiProvider)
+	if err != nil {
+		return nil, err
+	}
+	if throttlingPolicy == "" {
+		if len(api.ThrottlingPolicies) == 0 {
+			return nil, errors.New("no subscription throttling policies are available for " + apiName)
+		}
+		throttlingPolicy = api.ThrottlingPolicies[0]
+		utils.Logln(utils.LogPrefixInfo + "Using the subscription throttling policy " + throttlingPolicy)
+	}
+
+	body, err := json.Marshal(utils.SubscriptionCreateRequest{
+		APIID:            api.ID,
+		ApplicationID:    appId,
+		ThrottlingPolicy: throttlingPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
+	resp, err := utils.InvokePOSTRequest(utils.GetDevPortalSubscriptionListEndpointOfEnv(environment,
+		utils.MainConfigFilePath), headers, string(body))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
+		return nil, getDevPortalResponseError(resp, "subscribe "+appName+" to "+apiName)
+	}
+	created := &utils.Subscription{}
+	err = json.Unmarshal(resp.Body(), created)
+	return created, err
+}
+
+// DeleteSubscription removes the subscription of an application to an API or API Product in the DevPortal of an
+// environment
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the subscription
+// @param appName : Name of the application
+// @param apiName : Name of the API or API Product
+// @param apiVersion : Version of the API or API Product
+// @param apiProvider : Provider of the API or API Product
+// @return error
+func DeleteSubscription(accessToken, environment, appName, apiName, apiVersion, apiProvider string) error {
+	sub, err := getSubscription(accessToken, environment, appName, apiName, apiVersion, apiProvider)
+	if err != nil {
+		return err
+	}
+	deleteEndpoint := utils.GetDevPortalSubscriptionListEndpointOfEnv(environment, utils.MainConfigFilePath) + "/" +
+		sub.SubscriptionID
+	utils.Logln(utils.LogPrefixInfo+"DeleteSubscription: URL:", deleteEndpoint)
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeDELETERequest(deleteEndpoint, headers)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent &&
+		resp.StatusCode() != http.StatusAccepted {
+		return getDevPortalResponseError(resp, "delete the subscription "+sub.SubscriptionID)
+	}
+	return nil
+}
+
+// ChangeSubscriptionStatus blocks or unblocks the subscription of an application to an API or API Product. The
+// subscription is found in the DevPortal and its state is changed through the Publisher.
+// @param accessToken : Access Token for the environment
+// @param environment : Environment of the subscription
+// @param appName : Name of the application
+// @param apiName : Name of the API or API Product
+// @param apiVersion : Version of the API or API Product
+// @param apiProvider : Provider of the API or API Product
+// @param status : New status, which is BLOCKED, PROD_ONLY_BLOCKED or UNBLOCKED
+// @return error
+func ChangeSubscriptionStatus(accessToken, environment, appName, apiName, apiVersion, apiProvider,
+	status string) error {
+	status = strings.ToUpper(status)
+	if status != SubscriptionStatusBlocked && status != SubscriptionStatusProdOnlyBlocked &&
+		status != SubscriptionStatusUnblocked {
+		return fmt.Errorf("invalid subscription status '%s'. It should be one of %s, %s or %s", status,
+			SubscriptionStatusBlocked, SubscriptionStatusProdOnlyBlocked, SubscriptionStatusUnblocked)
+	}
+	sub, err := getSubscription(accessToken, environment, appName, apiName, apiVersion, apiProvider)
+	if err != nil {
+		return err
+	}
+
+	subscriptionsEndpoint := utils.GetPublisherEndpointOfEnv(environment, utils.MainConfigFilePath) + "/subscriptions"
+	queryParams := map[string]string{"subscriptionId": sub.SubscriptionID}
+	var statusEndpoint string
+	if status == SubscriptionStatusUnblocked {
+		statusEndpoint = subscriptionsEndpoint + "/unblock-subscription"
+	} else {
+		statusEndpoint = subscriptionsEndpoint + "/block-subscription"
+		queryParams["blockState"] = status
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokePOSTRequestWithQueryParam(queryParams, statusEndpoint, headers, "")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return getDevPortalResponseError(resp, "change the status of the subscription "+sub.SubscriptionID)
+	}
+	return nil
+}
+
+// PrintSubscriptions prints the subscriptions in the given format
+func PrintSubscriptions(subscriptions []utils.Subscription, format string) {
+	if format == "" {
+		format = defaultSubscriptionTableFormat
+	} else if format == utils.JsonArrayFormatType {
+		entries := make([]utils.SubscriptionEntry, 0, len(subscriptions))
+		for _, s := range subscriptions {
+			entries = append(entries, utils.SubscriptionEntry{Id: s.SubscriptionID, ApiName: s.APIInfo.Name,
+				ApiVersion: s.APIInfo.Version, Application: s.ApplicationInfo.Name,
+				ThrottlingPolicy: s.ThrottlingPolicy, Status: s.Status})
+		}
+		utils.ListArtifactsInJsonArrayFormat(entries, utils.ProjectTypeSubscription)
+		return
+	}
+
+	// create new subscription context with standard output
+	subscriptionContext := formatter.NewContext(os.Stdout, format)
+
+	// create a new renderer function which iterate collection of subscriptions
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, s := range subscriptions {
+			if err := t.Execute(w, newSubscriptionDefinitionFromSubscription(s)); err != nil {
+				return err
+			}
+			// write a new line after executing template
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	subscriptionTableHeaders := map[string]string{
+		"Id":               subscriptionIdHeader,
+		"ApiName":          subscriptionApiNameHeader,
+		"ApiVersion":       subscriptionApiVersionHeader,
+		"Application":      subscriptionApplicationHeader,
+		"ThrottlingPolicy": subscriptionThrottlingPolicyHeader,
+		"Status":           subscriptionStatusHeader,
+	}
+
+	// execute context
+	if err := subscriptionContext.Write(renderer, subscriptionTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// getSubscription returns the subscription of an application to an API or API Product
+func getSubscription(accessToken, environment, appName, apiName, apiVersion,
+	apiProvider string) (*utils.Subscription, error) {
+	subscriptions, err := GetSubscriptionListFromEnv(accessToken, environment, appName, apiName, apiVersion,
+		apiProvider, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(subscriptions) == 0 {
+		return nil, errors.New("the application " + appName + " is not subscribed to " + apiName)
+	}
+	return &subscriptions[0], nil
+}
+
+// getSubscriptions lists the subscriptions in the DevPortal filtered by the query params
+func getSubscriptions(accessToken, environment string, queryParams map[string]string) ([]utils.Subscription,
+	error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams,
+		utils.GetDevPortalSubscriptionListEndpointOfEnv(environment, utils.MainConfigFilePath), headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getDevPortalResponseError(resp, "retrieve the subscriptions")
+	}
+	subscriptionList := &utils.SubscriptionList{}
+	if err := json.Unmarshal(resp.Body(), subscriptionList); err != nil {
+		return nil, err
+	}
+	return subscriptionList.List, nil
+}
+
+// getAllSubscriptions lists all the subscriptions in the DevPortal filtered by the query params, by paging through
+// them, as the DevPortal returns only the first page when the limit is not given
+func getAllSubscriptions(accessToken, environment string, queryParams map[string]string) ([]utils.Subscription,
+	error) {
+	all := []utils.Subscription{}
+	pageParams := make(map[string]string, len(queryParams)+2)
+	for key, value := range queryParams {
+		pageParams[key] = value
+	}
+	pageParams["limit"] = strconv.Itoa(subscriptionsPageSize)
+	for offset := 0; ; offset += subscriptionsPageSize {
+		pageParams["offset"] = strconv.Itoa(offset)
+		subscriptions, err := getSubscriptions(accessToken, environment, pageParams)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, subscriptions...)
+		if len(subscriptions) < subscriptionsPageSize {
+			return all, nil
+		}
+	}
+}
+
+// filterSubscriptionsOfApi returns the subscriptions to the API or API Product of the given ID
+func filterSubscriptionsOfApi(subscriptions []utils.Subscription, apiId string) []utils.Subscription {
+	filtered := []utils.Subscription{}
+	for _, s := range subscriptions {
+		if s.APIID == apiId || s.APIInfo.ID == apiId {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// getDevPortalAppId returns the ID of the application of the given name, which the user can access in the DevPortal
+func getDevPortalAppId(accessToken, environment, appName string) (string, error) {
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequestWithQueryParam("query", appName,
+		utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath), headers)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", getDevPortalResponseError(resp, "search the application "+appName)
+	}
+	appList := &utils.AppList{}
+	if err := json.Unmarshal(resp.Body(), appList); err != nil {
+		return "", err
+	}
+	for _, app := range appList.List {
+		if app.Name == appName {
+			return app.ApplicationID, nil
+		}
+	}
+	return "", errors.New("cannot find the application " + appName + " in the DevPortal of " + environment)
+}
+
+// getDevPortalApi returns the API or API Product of the given name, version and provider in the DevPortal. The version
+// defaults to 1.0.0, and any provider matches if it is blank.
+func getDevPortalApi(accessToken, environment, apiName, apiVersion, apiProvider string) (*utils.DevPortalApi,
+	error) {
+	if apiVersion == "" {
+		apiVersion = utils.DefaultApiProductVersion
+	}
+	query := "name:\"" + apiName + "\" version:\"" + apiVersion + "\""
+	if apiProvider != "" {
+		query += " provider:\"" + apiProvider + "\""
+	}
+	headers := make(map[string]string)
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
+	resp, err := utils.InvokeGETRequestWithQueryParam("query", query,
+		utils.GetDevPortalApiListEndpointOfEnv(environment, utils.MainConfigFilePath), headers)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, getDevPortalResponseError(resp, "search the API "+apiName)
+	}
+	apiList := &utils.DevPortalApiList{}
+	if err := json.Unmarshal(resp.Body(), apiList); err != nil {
+		return nil, err
+	}
+	for i, api := range apiList.List {
+		if api.Name == apiName && api.Version == apiVersion && (apiProvider == "" || api.Provider == apiProvider) {
+			return &apiList.List[i], nil
+		}
+	}
+	return nil, errors.New("cannot find the API or API Product " + apiName + " " + apiVersion +
+		" in the DevPortal of " + environment)
+}
+
+// getDevPortalResponseError logs the failed response and returns an error describing the failed action
+func getDevPortalResponseError(resp *resty.Response, action string) error {
+	utils.Logf("Error: %s\n", resp.Error())
+	utils.Logf("Body: %s\n", resp.Body())
+	if resp.StatusCode() == http.StatusUnauthorized {
+		// 401 Unauthorized
+		return errors.New("authorization failed while trying to " + action)
+	}
+	return errors.New("Request didn't respond 200 OK to " + action + ". Status: " + resp.Status() + " " +
+		string(resp.Body()))
+}
@@ -0,0 +1,144 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const subscriptionsTestEnv = "dev"
+
+// newDevPortalTestServer serves the applications, the APIs and the subscriptions of the DevPortal, and points the
+// main config to it for the duration of the test
+func newDevPortalTestServer(t *testing.T, handleSubscriptions http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/applications"):
+			w.Write([]byte(`{"count": 2, "list": [{"applicationId": "app-2", "name": "SampleApp2"},
+				{"applicationId": "app-1", "name": "SampleApp"}]}`))
+		case strings.HasSuffix(r.URL.Path, "/apis"):
+			w.Write([]byte(`{"count": 1, "list": [{"id": "api-1", "name": "PizzaShackAPI", "version": "1.0.0",
+				"provider": "admin", "throttlingPolicies": ["Gold", "Unlimited"]}]}`))
+		case strings.Contains(r.URL.Path, "/subscriptions"):
+			handleSubscriptions(w, r)
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+	setTestEnvironment(t, subscriptionsTestEnv, server.URL)
+	return server
+}
+
+// setTestEnvironment points the main config to a config with an environment of the given API Manager endpoint for
+// the duration of the test
+func setTestEnvironment(t *testing.T, environment, apiManagerEndpoint string) {
+	mainConfig := &utils.MainConfig{
+		Environments: map[string]utils.EnvEndpoints{
+			environment: {
+				ApiManagerEndpoint: apiManagerEndpoint,
+				TokenEndpoint:      apiManagerEndpoint + "/oauth2/token",
+			},
+		},
+	}
+	configPath := filepath.Join(t.TempDir(), utils.MainConfigFileName)
+	utils.WriteConfigFile(mainConfig, configPath)
+	previousConfigPath := utils.MainConfigFilePath
+	utils.MainConfigFilePath = configPath
+	t.Cleanup(func() {
+		utils.MainConfigFilePath = previousConfigPath
+	})
+}
+
+func TestGetSubscriptionListOfAppAndApi(t *testing.T) {
+	newDevPortalTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "app-1", r.URL.Query().Get("applicationId"))
+		assert.Equal(t, strconv.Itoa(subscriptionsPageSize), r.URL.Query().Get("limit"))
+		if r.URL.Query().Get("offset") == "0" {
+			// a full page of the subscriptions to the other APIs
+			subscriptions := make([]utils.Subscription, subscriptionsPageSize)
+			for i := range subscriptions {
+				subscriptions[i] = utils.Subscription{SubscriptionID: "sub-" + strconv.Itoa(i+2), APIID: "api-2"}
+			}
+			body, _ := json.Marshal(utils.SubscriptionList{Count: subscriptionsPageSize, List: subscriptions})
+			w.Write(body)
+			return
+		}
+		assert.Equal(t, strconv.Itoa(subscriptionsPageSize), r.URL.Query().Get("offset"))
+		w.Write([]byte(`{"count": 1, "list": [
+			{"subscriptionId": "sub-1", "apiId": "api-1", "status": "UNBLOCKED"}]}`))
+	})
+
+	subscriptions, err := GetSubscriptionListFromEnv("token", subscriptionsTestEnv, "SampleApp", "PizzaShackAPI",
+		"1.0.0", "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(subscriptions), "Should page through all the subscriptions of the application")
+	assert.Equal(t, "sub-1", subscriptions[0].SubscriptionID)
+}
+
+func TestGetSubscriptionListWithoutAppAndApi(t *testing.T) {
+	_, err := GetSubscriptionListFromEnv("token", subscriptionsTestEnv, "", "", "", "", "")
+	assert.NotNil(t, err)
+}
+
+func TestAddSubscriptionWithDefaultThrottlingPolicy(t *testing.T) {
+	newDevPortalTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		request := utils.SubscriptionCreateRequest{}
+		assert.Nil(t, json.Unmarshal(body, &request))
+		assert.Equal(t, "api-1", request.APIID)
+		assert.Equal(t, "app-1", request.ApplicationID)
+		assert.Equal(t, "Gold", request.ThrottlingPolicy)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"subscriptionId": "sub-1", "status": "UNBLOCKED"}`))
+	})
+
+	subscription, err := AddSubscription("token", subscriptionsTestEnv, "SampleApp", "PizzaShackAPI", "1.0.0",
+		"admin", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "sub-1", subscription.SubscriptionID)
+}
+
+func TestAddSubscriptionToMissingApi(t *testing.T) {
+	newDevPortalTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected subscription request")
+	})
+
+	_, err := AddSubscription("token", subscriptionsTestEnv, "SampleApp", "PizzaShackAPI", "2.0.0", "", "")
+	assert.NotNil(t, err)
+}
+
+func TestChangeSubscriptionStatusInvalidStatus(t *testing.T) {
+	err := ChangeSubscriptionStatus("token", subscriptionsTestEnv, "SampleApp", "PizzaShackAPI", "1.0.0", "",
+		"PAUSED")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// ValidateAPIDefinition applies the overlays of the environment in the params to the OpenAPI definition of an API
+// project, validates the overlaid definition and returns it. The definition is validated as it is if the params path
+// is empty.
+// @param importPath : Path of the API project directory or archive
+// @param apiParamsPath : Path of the params file or the deployment directory
+// @param environment : Environment of which the overlays should be applied
+// @return the overlaid definition
+// @return error
+func ValidateAPIDefinition(importPath, apiParamsPath, environment string) (string, error) {
+	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedApisDirName)
+	resolvedAPIFilePath, err := resolveImportFilePath(importPath, exportDirectory)
+	if err != nil {
+		return "", err
+	}
+	tmpPath, err := utils.GetTempCloneFromDirOrZip(resolvedAPIFilePath)
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	if apiParamsPath != "" {
+		var apiParams *params.ApiParams
+		if strings.Contains(apiParamsPath, ".yaml") {
+			apiParams, err = params.LoadApiParamsFromFile(apiParamsPath, environment)
+		} else {
+			apiParams, err = params.LoadApiParamsFromDirectory(apiParamsPath, environment)
+		}
+		if err != nil {
+			return "", err
+		}
+		envParams := apiParams.GetEnv(environment)
+		if envParams == nil {
+			return "", errors.New("Environment '" + environment + "' does not exist in " + apiParamsPath)
+		}
+		err = applyEnvOverlays(tmpPath, envParams)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	definitionPath := filepath.Join(tmpPath, utils.InitProjectDefinitionsSwagger)
+	definition, err := ioutil.ReadFile(definitionPath)
+	if err != nil {
+		return "", err
+	}
+	err = validateDefinition(definitionPath, definition)
+	if err != nil {
+		return "", err
+	}
+	return string(definition), nil
+}
+
+// validateDefinition validates an OpenAPI 3 definition, or loads a Swagger 2 definition to check whether it is
+// well-formed
+func validateDefinition(definitionPath string, definition []byte) error {
+	var version struct {
+		OpenAPI string `yaml:"openapi"`
+	}
+	err := yaml.Unmarshal(definition, &version)
+	if err != nil {
+		return err
+	}
+	if version.OpenAPI == "" {
+		_, err = loadSwagger(definitionPath)
+		return err
+	}
+	swagger, err := openapi3.NewSwaggerLoader().LoadSwaggerFromData(definition)
+	if err != nil {
+		return err
+	}
+	return swagger.Validate(context.Background())
+}
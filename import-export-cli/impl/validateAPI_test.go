@@ -0,0 +1,91 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renstrom/dedent"
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func writeValidateTestFile(t *testing.T, path, content string) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(path, []byte(dedent.Dedent(content)), 0644))
+}
+
+func TestValidateAPIDefinitionWithOverlays(t *testing.T) {
+	dir := t.TempDir()
+	projectPath := filepath.Join(dir, "PetStore")
+	writeValidateTestFile(t, filepath.Join(projectPath, utils.InitProjectDefinitionsSwagger), `
+		openapi: 3.0.1
+		info:
+		  title: PetStore
+		  version: 1.0.0
+		servers:
+		- url: http://localhost:8080/v1
+		paths:
+		  /pets:
+		    get:
+		      responses:
+		        "200":
+		          description: A list of pets
+		    delete:
+		      x-internal: true
+		      responses:
+		        "200":
+		          description: Deleted
+	`)
+	writeValidateTestFile(t, filepath.Join(dir, "overlays", "production.yaml"), `
+		overlay: 1.0.0
+		info:
+		  title: Production
+		  version: 1.0.0
+		actions:
+		- target: $.servers[0]
+		  update:
+		    url: https://gw.prod.example.com/v1
+		- target: $.paths.*[?(@.x-internal == true)]
+		  remove: true
+	`)
+	paramsPath := filepath.Join(dir, "api_params.yaml")
+	writeValidateTestFile(t, paramsPath, `
+		environments:
+		  - name: production
+		    overlays:
+		      - overlays/production.yaml
+	`)
+
+	definition, err := ValidateAPIDefinition(projectPath, paramsPath, "production")
+	assert.NoError(t, err)
+	assert.Contains(t, definition, "https://gw.prod.example.com/v1")
+	assert.NotContains(t, definition, "x-internal")
+
+	// The project itself is not changed
+	definition, err = ValidateAPIDefinition(projectPath, "", "")
+	assert.NoError(t, err)
+	assert.Contains(t, definition, "http://localhost:8080/v1")
+
+	_, err = ValidateAPIDefinition(projectPath, paramsPath, "dev")
+	assert.Error(t, err, "Should return an error for an undefined environment")
+}
@@ -382,6 +382,18 @@ _apictl_add_env()
     two_word_flags+=("--apim")
     local_nonpersistent_flags+=("--apim")
     local_nonpersistent_flags+=("--apim=")
+    flags+=("--ca-bundle=")
+    two_word_flags+=("--ca-bundle")
+    local_nonpersistent_flags+=("--ca-bundle")
+    local_nonpersistent_flags+=("--ca-bundle=")
+    flags+=("--client-cert=")
+    two_word_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert=")
+    flags+=("--client-key=")
+    two_word_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key=")
     flags+=("--devportal=")
     two_word_flags+=("--devportal")
     local_nonpersistent_flags+=("--devportal")
@@ -394,6 +406,10 @@ _apictl_add_env()
     two_word_flags+=("--mi")
     local_nonpersistent_flags+=("--mi")
     local_nonpersistent_flags+=("--mi=")
+    flags+=("--proxy=")
+    two_word_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy=")
     flags+=("--publisher=")
     two_word_flags+=("--publisher")
     local_nonpersistent_flags+=("--publisher")
@@ -402,6 +418,10 @@ _apictl_add_env()
     two_word_flags+=("--registration")
     local_nonpersistent_flags+=("--registration")
     local_nonpersistent_flags+=("--registration=")
+    flags+=("--tls-renegotiation-mode=")
+    two_word_flags+=("--tls-renegotiation-mode")
+    local_nonpersistent_flags+=("--tls-renegotiation-mode")
+    local_nonpersistent_flags+=("--tls-renegotiation-mode=")
     flags+=("--token=")
     two_word_flags+=("--token")
     local_nonpersistent_flags+=("--token")
@@ -439,6 +459,70 @@ _apictl_add_help()
     noun_aliases=()
 }
 
+_apictl_add_subscription()
+{
+    last_command="apictl_add_subscription"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app-name=")
+    two_word_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--throttling-policy=")
+    two_word_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--app-name=")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_add()
 {
     last_command="apictl_add"
@@ -448,6 +532,7 @@ _apictl_add()
     commands=()
     commands+=("env")
     commands+=("help")
+    commands+=("subscription")
 
     flags=()
     two_word_flags=()
@@ -505,8 +590,6 @@ _apictl_ai_delete_artifacts()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
@@ -627,8 +710,6 @@ _apictl_ai_upload_api-products()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
@@ -672,8 +753,6 @@ _apictl_ai_upload_apis()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
@@ -958,8 +1037,6 @@ _apictl_change-status_api()
     must_have_one_flag=()
     must_have_one_flag+=("--action=")
     must_have_one_flag+=("-a")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -1023,8 +1100,6 @@ _apictl_change-status_api-product()
     must_have_one_flag=()
     must_have_one_flag+=("--action=")
     must_have_one_flag+=("-a")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -1057,39 +1132,9 @@ _apictl_change-status_help()
     noun_aliases=()
 }
 
-_apictl_change-status()
-{
-    last_command="apictl_change-status"
-
-    command_aliases=()
-
-    commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("help")
-
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_noun=()
-    noun_aliases=()
-}
-
-_apictl_delete_api()
+_apictl_change-status_subscription()
 {
-    last_command="apictl_delete_api"
+    last_command="apictl_change-status_subscription"
 
     command_aliases=()
 
@@ -1101,6 +1146,16 @@ _apictl_delete_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--action=")
+    two_word_flags+=("--action")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--action")
+    local_nonpersistent_flags+=("--action=")
+    local_nonpersistent_flags+=("-a")
+    flags+=("--app-name=")
+    two_word_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -1134,8 +1189,9 @@ _apictl_delete_api()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--action=")
+    must_have_one_flag+=("-a")
+    must_have_one_flag+=("--app-name=")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -1144,13 +1200,17 @@ _apictl_delete_api()
     noun_aliases=()
 }
 
-_apictl_delete_api-product()
+_apictl_change-status()
 {
-    last_command="apictl_delete_api-product"
+    last_command="apictl_change-status"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("help")
+    commands+=("subscription")
 
     flags=()
     two_word_flags=()
@@ -1158,52 +1218,22 @@ _apictl_delete_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_app()
+_apictl_config_get-contexts()
 {
-    last_command="apictl_delete_app"
+    last_command="apictl_config_get-contexts"
 
     command_aliases=()
 
@@ -1215,44 +1245,26 @@ _apictl_delete_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_help()
+_apictl_config_help()
 {
-    last_command="apictl_delete_help"
+    last_command="apictl_config_help"
 
     command_aliases=()
 
@@ -1274,9 +1286,9 @@ _apictl_delete_help()
     noun_aliases=()
 }
 
-_apictl_delete_policy_api()
+_apictl_config_set-context()
 {
-    last_command="apictl_delete_policy_api"
+    last_command="apictl_config_set-context"
 
     command_aliases=()
 
@@ -1298,36 +1310,34 @@ _apictl_delete_policy_api()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--tenant=")
+    two_word_flags+=("--tenant")
+    local_nonpersistent_flags+=("--tenant")
+    local_nonpersistent_flags+=("--tenant=")
+    flags+=("--user=")
+    two_word_flags+=("--user")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--user")
+    local_nonpersistent_flags+=("--user=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_policy_help()
+_apictl_config_use-context()
 {
-    last_command="apictl_delete_policy_help"
+    last_command="apictl_config_use-context"
 
     command_aliases=()
 
@@ -1339,23 +1349,30 @@ _apictl_delete_policy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_delete_policy_rate-limiting()
+_apictl_config()
 {
-    last_command="apictl_delete_policy_rate-limiting"
+    last_command="apictl_config"
 
     command_aliases=()
 
     commands=()
+    commands+=("get-contexts")
+    commands+=("help")
+    commands+=("set-context")
+    commands+=("use-context")
 
     flags=()
     two_word_flags=()
@@ -1363,53 +1380,26 @@ _apictl_delete_policy_rate-limiting()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--type=")
-    two_word_flags+=("--type")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--type")
-    local_nonpersistent_flags+=("--type=")
-    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--type=")
-    must_have_one_flag+=("-t")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_policy()
+_apictl_delete_api()
 {
-    last_command="apictl_delete_policy"
+    last_command="apictl_delete_api"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
-    commands+=("rate-limiting")
 
     flags=()
     two_word_flags=()
@@ -1417,99 +1407,28 @@ _apictl_delete_policy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_noun=()
-    noun_aliases=()
-}
-
-_apictl_delete()
-{
-    last_command="apictl_delete"
-
-    command_aliases=()
-
-    commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
-    commands+=("help")
-    commands+=("policy")
-
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_noun=()
-    noun_aliases=()
-}
-
-_apictl_export_api()
-{
-    last_command="apictl_export_api"
-
-    command_aliases=()
-
-    commands=()
-
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--latest")
-    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
@@ -1521,8 +1440,6 @@ _apictl_export_api()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -1531,9 +1448,9 @@ _apictl_export_api()
     noun_aliases=()
 }
 
-_apictl_export_api-product()
+_apictl_delete_api-product()
 {
-    last_command="apictl_export_api-product"
+    last_command="apictl_delete_api-product"
 
     command_aliases=()
 
@@ -1551,34 +1468,22 @@ _apictl_export_api-product()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--latest")
-    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
@@ -1590,8 +1495,6 @@ _apictl_export_api-product()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -1600,53 +1503,9 @@ _apictl_export_api-product()
     noun_aliases=()
 }
 
-_apictl_export_apis()
-{
-    last_command="apictl_export_apis"
-
-    command_aliases=()
-
-    commands=()
-
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--all")
-    local_nonpersistent_flags+=("--all")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--force")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_noun=()
-    noun_aliases=()
-}
-
-_apictl_export_app()
+_apictl_delete_app()
 {
-    last_command="apictl_export_app"
+    last_command="apictl_delete_app"
 
     command_aliases=()
 
@@ -1664,10 +1523,6 @@ _apictl_export_app()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1684,26 +1539,20 @@ _apictl_export_app()
     local_nonpersistent_flags+=("--owner")
     local_nonpersistent_flags+=("--owner=")
     local_nonpersistent_flags+=("-o")
-    flags+=("--with-keys")
-    local_nonpersistent_flags+=("--with-keys")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--owner=")
-    must_have_one_flag+=("-o")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_help()
+_apictl_delete_help()
 {
-    last_command="apictl_export_help"
+    last_command="apictl_delete_help"
 
     command_aliases=()
 
@@ -1725,9 +1574,9 @@ _apictl_export_help()
     noun_aliases=()
 }
 
-_apictl_export_policy_api()
+_apictl_delete_policy_api()
 {
-    last_command="apictl_export_policy_api"
+    last_command="apictl_delete_policy_api"
 
     command_aliases=()
 
@@ -1745,10 +1594,6 @@ _apictl_export_policy_api()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1770,8 +1615,6 @@ _apictl_export_policy_api()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -1780,9 +1623,9 @@ _apictl_export_policy_api()
     noun_aliases=()
 }
 
-_apictl_export_policy_help()
+_apictl_delete_policy_help()
 {
-    last_command="apictl_export_policy_help"
+    last_command="apictl_delete_policy_help"
 
     command_aliases=()
 
@@ -1804,9 +1647,9 @@ _apictl_export_policy_help()
     noun_aliases=()
 }
 
-_apictl_export_policy_rate-limiting()
+_apictl_delete_policy_rate-limiting()
 {
-    last_command="apictl_export_policy_rate-limiting"
+    last_command="apictl_delete_policy_rate-limiting"
 
     command_aliases=()
 
@@ -1824,10 +1667,6 @@ _apictl_export_policy_rate-limiting()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1849,17 +1688,17 @@ _apictl_export_policy_rate-limiting()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
+    must_have_one_flag+=("--type=")
+    must_have_one_flag+=("-t")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_policy()
+_apictl_delete_policy()
 {
-    last_command="apictl_export_policy"
+    last_command="apictl_delete_policy"
 
     command_aliases=()
 
@@ -1887,19 +1726,13 @@ _apictl_export_policy()
     noun_aliases=()
 }
 
-_apictl_export()
+_apictl_delete_subscription()
 {
-    last_command="apictl_export"
+    last_command="apictl_delete_subscription"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("apis")
-    commands+=("app")
-    commands+=("help")
-    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -1907,26 +1740,65 @@ _apictl_export()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--app-name=")
+    two_word_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--app-name=")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_deployment-dir()
+_apictl_delete()
 {
-    last_command="apictl_gen_deployment-dir"
+    last_command="apictl_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
+    commands+=("policy")
+    commands+=("subscription")
 
     flags=()
     two_word_flags=()
@@ -1934,36 +1806,22 @@ _apictl_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_help()
+_apictl_env_check()
 {
-    last_command="apictl_gen_help"
+    last_command="apictl_env_check"
 
     command_aliases=()
 
@@ -1975,25 +1833,38 @@ _apictl_gen_help()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_noun=()
-    has_completion_function=1
-    noun_aliases=()
-}
-
-_apictl_gen()
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--register-client")
+    local_nonpersistent_flags+=("--register-client")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_env_help()
 {
-    last_command="apictl_gen"
+    last_command="apictl_env_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2001,26 +1872,25 @@ _apictl_gen()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_get_api-logging()
+_apictl_env()
 {
-    last_command="apictl_get_api-logging"
+    last_command="apictl_env"
 
     command_aliases=()
 
     commands=()
+    commands+=("check")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2028,44 +1898,22 @@ _apictl_get_api-logging()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--api-id=")
-    two_word_flags+=("--api-id")
-    two_word_flags+=("-i")
-    local_nonpersistent_flags+=("--api-id")
-    local_nonpersistent_flags+=("--api-id=")
-    local_nonpersistent_flags+=("-i")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--tenant-domain=")
-    two_word_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-product-revisions()
+_apictl_export_api()
 {
-    last_command="apictl_get_api-product-revisions"
+    last_command="apictl_export_api"
 
     command_aliases=()
 
@@ -2091,24 +1939,26 @@ _apictl_get_api-product-revisions()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
@@ -2120,8 +1970,6 @@ _apictl_get_api-product-revisions()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -2130,9 +1978,9 @@ _apictl_get_api-product-revisions()
     noun_aliases=()
 }
 
-_apictl_get_api-products()
+_apictl_export_api-category()
 {
-    last_command="apictl_get_api-products"
+    last_command="apictl_export_api-category"
 
     command_aliases=()
 
@@ -2158,32 +2006,26 @@ _apictl_get_api-products()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-revisions()
+_apictl_export_api-product()
 {
-    last_command="apictl_get_api-revisions"
+    last_command="apictl_export_api-product"
 
     command_aliases=()
 
@@ -2209,24 +2051,26 @@ _apictl_get_api-revisions()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
     flags+=("--version=")
     two_word_flags+=("--version")
     two_word_flags+=("-v")
@@ -2238,8 +2082,6 @@ _apictl_get_api-revisions()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--version=")
@@ -2248,9 +2090,9 @@ _apictl_get_api-revisions()
     noun_aliases=()
 }
 
-_apictl_get_apis()
+_apictl_export_apis()
 {
-    last_command="apictl_get_apis"
+    last_command="apictl_export_apis"
 
     command_aliases=()
 
@@ -2262,12 +2104,15 @@ _apictl_get_apis()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--force")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -2276,32 +2121,20 @@ _apictl_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_apps()
+_apictl_export_app()
 {
-    last_command="apictl_get_apps"
+    last_command="apictl_export_app"
 
     command_aliases=()
 
@@ -2327,32 +2160,36 @@ _apictl_get_apps()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--owner=")
     two_word_flags+=("--owner")
     two_word_flags+=("-o")
     local_nonpersistent_flags+=("--owner")
     local_nonpersistent_flags+=("--owner=")
     local_nonpersistent_flags+=("-o")
+    flags+=("--with-keys")
+    local_nonpersistent_flags+=("--with-keys")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--owner=")
+    must_have_one_flag+=("-o")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_correlation-logging()
+_apictl_export_deny-policy()
 {
-    last_command="apictl_get_correlation-logging"
+    last_command="apictl_export_deny-policy"
 
     command_aliases=()
 
@@ -2378,20 +2215,26 @@ _apictl_get_correlation-logging()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_envs()
+_apictl_export_gateway-environment()
 {
-    last_command="apictl_get_envs"
+    last_command="apictl_export_gateway-environment"
 
     command_aliases=()
 
@@ -2403,6 +2246,12 @@ _apictl_get_envs()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -2411,18 +2260,26 @@ _apictl_get_envs()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_help()
+_apictl_export_help()
 {
-    last_command="apictl_get_help"
+    last_command="apictl_export_help"
 
     command_aliases=()
 
@@ -2444,9 +2301,9 @@ _apictl_get_help()
     noun_aliases=()
 }
 
-_apictl_get_keys()
+_apictl_export_key-manager()
 {
-    last_command="apictl_get_keys"
+    last_command="apictl_export_key-manager"
 
     command_aliases=()
 
@@ -2464,6 +2321,10 @@ _apictl_get_keys()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -2474,40 +2335,20 @@ _apictl_get_keys()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--token=")
-    two_word_flags+=("--token")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--token")
-    local_nonpersistent_flags+=("--token=")
-    local_nonpersistent_flags+=("-t")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_policies_api()
+_apictl_export_policy_api()
 {
-    last_command="apictl_get_policies_api"
+    last_command="apictl_export_policy_api"
 
     command_aliases=()
 
@@ -2519,8 +2360,6 @@ _apictl_get_policies_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--all")
-    local_nonpersistent_flags+=("--all")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -2535,26 +2374,34 @@ _apictl_get_policies_api()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_policies_help()
+_apictl_export_policy_help()
 {
-    last_command="apictl_get_policies_help"
+    last_command="apictl_export_policy_help"
 
     command_aliases=()
 
@@ -2576,9 +2423,9 @@ _apictl_get_policies_help()
     noun_aliases=()
 }
 
-_apictl_get_policies_rate-limiting()
+_apictl_export_policy_rate-limiting()
 {
-    last_command="apictl_get_policies_rate-limiting"
+    last_command="apictl_export_policy_rate-limiting"
 
     command_aliases=()
 
@@ -2604,26 +2451,32 @@ _apictl_get_policies_rate-limiting()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--type=")
+    two_word_flags+=("--type")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--type")
+    local_nonpersistent_flags+=("--type=")
+    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_policies()
+_apictl_export_policy()
 {
-    last_command="apictl_get_policies"
+    last_command="apictl_export_policy"
 
     command_aliases=()
 
@@ -2651,24 +2504,13 @@ _apictl_get_policies()
     noun_aliases=()
 }
 
-_apictl_get()
+_apictl_export_scope()
 {
-    last_command="apictl_get"
+    last_command="apictl_export_scope"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-logging")
-    commands+=("api-product-revisions")
-    commands+=("api-products")
-    commands+=("api-revisions")
-    commands+=("apis")
-    commands+=("apps")
-    commands+=("correlation-logging")
-    commands+=("envs")
-    commands+=("help")
-    commands+=("keys")
-    commands+=("policies")
 
     flags=()
     two_word_flags=()
@@ -2676,22 +2518,40 @@ _apictl_get()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_help()
+_apictl_export_tenant-config()
 {
-    last_command="apictl_help"
+    last_command="apictl_export_tenant-config"
 
     command_aliases=()
 
@@ -2703,23 +2563,48 @@ _apictl_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_api()
+_apictl_export()
 {
-    last_command="apictl_import_api"
+    last_command="apictl_export"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-category")
+    commands+=("api-product")
+    commands+=("apis")
+    commands+=("app")
+    commands+=("deny-policy")
+    commands+=("gateway-environment")
+    commands+=("help")
+    commands+=("key-manager")
+    commands+=("policy")
+    commands+=("scope")
+    commands+=("tenant-config")
 
     flags=()
     two_word_flags=()
@@ -2727,52 +2612,22 @@ _apictl_import_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--rotate-revision")
-    local_nonpersistent_flags+=("--rotate-revision")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-deployments")
-    local_nonpersistent_flags+=("--skip-deployments")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_api-product()
+_apictl_gen_deployment-dir()
 {
-    last_command="apictl_import_api-product"
+    last_command="apictl_gen_deployment-dir"
 
     command_aliases=()
 
@@ -2784,56 +2639,36 @@ _apictl_import_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--import-apis")
-    local_nonpersistent_flags+=("--import-apis")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--rotate-revision")
-    local_nonpersistent_flags+=("--rotate-revision")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-deployments")
-    local_nonpersistent_flags+=("--skip-deployments")
-    flags+=("--update-api-product")
-    local_nonpersistent_flags+=("--update-api-product")
-    flags+=("--update-apis")
-    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_app()
+_apictl_gen_help()
 {
-    last_command="apictl_import_app"
+    last_command="apictl_gen_help"
 
     command_aliases=()
 
@@ -2845,60 +2680,25 @@ _apictl_import_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
-    two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
-    local_nonpersistent_flags+=("-o")
-    flags+=("--preserve-owner")
-    local_nonpersistent_flags+=("--preserve-owner")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-keys")
-    local_nonpersistent_flags+=("--skip-keys")
-    flags+=("--skip-subscriptions")
-    flags+=("-s")
-    local_nonpersistent_flags+=("--skip-subscriptions")
-    local_nonpersistent_flags+=("-s")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_help()
+_apictl_gen()
 {
-    last_command="apictl_import_help"
+    last_command="apictl_gen"
 
     command_aliases=()
 
     commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2906,19 +2706,22 @@ _apictl_import_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_policy_api()
+_apictl_get_api-logging()
 {
-    last_command="apictl_import_policy_api"
+    last_command="apictl_get_api-logging"
 
     command_aliases=()
 
@@ -2930,38 +2733,1781 @@ _apictl_import_policy_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--api-id=")
+    two_word_flags+=("--api-id")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--api-id")
+    local_nonpersistent_flags+=("--api-id=")
+    local_nonpersistent_flags+=("-i")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--tenant-domain=")
+    two_word_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_policy_help()
+_apictl_get_api-product-revisions()
+{
+    last_command="apictl_get_api-product-revisions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-products()
+{
+    last_command="apictl_get_api-products"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-revisions()
+{
+    last_command="apictl_get_api-revisions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apis()
+{
+    last_command="apictl_get_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apps()
+{
+    last_command="apictl_get_apps"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_correlation-logging()
+{
+    last_command="apictl_get_correlation-logging"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_envs()
+{
+    last_command="apictl_get_envs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_help()
+{
+    last_command="apictl_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_get_keys()
+{
+    last_command="apictl_get_keys"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_policies_api()
+{
+    last_command="apictl_get_policies_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_policies_help()
+{
+    last_command="apictl_get_policies_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_get_policies_rate-limiting()
+{
+    last_command="apictl_get_policies_rate-limiting"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_policies()
+{
+    last_command="apictl_get_policies"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+    commands+=("rate-limiting")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_subscriptions()
+{
+    last_command="apictl_get_subscriptions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--app-name=")
+    two_word_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name")
+    local_nonpersistent_flags+=("--app-name=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get()
+{
+    last_command="apictl_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api-logging")
+    commands+=("api-product-revisions")
+    commands+=("api-products")
+    commands+=("api-revisions")
+    commands+=("apis")
+    commands+=("apps")
+    commands+=("correlation-logging")
+    commands+=("envs")
+    commands+=("help")
+    commands+=("keys")
+    commands+=("policies")
+    commands+=("subscriptions")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_help()
+{
+    last_command="apictl_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_api()
+{
+    last_command="apictl_import_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--allow-exec-secrets")
+    local_nonpersistent_flags+=("--allow-exec-secrets")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_api-category()
+{
+    last_command="apictl_import_api-category"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_api-product()
+{
+    last_command="apictl_import_api-product"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--import-apis")
+    local_nonpersistent_flags+=("--import-apis")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--update-api-product")
+    local_nonpersistent_flags+=("--update-api-product")
+    flags+=("--update-apis")
+    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_app()
+{
+    last_command="apictl_import_app"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserve-owner")
+    local_nonpersistent_flags+=("--preserve-owner")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-keys")
+    local_nonpersistent_flags+=("--skip-keys")
+    flags+=("--skip-subscriptions")
+    flags+=("-s")
+    local_nonpersistent_flags+=("--skip-subscriptions")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_deny-policy()
+{
+    last_command="apictl_import_deny-policy"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_gateway-environment()
+{
+    last_command="apictl_import_gateway-environment"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_help()
+{
+    last_command="apictl_import_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_key-manager()
+{
+    last_command="apictl_import_key-manager"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_policy_api()
+{
+    last_command="apictl_import_policy_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_policy_help()
+{
+    last_command="apictl_import_policy_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_policy_rate-limiting()
+{
+    last_command="apictl_import_policy_rate-limiting"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_policy()
+{
+    last_command="apictl_import_policy"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+    commands+=("rate-limiting")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_scope()
+{
+    last_command="apictl_import_scope"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--update")
+    flags+=("-u")
+    local_nonpersistent_flags+=("--update")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_tenant-config()
+{
+    last_command="apictl_import_tenant-config"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import()
+{
+    last_command="apictl_import"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("api-category")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("deny-policy")
+    commands+=("gateway-environment")
+    commands+=("help")
+    commands+=("key-manager")
+    commands+=("policy")
+    commands+=("scope")
+    commands+=("tenant-config")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_init()
+{
+    last_command="apictl_init"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--definition=")
+    two_word_flags+=("--definition")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--definition")
+    local_nonpersistent_flags+=("--definition=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--force")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--force")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--initial-state=")
+    two_word_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state=")
+    flags+=("--oas=")
+    two_word_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_api()
+{
+    last_command="apictl_k8s_add_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_help()
+{
+    last_command="apictl_k8s_add_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_add()
+{
+    last_command="apictl_k8s_add"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_api()
+{
+    last_command="apictl_k8s_delete_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_help()
+{
+    last_command="apictl_k8s_delete_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_delete()
+{
+    last_command="apictl_k8s_delete"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_deployment-dir()
+{
+    last_command="apictl_k8s_gen_deployment-dir"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_help()
+{
+    last_command="apictl_k8s_gen_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_gen()
+{
+    last_command="apictl_k8s_gen"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_help()
+{
+    last_command="apictl_k8s_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_update_api()
+{
+    last_command="apictl_k8s_update_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_update_help()
 {
-    last_command="apictl_import_policy_help"
+    last_command="apictl_k8s_update_help"
 
     command_aliases=()
 
@@ -2983,13 +4529,15 @@ _apictl_import_policy_help()
     noun_aliases=()
 }
 
-_apictl_import_policy_rate-limiting()
+_apictl_k8s_update()
 {
-    last_command="apictl_import_policy_rate-limiting"
+    last_command="apictl_k8s_update"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2997,49 +4545,31 @@ _apictl_import_policy_rate-limiting()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--update")
-    flags+=("-u")
-    local_nonpersistent_flags+=("--update")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_policy()
+_apictl_k8s()
 {
-    last_command="apictl_import_policy"
+    last_command="apictl_k8s"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
+    commands+=("add")
+    commands+=("delete")
+    commands+=("gen")
     commands+=("help")
-    commands+=("rate-limiting")
+    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -3060,18 +4590,13 @@ _apictl_import_policy()
     noun_aliases=()
 }
 
-_apictl_import()
+_apictl_login()
 {
-    last_command="apictl_import"
+    last_command="apictl_login"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
-    commands+=("help")
-    commands+=("policy")
 
     flags=()
     two_word_flags=()
@@ -3079,10 +4604,42 @@ _apictl_import()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--callback-port=")
+    two_word_flags+=("--callback-port")
+    local_nonpersistent_flags+=("--callback-port")
+    local_nonpersistent_flags+=("--callback-port=")
+    flags+=("--client-id=")
+    two_word_flags+=("--client-id")
+    local_nonpersistent_flags+=("--client-id")
+    local_nonpersistent_flags+=("--client-id=")
+    flags+=("--client-secret=")
+    two_word_flags+=("--client-secret")
+    local_nonpersistent_flags+=("--client-secret")
+    local_nonpersistent_flags+=("--client-secret=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--sso")
+    local_nonpersistent_flags+=("--sso")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3092,9 +4649,9 @@ _apictl_import()
     noun_aliases=()
 }
 
-_apictl_init()
+_apictl_logout()
 {
-    last_command="apictl_init"
+    last_command="apictl_logout"
 
     command_aliases=()
 
@@ -3106,28 +4663,10 @@ _apictl_init()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--definition=")
-    two_word_flags+=("--definition")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--definition")
-    local_nonpersistent_flags+=("--definition=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--force")
-    flags+=("-f")
-    local_nonpersistent_flags+=("--force")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--initial-state=")
-    two_word_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state=")
-    flags+=("--oas=")
-    two_word_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3137,9 +4676,9 @@ _apictl_init()
     noun_aliases=()
 }
 
-_apictl_k8s_add_api()
+_apictl_map_help()
 {
-    last_command="apictl_k8s_add_api"
+    last_command="apictl_map_help"
 
     command_aliases=()
 
@@ -3151,42 +4690,162 @@ _apictl_k8s_add_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_map_keys()
+{
+    last_command="apictl_map_keys"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--consumer-key=")
+    two_word_flags+=("--consumer-key")
+    local_nonpersistent_flags+=("--consumer-key")
+    local_nonpersistent_flags+=("--consumer-key=")
+    flags+=("--consumer-secret=")
+    two_word_flags+=("--consumer-secret")
+    local_nonpersistent_flags+=("--consumer-secret")
+    local_nonpersistent_flags+=("--consumer-secret=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--consumer-key=")
+    must_have_one_flag+=("--consumer-secret=")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_add_help()
+_apictl_map()
 {
-    last_command="apictl_k8s_add_help"
+    last_command="apictl_map"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+    commands+=("keys")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_add_env()
+{
+    last_command="apictl_mg_add_env"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--adapter=")
+    two_word_flags+=("--adapter")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--adapter")
+    local_nonpersistent_flags+=("--adapter=")
+    local_nonpersistent_flags+=("-a")
+    flags+=("--ca-bundle=")
+    two_word_flags+=("--ca-bundle")
+    local_nonpersistent_flags+=("--ca-bundle")
+    local_nonpersistent_flags+=("--ca-bundle=")
+    flags+=("--client-cert=")
+    two_word_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert=")
+    flags+=("--client-key=")
+    two_word_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--proxy=")
+    two_word_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy=")
+    flags+=("--tls-renegotiation-mode=")
+    two_word_flags+=("--tls-renegotiation-mode")
+    local_nonpersistent_flags+=("--tls-renegotiation-mode")
+    local_nonpersistent_flags+=("--tls-renegotiation-mode=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--adapter=")
+    must_have_one_flag+=("-a")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_add_help()
+{
+    last_command="apictl_mg_add_help"
 
     command_aliases=()
 
@@ -3208,14 +4867,14 @@ _apictl_k8s_add_help()
     noun_aliases=()
 }
 
-_apictl_k8s_add()
+_apictl_mg_add()
 {
-    last_command="apictl_k8s_add"
+    last_command="apictl_mg_add"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
+    commands+=("env")
     commands+=("help")
 
     flags=()
@@ -3237,9 +4896,9 @@ _apictl_k8s_add()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_api()
+_apictl_mg_deploy_api()
 {
-    last_command="apictl_k8s_delete_api"
+    last_command="apictl_mg_deploy_api"
 
     command_aliases=()
 
@@ -3251,30 +4910,44 @@ _apictl_k8s_delete_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
+    flags+=("--override")
+    flags+=("-o")
+    local_nonpersistent_flags+=("--override")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_help()
+_apictl_mg_deploy_help()
 {
-    last_command="apictl_k8s_delete_help"
+    last_command="apictl_mg_deploy_help"
 
     command_aliases=()
 
@@ -3296,9 +4969,9 @@ _apictl_k8s_delete_help()
     noun_aliases=()
 }
 
-_apictl_k8s_delete()
+_apictl_mg_deploy()
 {
-    last_command="apictl_k8s_delete"
+    last_command="apictl_mg_deploy"
 
     command_aliases=()
 
@@ -3325,9 +4998,9 @@ _apictl_k8s_delete()
     noun_aliases=()
 }
 
-_apictl_k8s_gen_deployment-dir()
+_apictl_mg_get_apis()
 {
-    last_command="apictl_k8s_gen_deployment-dir"
+    last_command="apictl_mg_get_apis"
 
     command_aliases=()
 
@@ -3339,36 +5012,42 @@ _apictl_k8s_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_gen_help()
+_apictl_mg_get_help()
 {
-    last_command="apictl_k8s_gen_help"
+    last_command="apictl_mg_get_help"
 
     command_aliases=()
 
@@ -3390,14 +5069,14 @@ _apictl_k8s_gen_help()
     noun_aliases=()
 }
 
-_apictl_k8s_gen()
+_apictl_mg_get()
 {
-    last_command="apictl_k8s_gen"
+    last_command="apictl_mg_get"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
+    commands+=("apis")
     commands+=("help")
 
     flags=()
@@ -3419,9 +5098,9 @@ _apictl_k8s_gen()
     noun_aliases=()
 }
 
-_apictl_k8s_help()
+_apictl_mg_help()
 {
-    last_command="apictl_k8s_help"
+    last_command="apictl_mg_help"
 
     command_aliases=()
 
@@ -3443,9 +5122,9 @@ _apictl_k8s_help()
     noun_aliases=()
 }
 
-_apictl_k8s_update_api()
+_apictl_mg_login()
 {
-    last_command="apictl_k8s_update_api"
+    last_command="apictl_mg_login"
 
     command_aliases=()
 
@@ -3457,42 +5136,36 @@ _apictl_k8s_update_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_update_help()
+_apictl_mg_logout()
 {
-    last_command="apictl_k8s_update_help"
+    last_command="apictl_mg_logout"
 
     command_aliases=()
 
@@ -3504,25 +5177,26 @@ _apictl_k8s_update_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_update()
+_apictl_mg_remove_env()
 {
-    last_command="apictl_k8s_update"
+    last_command="apictl_mg_remove_env"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3543,18 +5217,13 @@ _apictl_k8s_update()
     noun_aliases=()
 }
 
-_apictl_k8s()
+_apictl_mg_remove_help()
 {
-    last_command="apictl_k8s"
+    last_command="apictl_mg_remove_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("delete")
-    commands+=("gen")
-    commands+=("help")
-    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -3562,26 +5231,25 @@ _apictl_k8s()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_login()
+_apictl_mg_remove()
 {
-    last_command="apictl_login"
+    last_command="apictl_mg_remove"
 
     command_aliases=()
 
     commands=()
+    commands+=("env")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3593,24 +5261,6 @@ _apictl_login()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--token=")
-    two_word_flags+=("--token")
-    local_nonpersistent_flags+=("--token")
-    local_nonpersistent_flags+=("--token=")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3620,9 +5270,9 @@ _apictl_login()
     noun_aliases=()
 }
 
-_apictl_logout()
+_apictl_mg_undeploy_api()
 {
-    last_command="apictl_logout"
+    last_command="apictl_mg_undeploy_api"
 
     command_aliases=()
 
@@ -3634,22 +5284,58 @@ _apictl_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
+    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_add_env()
+_apictl_mg_undeploy_help()
 {
-    last_command="apictl_mg_add_env"
+    last_command="apictl_mg_undeploy_help"
 
     command_aliases=()
 
@@ -3661,34 +5347,25 @@ _apictl_mg_add_env()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--adapter=")
-    two_word_flags+=("--adapter")
-    two_word_flags+=("-a")
-    local_nonpersistent_flags+=("--adapter")
-    local_nonpersistent_flags+=("--adapter=")
-    local_nonpersistent_flags+=("-a")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--adapter=")
-    must_have_one_flag+=("-a")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_add_help()
+_apictl_mg_undeploy()
 {
-    last_command="apictl_mg_add_help"
+    last_command="apictl_mg_undeploy"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3696,25 +5373,34 @@ _apictl_mg_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_add()
+_apictl_mg()
 {
-    last_command="apictl_mg_add"
+    last_command="apictl_mg"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
+    commands+=("add")
+    commands+=("deploy")
+    commands+=("get")
     commands+=("help")
+    commands+=("login")
+    commands+=("logout")
+    commands+=("remove")
+    commands+=("undeploy")
 
     flags=()
     two_word_flags=()
@@ -3735,9 +5421,9 @@ _apictl_mg_add()
     noun_aliases=()
 }
 
-_apictl_mg_deploy_api()
+_apictl_mi_activate_endpoint()
 {
-    last_command="apictl_mg_deploy_api"
+    last_command="apictl_mi_activate_endpoint"
 
     command_aliases=()
 
@@ -3755,22 +5441,10 @@ _apictl_mg_deploy_api()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--override")
-    flags+=("-o")
-    local_nonpersistent_flags+=("--override")
-    local_nonpersistent_flags+=("-o")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3778,15 +5452,13 @@ _apictl_mg_deploy_api()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_deploy_help()
+_apictl_mi_activate_help()
 {
-    last_command="apictl_mg_deploy_help"
+    last_command="apictl_mi_activate_help"
 
     command_aliases=()
 
@@ -3808,15 +5480,13 @@ _apictl_mg_deploy_help()
     noun_aliases=()
 }
 
-_apictl_mg_deploy()
+_apictl_mi_activate_message-processor()
 {
-    last_command="apictl_mg_deploy"
+    last_command="apictl_mi_activate_message-processor"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3824,6 +5494,12 @@ _apictl_mg_deploy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -3833,13 +5509,15 @@ _apictl_mg_deploy()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_get_apis()
+_apictl_mi_activate_proxy-service()
 {
-    last_command="apictl_mg_get_apis"
+    last_command="apictl_mi_activate_proxy-service"
 
     command_aliases=()
 
@@ -3861,18 +5539,6 @@ _apictl_mg_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -3884,13 +5550,17 @@ _apictl_mg_get_apis()
     noun_aliases=()
 }
 
-_apictl_mg_get_help()
+_apictl_mi_activate()
 {
-    last_command="apictl_mg_get_help"
+    last_command="apictl_mi_activate"
 
     command_aliases=()
 
     commands=()
+    commands+=("endpoint")
+    commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -3898,25 +5568,26 @@ _apictl_mg_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_get()
+_apictl_mi_add_help()
 {
-    last_command="apictl_mg_get"
+    last_command="apictl_mi_add_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("apis")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3924,22 +5595,19 @@ _apictl_mg_get()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_help()
+_apictl_mi_add_log-level()
 {
-    last_command="apictl_mg_help"
+    last_command="apictl_mi_add_log-level"
 
     command_aliases=()
 
@@ -3951,19 +5619,30 @@ _apictl_mg_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_login()
+_apictl_mi_add_role()
 {
-    last_command="apictl_mg_login"
+    last_command="apictl_mi_add_role"
 
     command_aliases=()
 
@@ -3975,36 +5654,30 @@ _apictl_mg_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_logout()
+_apictl_mi_add_user()
 {
-    last_command="apictl_mg_logout"
+    last_command="apictl_mi_add_user"
 
     command_aliases=()
 
@@ -4016,6 +5689,12 @@ _apictl_mg_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4025,17 +5704,23 @@ _apictl_mg_logout()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_remove_env()
+_apictl_mi_add()
 {
-    last_command="apictl_mg_remove_env"
+    last_command="apictl_mi_add"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("log-level")
+    commands+=("role")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4056,9 +5741,9 @@ _apictl_mg_remove_env()
     noun_aliases=()
 }
 
-_apictl_mg_remove_help()
+_apictl_mi_deactivate_endpoint()
 {
-    last_command="apictl_mg_remove_help"
+    last_command="apictl_mi_deactivate_endpoint"
 
     command_aliases=()
 
@@ -4070,25 +5755,34 @@ _apictl_mg_remove_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_remove()
+_apictl_mi_deactivate_help()
 {
-    last_command="apictl_mg_remove"
+    last_command="apictl_mi_deactivate_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -4096,22 +5790,19 @@ _apictl_mg_remove()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_undeploy_api()
+_apictl_mi_deactivate_message-processor()
 {
-    last_command="apictl_mg_undeploy_api"
+    last_command="apictl_mi_deactivate_message-processor"
 
     command_aliases=()
 
@@ -4129,34 +5820,10 @@ _apictl_mg_undeploy_api()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--gateway-env=")
-    two_word_flags+=("--gateway-env")
-    two_word_flags+=("-g")
-    local_nonpersistent_flags+=("--gateway-env")
-    local_nonpersistent_flags+=("--gateway-env=")
-    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
-    flags+=("--vhost=")
-    two_word_flags+=("--vhost")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--vhost")
-    local_nonpersistent_flags+=("--vhost=")
-    local_nonpersistent_flags+=("-t")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4164,17 +5831,13 @@ _apictl_mg_undeploy_api()
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_undeploy_help()
+_apictl_mi_deactivate_proxy-service()
 {
-    last_command="apictl_mg_undeploy_help"
+    last_command="apictl_mi_deactivate_proxy-service"
 
     command_aliases=()
 
@@ -4186,25 +5849,38 @@ _apictl_mg_undeploy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_undeploy()
+_apictl_mi_deactivate()
 {
-    last_command="apictl_mg_undeploy"
+    last_command="apictl_mi_deactivate"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
+    commands+=("endpoint")
     commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -4225,21 +5901,13 @@ _apictl_mg_undeploy()
     noun_aliases=()
 }
 
-_apictl_mg()
+_apictl_mi_delete_help()
 {
-    last_command="apictl_mg"
+    last_command="apictl_mi_delete_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("deploy")
-    commands+=("get")
-    commands+=("help")
-    commands+=("login")
-    commands+=("logout")
-    commands+=("remove")
-    commands+=("undeploy")
 
     flags=()
     two_word_flags=()
@@ -4247,22 +5915,19 @@ _apictl_mg()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_activate_endpoint()
+_apictl_mi_delete_role()
 {
-    last_command="apictl_mi_activate_endpoint"
+    last_command="apictl_mi_delete_role"
 
     command_aliases=()
 
@@ -4274,6 +5939,12 @@ _apictl_mi_activate_endpoint()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -4295,9 +5966,9 @@ _apictl_mi_activate_endpoint()
     noun_aliases=()
 }
 
-_apictl_mi_activate_help()
+_apictl_mi_delete_user()
 {
-    last_command="apictl_mi_activate_help"
+    last_command="apictl_mi_delete_user"
 
     command_aliases=()
 
@@ -4309,23 +5980,43 @@ _apictl_mi_activate_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_activate_message-processor()
+_apictl_mi_delete()
 {
-    last_command="apictl_mi_activate_message-processor"
+    last_command="apictl_mi_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("role")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4333,12 +6024,6 @@ _apictl_mi_activate_message-processor()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4348,15 +6033,13 @@ _apictl_mi_activate_message-processor()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_activate_proxy-service()
+_apictl_mi_get_apis()
 {
-    last_command="apictl_mi_activate_proxy-service"
+    last_command="apictl_mi_get_apis"
 
     command_aliases=()
 
@@ -4374,6 +6057,10 @@ _apictl_mi_activate_proxy-service()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4389,17 +6076,13 @@ _apictl_mi_activate_proxy-service()
     noun_aliases=()
 }
 
-_apictl_mi_activate()
+_apictl_mi_get_composite-apps()
 {
-    last_command="apictl_mi_activate"
+    last_command="apictl_mi_get_composite-apps"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
-    commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -4407,6 +6090,16 @@ _apictl_mi_activate()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4416,13 +6109,15 @@ _apictl_mi_activate()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_add_help()
+_apictl_mi_get_connectors()
 {
-    last_command="apictl_mi_add_help"
+    last_command="apictl_mi_get_connectors"
 
     command_aliases=()
 
@@ -4434,19 +6129,34 @@ _apictl_mi_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_add_log-level()
+_apictl_mi_get_data-services()
 {
-    last_command="apictl_mi_add_log-level"
+    last_command="apictl_mi_get_data-services"
 
     command_aliases=()
 
@@ -4464,6 +6174,10 @@ _apictl_mi_add_log-level()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4479,9 +6193,9 @@ _apictl_mi_add_log-level()
     noun_aliases=()
 }
 
-_apictl_mi_add_role()
+_apictl_mi_get_endpoints()
 {
-    last_command="apictl_mi_add_role"
+    last_command="apictl_mi_get_endpoints"
 
     command_aliases=()
 
@@ -4499,6 +6213,10 @@ _apictl_mi_add_role()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4514,9 +6232,9 @@ _apictl_mi_add_role()
     noun_aliases=()
 }
 
-_apictl_mi_add_user()
+_apictl_mi_get_help()
 {
-    last_command="apictl_mi_add_user"
+    last_command="apictl_mi_get_help"
 
     command_aliases=()
 
@@ -4528,38 +6246,23 @@ _apictl_mi_add_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_add()
+_apictl_mi_get_inbound-endpoints()
 {
-    last_command="apictl_mi_add"
+    last_command="apictl_mi_get_inbound-endpoints"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("log-level")
-    commands+=("role")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4567,6 +6270,16 @@ _apictl_mi_add()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4576,13 +6289,15 @@ _apictl_mi_add()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_endpoint()
+_apictl_mi_get_local-entries()
 {
-    last_command="apictl_mi_deactivate_endpoint"
+    last_command="apictl_mi_get_local-entries"
 
     command_aliases=()
 
@@ -4600,6 +6315,10 @@ _apictl_mi_deactivate_endpoint()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4615,9 +6334,9 @@ _apictl_mi_deactivate_endpoint()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_help()
+_apictl_mi_get_log-levels()
 {
-    last_command="apictl_mi_deactivate_help"
+    last_command="apictl_mi_get_log-levels"
 
     command_aliases=()
 
@@ -4629,19 +6348,34 @@ _apictl_mi_deactivate_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_message-processor()
+_apictl_mi_get_logs()
 {
-    last_command="apictl_mi_deactivate_message-processor"
+    last_command="apictl_mi_get_logs"
 
     command_aliases=()
 
@@ -4659,10 +6393,20 @@ _apictl_mi_deactivate_message-processor()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4674,9 +6418,9 @@ _apictl_mi_deactivate_message-processor()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_proxy-service()
+_apictl_mi_get_message-processors()
 {
-    last_command="apictl_mi_deactivate_proxy-service"
+    last_command="apictl_mi_get_message-processors"
 
     command_aliases=()
 
@@ -4694,6 +6438,10 @@ _apictl_mi_deactivate_proxy-service()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4709,17 +6457,13 @@ _apictl_mi_deactivate_proxy-service()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate()
+_apictl_mi_get_message-stores()
 {
-    last_command="apictl_mi_deactivate"
+    last_command="apictl_mi_get_message-stores"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
-    commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -4727,6 +6471,16 @@ _apictl_mi_deactivate()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4736,13 +6490,15 @@ _apictl_mi_deactivate()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_delete_help()
+_apictl_mi_get_proxy-services()
 {
-    last_command="apictl_mi_delete_help"
+    last_command="apictl_mi_get_proxy-services"
 
     command_aliases=()
 
@@ -4754,19 +6510,34 @@ _apictl_mi_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_delete_role()
+_apictl_mi_get_roles()
 {
-    last_command="apictl_mi_delete_role"
+    last_command="apictl_mi_get_roles"
 
     command_aliases=()
 
@@ -4790,6 +6561,10 @@ _apictl_mi_delete_role()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4805,9 +6580,9 @@ _apictl_mi_delete_role()
     noun_aliases=()
 }
 
-_apictl_mi_delete_user()
+_apictl_mi_get_sequences()
 {
-    last_command="apictl_mi_delete_user"
+    last_command="apictl_mi_get_sequences"
 
     command_aliases=()
 
@@ -4819,18 +6594,16 @@ _apictl_mi_delete_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4846,16 +6619,13 @@ _apictl_mi_delete_user()
     noun_aliases=()
 }
 
-_apictl_mi_delete()
+_apictl_mi_get_tasks()
 {
-    last_command="apictl_mi_delete"
+    last_command="apictl_mi_get_tasks"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("role")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -4863,6 +6633,16 @@ _apictl_mi_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -4872,13 +6652,15 @@ _apictl_mi_delete()
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_apis()
+_apictl_mi_get_templates()
 {
-    last_command="apictl_mi_get_apis"
+    last_command="apictl_mi_get_templates"
 
     command_aliases=()
 
@@ -4915,9 +6697,9 @@ _apictl_mi_get_apis()
     noun_aliases=()
 }
 
-_apictl_mi_get_composite-apps()
+_apictl_mi_get_transaction-counts()
 {
-    last_command="apictl_mi_get_composite-apps"
+    last_command="apictl_mi_get_transaction-counts"
 
     command_aliases=()
 
@@ -4954,9 +6736,9 @@ _apictl_mi_get_composite-apps()
     noun_aliases=()
 }
 
-_apictl_mi_get_connectors()
+_apictl_mi_get_transaction-reports()
 {
-    last_command="apictl_mi_get_connectors"
+    last_command="apictl_mi_get_transaction-reports"
 
     command_aliases=()
 
@@ -4974,14 +6756,16 @@ _apictl_mi_get_connectors()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -4993,9 +6777,9 @@ _apictl_mi_get_connectors()
     noun_aliases=()
 }
 
-_apictl_mi_get_data-services()
+_apictl_mi_get_users()
 {
-    last_command="apictl_mi_get_data-services"
+    last_command="apictl_mi_get_users"
 
     command_aliases=()
 
@@ -5007,6 +6791,12 @@ _apictl_mi_get_data-services()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -5021,6 +6811,18 @@ _apictl_mi_get_data-services()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--role=")
+    two_word_flags+=("--role")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--role")
+    local_nonpersistent_flags+=("--role=")
+    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
@@ -5032,13 +6834,33 @@ _apictl_mi_get_data-services()
     noun_aliases=()
 }
 
-_apictl_mi_get_endpoints()
+_apictl_mi_get()
 {
-    last_command="apictl_mi_get_endpoints"
+    last_command="apictl_mi_get"
 
     command_aliases=()
 
     commands=()
+    commands+=("apis")
+    commands+=("composite-apps")
+    commands+=("connectors")
+    commands+=("data-services")
+    commands+=("endpoints")
+    commands+=("help")
+    commands+=("inbound-endpoints")
+    commands+=("local-entries")
+    commands+=("log-levels")
+    commands+=("logs")
+    commands+=("message-processors")
+    commands+=("message-stores")
+    commands+=("proxy-services")
+    commands+=("roles")
+    commands+=("sequences")
+    commands+=("tasks")
+    commands+=("templates")
+    commands+=("transaction-counts")
+    commands+=("transaction-reports")
+    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -5046,16 +6868,6 @@ _apictl_mi_get_endpoints()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5065,15 +6877,13 @@ _apictl_mi_get_endpoints()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_help()
+_apictl_mi_help()
 {
-    last_command="apictl_mi_get_help"
+    last_command="apictl_mi_help"
 
     command_aliases=()
 
@@ -5095,9 +6905,9 @@ _apictl_mi_get_help()
     noun_aliases=()
 }
 
-_apictl_mi_get_inbound-endpoints()
+_apictl_mi_login()
 {
-    last_command="apictl_mi_get_inbound-endpoints"
+    last_command="apictl_mi_login"
 
     command_aliases=()
 
@@ -5109,34 +6919,36 @@ _apictl_mi_get_inbound-endpoints()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_local-entries()
+_apictl_mi_logout()
 {
-    last_command="apictl_mi_get_local-entries"
+    last_command="apictl_mi_logout"
 
     command_aliases=()
 
@@ -5148,16 +6960,6 @@ _apictl_mi_get_local-entries()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5167,15 +6969,13 @@ _apictl_mi_get_local-entries()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_log-levels()
+_apictl_mi_update_hashicorp-secret()
 {
-    last_command="apictl_mi_get_log-levels"
+    last_command="apictl_mi_update_hashicorp-secret"
 
     command_aliases=()
 
@@ -5193,10 +6993,6 @@ _apictl_mi_get_log-levels()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5212,9 +7008,9 @@ _apictl_mi_get_log-levels()
     noun_aliases=()
 }
 
-_apictl_mi_get_logs()
+_apictl_mi_update_help()
 {
-    last_command="apictl_mi_get_logs"
+    last_command="apictl_mi_update_help"
 
     command_aliases=()
 
@@ -5226,40 +7022,19 @@ _apictl_mi_get_logs()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
-    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_message-processors()
+_apictl_mi_update_log-level()
 {
-    last_command="apictl_mi_get_message-processors"
+    last_command="apictl_mi_update_log-level"
 
     command_aliases=()
 
@@ -5277,10 +7052,6 @@ _apictl_mi_get_message-processors()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5296,9 +7067,9 @@ _apictl_mi_get_message-processors()
     noun_aliases=()
 }
 
-_apictl_mi_get_message-stores()
+_apictl_mi_update_user()
 {
-    last_command="apictl_mi_get_message-stores"
+    last_command="apictl_mi_update_user"
 
     command_aliases=()
 
@@ -5316,10 +7087,6 @@ _apictl_mi_get_message-stores()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5335,13 +7102,17 @@ _apictl_mi_get_message-stores()
     noun_aliases=()
 }
 
-_apictl_mi_get_proxy-services()
+_apictl_mi_update()
 {
-    last_command="apictl_mi_get_proxy-services"
+    last_command="apictl_mi_update"
 
     command_aliases=()
 
     commands=()
+    commands+=("hashicorp-secret")
+    commands+=("help")
+    commands+=("log-level")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -5349,16 +7120,6 @@ _apictl_mi_get_proxy-services()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5368,19 +7129,26 @@ _apictl_mi_get_proxy-services()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_roles()
+_apictl_mi()
 {
-    last_command="apictl_mi_get_roles"
+    last_command="apictl_mi"
 
     command_aliases=()
 
     commands=()
+    commands+=("activate")
+    commands+=("add")
+    commands+=("deactivate")
+    commands+=("delete")
+    commands+=("get")
+    commands+=("help")
+    commands+=("login")
+    commands+=("logout")
+    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -5388,22 +7156,6 @@ _apictl_mi_get_roles()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5413,15 +7165,13 @@ _apictl_mi_get_roles()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_sequences()
+_apictl_params_help()
 {
-    last_command="apictl_mi_get_sequences"
+    last_command="apictl_params_help"
 
     command_aliases=()
 
@@ -5433,34 +7183,19 @@ _apictl_mi_get_sequences()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_tasks()
+_apictl_params_render()
 {
-    last_command="apictl_mi_get_tasks"
+    last_command="apictl_params_render"
 
     command_aliases=()
 
@@ -5472,55 +7207,51 @@ _apictl_mi_get_tasks()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
+    flags+=("--allow-exec-secrets")
+    local_nonpersistent_flags+=("--allow-exec-secrets")
+    flags+=("--env=")
+    two_word_flags+=("--env")
     two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("--env")
+    local_nonpersistent_flags+=("--env=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--show-secrets")
+    local_nonpersistent_flags+=("--show-secrets")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("--env=")
     must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_templates()
+_apictl_params()
 {
-    last_command="apictl_mi_get_templates"
+    last_command="apictl_params"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("render")
 
     flags=()
     two_word_flags=()
     local_nonpersistent_flags=()
     flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
+    flags_completion=()
+
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5530,15 +7261,13 @@ _apictl_mi_get_templates()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-counts()
+_apictl_plugin_help()
 {
-    last_command="apictl_mi_get_transaction-counts"
+    last_command="apictl_plugin_help"
 
     command_aliases=()
 
@@ -5550,34 +7279,19 @@ _apictl_mi_get_transaction-counts()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-reports()
+_apictl_plugin_list()
 {
-    last_command="apictl_mi_get_transaction-reports"
+    last_command="apictl_plugin_list"
 
     command_aliases=()
 
@@ -5589,40 +7303,32 @@ _apictl_mi_get_transaction-reports()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
-    local_nonpersistent_flags+=("-p")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_users()
+_apictl_plugin()
 {
-    last_command="apictl_mi_get_users"
+    last_command="apictl_plugin"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("list")
 
     flags=()
     two_word_flags=()
@@ -5630,76 +7336,26 @@ _apictl_mi_get_users()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--pattern=")
-    two_word_flags+=("--pattern")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--pattern")
-    local_nonpersistent_flags+=("--pattern=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--role=")
-    two_word_flags+=("--role")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--role")
-    local_nonpersistent_flags+=("--role=")
-    local_nonpersistent_flags+=("-r")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get()
+_apictl_promote_api()
 {
-    last_command="apictl_mi_get"
+    last_command="apictl_promote_api"
 
     command_aliases=()
 
     commands=()
-    commands+=("apis")
-    commands+=("composite-apps")
-    commands+=("connectors")
-    commands+=("data-services")
-    commands+=("endpoints")
-    commands+=("help")
-    commands+=("inbound-endpoints")
-    commands+=("local-entries")
-    commands+=("log-levels")
-    commands+=("logs")
-    commands+=("message-processors")
-    commands+=("message-stores")
-    commands+=("proxy-services")
-    commands+=("roles")
-    commands+=("sequences")
-    commands+=("tasks")
-    commands+=("templates")
-    commands+=("transaction-counts")
-    commands+=("transaction-reports")
-    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -5707,22 +7363,78 @@ _apictl_mi_get()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--from=")
+    two_word_flags+=("--from")
+    local_nonpersistent_flags+=("--from")
+    local_nonpersistent_flags+=("--from=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--to=")
+    two_word_flags+=("--to")
+    local_nonpersistent_flags+=("--to")
+    local_nonpersistent_flags+=("--to=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--with-api-policies")
+    local_nonpersistent_flags+=("--with-api-policies")
+    flags+=("--with-throttling-policies")
+    local_nonpersistent_flags+=("--with-throttling-policies")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--from=")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--to=")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_help()
+_apictl_promote_api-product()
 {
-    last_command="apictl_mi_help"
+    last_command="apictl_promote_api-product"
 
     command_aliases=()
 
@@ -5734,19 +7446,80 @@ _apictl_mi_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--from=")
+    two_word_flags+=("--from")
+    local_nonpersistent_flags+=("--from")
+    local_nonpersistent_flags+=("--from=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--import-apis")
+    local_nonpersistent_flags+=("--import-apis")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--to=")
+    two_word_flags+=("--to")
+    local_nonpersistent_flags+=("--to")
+    local_nonpersistent_flags+=("--to=")
+    flags+=("--update-apis")
+    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--with-throttling-policies")
+    local_nonpersistent_flags+=("--with-throttling-policies")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--from=")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--to=")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_login()
+_apictl_promote_app()
 {
-    last_command="apictl_mi_login"
+    last_command="apictl_promote_app"
 
     command_aliases=()
 
@@ -5758,36 +7531,60 @@ _apictl_mi_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--from=")
+    two_word_flags+=("--from")
+    local_nonpersistent_flags+=("--from")
+    local_nonpersistent_flags+=("--from=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserve-owner")
+    local_nonpersistent_flags+=("--preserve-owner")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-subscriptions")
+    flags+=("-s")
+    local_nonpersistent_flags+=("--skip-subscriptions")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--to=")
+    two_word_flags+=("--to")
+    local_nonpersistent_flags+=("--to")
+    local_nonpersistent_flags+=("--to=")
+    flags+=("--with-keys")
+    local_nonpersistent_flags+=("--with-keys")
+    flags+=("--with-throttling-policies")
+    local_nonpersistent_flags+=("--with-throttling-policies")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--from=")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--owner=")
+    must_have_one_flag+=("-o")
+    must_have_one_flag+=("--to=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_logout()
+_apictl_promote_help()
 {
-    last_command="apictl_mi_logout"
+    last_command="apictl_promote_help"
 
     command_aliases=()
 
@@ -5799,26 +7596,27 @@ _apictl_mi_logout()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_update_hashicorp-secret()
+_apictl_promote()
 {
-    last_command="apictl_mi_update_hashicorp-secret"
+    last_command="apictl_promote"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -5826,12 +7624,6 @@ _apictl_mi_update_hashicorp-secret()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5841,15 +7633,13 @@ _apictl_mi_update_hashicorp-secret()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_help()
+_apictl_regenerate_help()
 {
-    last_command="apictl_mi_update_help"
+    last_command="apictl_regenerate_help"
 
     command_aliases=()
 
@@ -5871,9 +7661,9 @@ _apictl_mi_update_help()
     noun_aliases=()
 }
 
-_apictl_mi_update_log-level()
+_apictl_regenerate_keys()
 {
-    last_command="apictl_mi_update_log-level"
+    last_command="apictl_regenerate_keys"
 
     command_aliases=()
 
@@ -5895,24 +7685,44 @@ _apictl_mi_update_log-level()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--revoke-token=")
+    two_word_flags+=("--revoke-token")
+    local_nonpersistent_flags+=("--revoke-token")
+    local_nonpersistent_flags+=("--revoke-token=")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_user()
+_apictl_regenerate()
 {
-    last_command="apictl_mi_update_user"
+    last_command="apictl_regenerate"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("keys")
 
     flags=()
     two_word_flags=()
@@ -5920,12 +7730,6 @@ _apictl_mi_update_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -5935,23 +7739,17 @@ _apictl_mi_update_user()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update()
+_apictl_remove_env()
 {
-    last_command="apictl_mi_update"
+    last_command="apictl_remove_env"
 
     command_aliases=()
 
     commands=()
-    commands+=("hashicorp-secret")
-    commands+=("help")
-    commands+=("log-level")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -5972,22 +7770,13 @@ _apictl_mi_update()
     noun_aliases=()
 }
 
-_apictl_mi()
+_apictl_remove_help()
 {
-    last_command="apictl_mi"
+    last_command="apictl_remove_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("activate")
-    commands+=("add")
-    commands+=("deactivate")
-    commands+=("delete")
-    commands+=("get")
-    commands+=("help")
-    commands+=("login")
-    commands+=("logout")
-    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -5995,26 +7784,25 @@ _apictl_mi()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
     flags+=("--insecure")
     flags+=("-k")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_remove_env()
+_apictl_remove()
 {
-    last_command="apictl_remove_env"
+    last_command="apictl_remove"
 
     command_aliases=()
 
     commands=()
+    commands+=("env")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -6035,9 +7823,9 @@ _apictl_remove_env()
     noun_aliases=()
 }
 
-_apictl_remove_help()
+_apictl_revoke_help()
 {
-    last_command="apictl_remove_help"
+    last_command="apictl_revoke_help"
 
     command_aliases=()
 
@@ -6059,15 +7847,69 @@ _apictl_remove_help()
     noun_aliases=()
 }
 
-_apictl_remove()
+_apictl_revoke_token()
 {
-    last_command="apictl_remove"
+    last_command="apictl_revoke_token"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--key-manager=")
+    two_word_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager")
+    local_nonpersistent_flags+=("--key-manager=")
+    flags+=("--key-type=")
+    two_word_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type")
+    local_nonpersistent_flags+=("--key-type=")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--token=")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_revoke()
+{
+    last_command="apictl_revoke"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
     commands+=("help")
+    commands+=("token")
 
     flags=()
     two_word_flags=()
@@ -6259,13 +8101,57 @@ _apictl_set_api-logging()
     must_have_one_flag=()
     must_have_one_flag+=("--api-id=")
     must_have_one_flag+=("-i")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--log-level=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
+_apictl_set_app-throttling-policy()
+{
+    last_command="apictl_set_app-throttling-policy"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--throttling-policy=")
+    two_word_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy")
+    local_nonpersistent_flags+=("--throttling-policy=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--throttling-policy=")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_set_correlation-logging()
 {
     last_command="apictl_set_correlation-logging"
@@ -6311,8 +8197,6 @@ _apictl_set_correlation-logging()
     must_have_one_flag=()
     must_have_one_flag+=("--component-name=")
     must_have_one_flag+=("-i")
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
@@ -6349,6 +8233,7 @@ _apictl_set()
 
     commands=()
     commands+=("api-logging")
+    commands+=("app-throttling-policy")
     commands+=("correlation-logging")
     commands+=("help")
 
@@ -6462,8 +8347,6 @@ _apictl_undeploy_api()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--rev=")
@@ -6530,8 +8413,6 @@ _apictl_undeploy_api-product()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
     must_have_one_flag+=("--rev=")
@@ -6595,6 +8476,112 @@ _apictl_undeploy()
     noun_aliases=()
 }
 
+_apictl_validate_api()
+{
+    last_command="apictl_validate_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--allow-exec-secrets")
+    local_nonpersistent_flags+=("--allow-exec-secrets")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_validate_help()
+{
+    last_command="apictl_validate_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_validate()
+{
+    last_command="apictl_validate"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _apictl_vcs_deploy()
 {
     last_command="apictl_vcs_deploy"
@@ -6609,6 +8596,8 @@ _apictl_vcs_deploy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--allow-exec-secrets")
+    local_nonpersistent_flags+=("--allow-exec-secrets")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -6626,8 +8615,6 @@ _apictl_vcs_deploy()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
@@ -6720,8 +8707,6 @@ _apictl_vcs_status()
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
@@ -6796,7 +8781,9 @@ _apictl_root_command()
     commands+=("aws")
     commands+=("bundle")
     commands+=("change-status")
+    commands+=("config")
     commands+=("delete")
+    commands+=("env")
     commands+=("export")
     commands+=("gen")
     commands+=("get")
@@ -6806,12 +8793,19 @@ _apictl_root_command()
     commands+=("k8s")
     commands+=("login")
     commands+=("logout")
+    commands+=("map")
     commands+=("mg")
     commands+=("mi")
+    commands+=("params")
+    commands+=("plugin")
+    commands+=("promote")
+    commands+=("regenerate")
     commands+=("remove")
+    commands+=("revoke")
     commands+=("secret")
     commands+=("set")
     commands+=("undeploy")
+    commands+=("validate")
     commands+=("vcs")
     commands+=("version")
 
@@ -0,0 +1,355 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package overlay
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// nodeRef refers to a node of the definition through its parent, so that the node can be replaced
+type nodeRef struct {
+	get func() interface{}
+	set func(interface{})
+}
+
+// selector is a segment of a JSONPath expression
+type selector struct {
+	descendant bool
+	wildcard   bool
+	names      []string
+	indices    []int
+	filter     *filter
+}
+
+// filter selects the children for which the value at the path exists, or compares to the value
+type filter struct {
+	path     []string
+	operator string
+	value    string
+}
+
+// parsePath parses the supported subset of JSONPath
+func parsePath(path string) ([]selector, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid target %q: a target should start with $", path)
+	}
+	var selectors []selector
+	for i := 1; i < len(path); {
+		sel := selector{}
+		switch {
+		case strings.HasPrefix(path[i:], ".."):
+			sel.descendant = true
+			i += 2
+		case path[i] == '.':
+			i++
+		case path[i] != '[':
+			return nil, fmt.Errorf("invalid target %q: unexpected %q at %d", path, path[i], i)
+		}
+		switch {
+		case i < len(path) && path[i] == '[':
+			end := closingBracket(path, i)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid target %q: unclosed [ at %d", path, i)
+			}
+			if err := parseBracket(strings.TrimSpace(path[i+1:end]), &sel); err != nil {
+				return nil, fmt.Errorf("invalid target %q: %w", path, err)
+			}
+			i = end + 1
+		case i < len(path) && path[i] == '*':
+			sel.wildcard = true
+			i++
+		default:
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("invalid target %q: a name is missing at %d", path, i)
+			}
+			sel.names = []string{path[i:end]}
+			i = end
+		}
+		selectors = append(selectors, sel)
+	}
+	return selectors, nil
+}
+
+// closingBracket returns the index of the ] closing the [ at start, skipping the quoted strings
+func closingBracket(path string, start int) int {
+	var quote byte
+	depth := 0
+	for i := start; i < len(path); i++ {
+		switch c := path[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseBracket parses the content of a bracketed segment, which is *, a filter or a list of names and indices
+func parseBracket(content string, sel *selector) error {
+	if content == "*" {
+		sel.wildcard = true
+		return nil
+	}
+	if strings.HasPrefix(content, "?") {
+		expression := strings.TrimSpace(content[1:])
+		if strings.HasPrefix(expression, "(") && strings.HasSuffix(expression, ")") {
+			expression = strings.TrimSpace(expression[1 : len(expression)-1])
+		}
+		f, err := parseFilter(expression)
+		if err != nil {
+			return err
+		}
+		sel.filter = f
+		return nil
+	}
+	for _, part := range splitUnquoted(content, ',') {
+		part = strings.TrimSpace(part)
+		if name, ok := unquote(part); ok {
+			sel.names = append(sel.names, name)
+			continue
+		}
+		index, err := strconv.Atoi(part)
+		if err != nil {
+			return fmt.Errorf("unsupported segment [%s]", content)
+		}
+		sel.indices = append(sel.indices, index)
+	}
+	return nil
+}
+
+// parseFilter parses a filter expression of the form @.path, @.path == value or @.path != value
+func parseFilter(expression string) (*filter, error) {
+	f := &filter{}
+	left := expression
+	for _, operator := range []string{"==", "!="} {
+		if i := indexUnquoted(expression, operator); i >= 0 {
+			f.operator = operator
+			left = strings.TrimSpace(expression[:i])
+			f.value = strings.TrimSpace(expression[i+len(operator):])
+			break
+		}
+	}
+	if !strings.HasPrefix(left, "@") {
+		return nil, fmt.Errorf("unsupported filter %q: a filter should start with @", expression)
+	}
+	selectors, err := parsePath("$" + left[1:])
+	if err != nil {
+		return nil, fmt.Errorf("unsupported filter %q: %w", expression, err)
+	}
+	for _, sel := range selectors {
+		if sel.descendant || sel.wildcard || sel.filter != nil || len(sel.names) != 1 {
+			return nil, fmt.Errorf("unsupported filter %q: only the names of the properties are supported in "+
+				"the path", expression)
+		}
+		f.path = append(f.path, sel.names[0])
+	}
+	return f, nil
+}
+
+// splitUnquoted splits the string by the separator outside the quoted strings
+func splitUnquoted(s string, separator byte) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == separator:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// indexUnquoted returns the index of the first occurrence of the substring outside the quoted strings, or -1
+func indexUnquoted(s string, substring string) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case strings.HasPrefix(s[i:], substring):
+			return i
+		}
+	}
+	return -1
+}
+
+func unquote(s string) (string, bool) {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1], true
+	}
+	return "", false
+}
+
+// matches returns true if the node satisfies the filter. The values are compared as strings, and the unquoted value
+// null matches the missing values.
+func (f *filter) matches(node interface{}) bool {
+	value, exists := node, true
+	for _, name := range f.path {
+		mapping, ok := value.(yaml.MapSlice)
+		if !ok {
+			exists = false
+			break
+		}
+		if i := indexOf(mapping, name); i >= 0 {
+			value = mapping[i].Value
+		} else {
+			exists = false
+			break
+		}
+	}
+	if f.operator == "" {
+		return exists
+	}
+	expected, quoted := unquote(f.value)
+	if !quoted {
+		expected = f.value
+	}
+	equal := false
+	if !exists || value == nil {
+		equal = !quoted && expected == "null"
+	} else {
+		equal = fmt.Sprint(value) == expected
+	}
+	if f.operator == "!=" {
+		return !equal
+	}
+	return equal
+}
+
+// selectNodes returns the references to the nodes selected by the selectors from the given nodes
+func selectNodes(refs []*nodeRef, selectors []selector) []*nodeRef {
+	for _, sel := range selectors {
+		var selected []*nodeRef
+		for _, ref := range refs {
+			candidates := []*nodeRef{ref}
+			if sel.descendant {
+				candidates = descendants(ref)
+			}
+			for _, candidate := range candidates {
+				selected = append(selected, selectChildren(candidate, sel)...)
+			}
+		}
+		refs = selected
+	}
+	return refs
+}
+
+// descendants returns the given node and all the nodes under it
+func descendants(ref *nodeRef) []*nodeRef {
+	refs := []*nodeRef{ref}
+	for _, child := range children(ref) {
+		refs = append(refs, descendants(child)...)
+	}
+	return refs
+}
+
+// children returns the references to the values of an object or the items of an array
+func children(ref *nodeRef) []*nodeRef {
+	var refs []*nodeRef
+	switch node := ref.get().(type) {
+	case yaml.MapSlice:
+		for i := range node {
+			refs = append(refs, mapItemRef(node, i))
+		}
+	case []interface{}:
+		for i := range node {
+			refs = append(refs, arrayItemRef(node, i))
+		}
+	}
+	return refs
+}
+
+// selectChildren returns the children of the node selected by the selector
+func selectChildren(ref *nodeRef, sel selector) []*nodeRef {
+	if sel.wildcard {
+		return children(ref)
+	}
+	if sel.filter != nil {
+		var refs []*nodeRef
+		for _, child := range children(ref) {
+			if sel.filter.matches(child.get()) {
+				refs = append(refs, child)
+			}
+		}
+		return refs
+	}
+	var refs []*nodeRef
+	switch node := ref.get().(type) {
+	case yaml.MapSlice:
+		for _, name := range sel.names {
+			if i := indexOf(node, name); i >= 0 {
+				refs = append(refs, mapItemRef(node, i))
+			}
+		}
+	case []interface{}:
+		for _, index := range sel.indices {
+			if index < 0 {
+				index += len(node)
+			}
+			if index >= 0 && index < len(node) {
+				refs = append(refs, arrayItemRef(node, index))
+			}
+		}
+	}
+	return refs
+}
+
+func mapItemRef(mapping yaml.MapSlice, i int) *nodeRef {
+	return &nodeRef{
+		get: func() interface{} { return mapping[i].Value },
+		set: func(value interface{}) { mapping[i].Value = value },
+	}
+}
+
+func arrayItemRef(array []interface{}, i int) *nodeRef {
+	return &nodeRef{
+		get: func() interface{} { return array[i] },
+		set: func(value interface{}) { array[i] = value },
+	}
+}
@@ -0,0 +1,237 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+// Package overlay applies OpenAPI Overlay 1.0 documents to OpenAPI definitions. The targets of the actions are
+// selected with a subset of JSONPath, which supports the root $, the child segments .name, ['name'], [n] and [*],
+// the descendant segment ..name and the filters [?(@.path)], [?(@.path == value)] and [?(@.path != value)].
+package overlay
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Info is the metadata of an overlay document
+type Info struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// Action updates or removes the nodes of a definition selected by the target
+type Action struct {
+	// Target is the JSONPath expression selecting the nodes of the definition
+	Target      string `yaml:"target"`
+	Description string `yaml:"description,omitempty"`
+	// Update is merged into the selected objects or appended to the selected arrays
+	Update Value `yaml:"update,omitempty"`
+	// Remove removes the selected nodes from the definition
+	Remove bool `yaml:"remove,omitempty"`
+}
+
+// Overlay is an OpenAPI Overlay document
+type Overlay struct {
+	Overlay string   `yaml:"overlay"`
+	Info    Info     `yaml:"info"`
+	Extends string   `yaml:"extends,omitempty"`
+	Actions []Action `yaml:"actions"`
+}
+
+// Value is a YAML value decoded preserving the order of the mapping keys
+type Value struct {
+	value interface{}
+}
+
+// UnmarshalYAML decodes the mappings of the value, including the nested ones, as yaml.MapSlice
+func (v *Value) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// A sequence is decoded first, as yaml.MapSlice also accepts a sequence of mappings
+	var sequence []Value
+	if err := unmarshal(&sequence); err == nil {
+		items := make([]interface{}, len(sequence))
+		for i := range sequence {
+			items[i] = sequence[i].value
+		}
+		v.value = items
+		return nil
+	}
+	var mapping yaml.MapSlice
+	if err := unmarshal(&mapping); err == nil {
+		v.value = mapping
+		return nil
+	}
+	return unmarshal(&v.value)
+}
+
+// removed marks the nodes removed from the definition until they are swept out of their parents
+type removed struct{}
+
+// Load loads an overlay document from the given path
+func Load(path string) (*Overlay, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	overlay := &Overlay{}
+	if err := yaml.Unmarshal(data, overlay); err != nil {
+		return nil, fmt.Errorf("invalid overlay %s: %w", path, err)
+	}
+	if overlay.Overlay == "" {
+		return nil, fmt.Errorf("invalid overlay %s: the overlay version is missing", path)
+	}
+	return overlay, nil
+}
+
+// Apply applies the actions of the overlay to the definition in order, and returns the updated definition
+func (overlay *Overlay) Apply(definition interface{}) (interface{}, error) {
+	root := definition
+	rootRef := &nodeRef{
+		get: func() interface{} { return root },
+		set: func(value interface{}) { root = value },
+	}
+	for i, action := range overlay.Actions {
+		selectors, err := parsePath(action.Target)
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i+1, err)
+		}
+		targets := selectNodes([]*nodeRef{rootRef}, selectors)
+		if action.Remove {
+			for _, target := range targets {
+				target.set(removed{})
+			}
+			root = sweep(root)
+			continue
+		}
+		if action.Update.value == nil {
+			continue
+		}
+		for _, target := range targets {
+			if err := update(target, action.Update.value); err != nil {
+				return nil, fmt.Errorf("action %d with the target %s: %w", i+1, action.Target, err)
+			}
+		}
+	}
+	if _, isRemoved := root.(removed); isRemoved {
+		return nil, errors.New("the overlay removes the whole definition")
+	}
+	return root, nil
+}
+
+// ApplyToFile applies the overlays in the given paths in order to the YAML or JSON definition in definitionPath, and
+// writes back the updated definition as YAML
+func ApplyToFile(definitionPath string, overlayPaths []string) error {
+	data, err := ioutil.ReadFile(definitionPath)
+	if err != nil {
+		return err
+	}
+	var definition yaml.MapSlice
+	if err := yaml.Unmarshal(data, &definition); err != nil {
+		return err
+	}
+	var updated interface{} = definition
+	for _, overlayPath := range overlayPaths {
+		overlay, err := Load(overlayPath)
+		if err != nil {
+			return err
+		}
+		updated, err = overlay.Apply(updated)
+		if err != nil {
+			return fmt.Errorf("applying the overlay %s failed: %w", overlayPath, err)
+		}
+	}
+	content, err := yaml.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(definitionPath, content, 0644)
+}
+
+// update merges the value into the target object, or appends it to the target array
+func update(target *nodeRef, value interface{}) error {
+	switch current := target.get().(type) {
+	case yaml.MapSlice:
+		updateMap, ok := value.(yaml.MapSlice)
+		if !ok {
+			return errors.New("an object can only be updated with an object")
+		}
+		target.set(merge(current, updateMap))
+	case []interface{}:
+		if items, ok := value.([]interface{}); ok {
+			target.set(append(current, items...))
+		} else {
+			target.set(append(current, value))
+		}
+	default:
+		return errors.New("only objects and arrays can be updated")
+	}
+	return nil
+}
+
+// merge returns a copy of the base object with the properties of the update object added or replaced. The nested
+// objects are merged recursively.
+func merge(base, update yaml.MapSlice) yaml.MapSlice {
+	merged := append(yaml.MapSlice{}, base...)
+	for _, item := range update {
+		i := indexOf(merged, fmt.Sprint(item.Key))
+		if i < 0 {
+			merged = append(merged, item)
+			continue
+		}
+		baseMap, baseIsMap := merged[i].Value.(yaml.MapSlice)
+		updateMap, updateIsMap := item.Value.(yaml.MapSlice)
+		if baseIsMap && updateIsMap {
+			merged[i].Value = merge(baseMap, updateMap)
+		} else {
+			merged[i].Value = item.Value
+		}
+	}
+	return merged
+}
+
+func indexOf(mapping yaml.MapSlice, key string) int {
+	for i, item := range mapping {
+		if fmt.Sprint(item.Key) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// sweep drops the nodes marked as removed from their parents
+func sweep(node interface{}) interface{} {
+	switch n := node.(type) {
+	case yaml.MapSlice:
+		kept := yaml.MapSlice{}
+		for _, item := range n {
+			if _, isRemoved := item.Value.(removed); !isRemoved {
+				kept = append(kept, yaml.MapItem{Key: item.Key, Value: sweep(item.Value)})
+			}
+		}
+		return kept
+	case []interface{}:
+		kept := []interface{}{}
+		for _, item := range n {
+			if _, isRemoved := item.(removed); !isRemoved {
+				kept = append(kept, sweep(item))
+			}
+		}
+		return kept
+	}
+	return node
+}
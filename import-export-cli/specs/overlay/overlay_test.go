@@ -0,0 +1,105 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package overlay
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func copyToTempFile(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	tempPath := filepath.Join(t.TempDir(), filepath.Base(path))
+	assert.NoError(t, ioutil.WriteFile(tempPath, data, os.ModePerm))
+	return tempPath
+}
+
+func TestApplyToFile(t *testing.T) {
+	definitionPath := copyToTempFile(t, "testdata/petstore.yaml")
+	err := ApplyToFile(definitionPath, []string{"testdata/production.yaml"})
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadFile(definitionPath)
+	assert.NoError(t, err)
+	var definition map[string]interface{}
+	assert.NoError(t, yaml.Unmarshal(data, &definition))
+
+	servers := definition["servers"].([]interface{})
+	assert.Len(t, servers, 2, "Array update should be appended")
+	assert.Equal(t, "https://gw.prod.example.com/v1", servers[0].(map[interface{}]interface{})["url"])
+	assert.Equal(t, "https://gw-dr.prod.example.com/v1", servers[1].(map[interface{}]interface{})["url"])
+
+	pets := definition["paths"].(map[interface{}]interface{})["/pets"].(map[interface{}]interface{})
+	assert.Contains(t, pets, "get")
+	assert.NotContains(t, pets, "post", "Internal operation should be removed")
+
+	schemes := definition["components"].(map[interface{}]interface{})["securitySchemes"].(map[interface{}]interface{})
+	assert.Contains(t, schemes, "default")
+	assert.Contains(t, schemes, "apiKey")
+
+	petByID := definition["paths"].(map[interface{}]interface{})["/pets/{petId}"].(map[interface{}]interface{})
+	parameter := petByID["get"].(map[interface{}]interface{})["parameters"].([]interface{})[0].(map[interface{}]interface{})
+	assert.Equal(t, "The id of the pet", parameter["description"])
+	assert.Equal(t, true, parameter["required"])
+
+	// The order of the definition is preserved
+	var ordered yaml.MapSlice
+	assert.NoError(t, yaml.Unmarshal(data, &ordered))
+	assert.Equal(t, []interface{}{"openapi", "info", "servers", "paths", "components"},
+		[]interface{}{ordered[0].Key, ordered[1].Key, ordered[2].Key, ordered[3].Key, ordered[4].Key})
+}
+
+func TestApplyUpdatesScalarTargetFails(t *testing.T) {
+	overlay := &Overlay{Overlay: "1.0.0", Actions: []Action{
+		{Target: "$.info.title", Update: Value{value: yaml.MapSlice{{Key: "a", Value: "b"}}}},
+	}}
+	_, err := overlay.Apply(yaml.MapSlice{{Key: "info", Value: yaml.MapSlice{{Key: "title", Value: "PetStore"}}}})
+	assert.Error(t, err, "Should return an error when a scalar is updated")
+}
+
+func TestParsePath(t *testing.T) {
+	selectors, err := parsePath("$.paths['/pets'].get")
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 3)
+	assert.Equal(t, []string{"/pets"}, selectors[1].names)
+
+	selectors, err = parsePath("$..[?(@.in != 'path')]")
+	assert.NoError(t, err)
+	assert.True(t, selectors[0].descendant)
+	assert.Equal(t, "!=", selectors[0].filter.operator)
+	assert.Equal(t, []string{"in"}, selectors[0].filter.path)
+
+	_, err = parsePath("paths")
+	assert.Error(t, err, "Should return an error when the target does not start with $")
+	_, err = parsePath("$.servers[0")
+	assert.Error(t, err, "Should return an error for an unclosed bracket")
+	_, err = parsePath("$.paths[?(@..a)]")
+	assert.Error(t, err, "Should return an error for an unsupported filter")
+}
+
+func TestLoadInvalidOverlay(t *testing.T) {
+	_, err := Load("testdata/petstore.yaml")
+	assert.Error(t, err, "Should return an error when the overlay version is missing")
+}
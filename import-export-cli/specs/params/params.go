@@ -59,15 +59,22 @@ type APIIdentifier struct {
 }
 
 type Environment struct {
-	Name   string                 `yaml:"name"`
-	Config map[string]interface{} `yaml:"configs"`
+	Name string `yaml:"name"`
+	// Extends is the name of the environment or the base whose configs are inherited and overridden by Config
+	Extends string                 `yaml:"extends,omitempty"`
+	Config  map[string]interface{} `yaml:"configs"`
+	// Overlays are the paths of the OpenAPI Overlay documents applied in order to the definition of the API. The
+	// relative paths are resolved against the directory of the params file.
+	Overlays []string `yaml:"overlays,omitempty"`
 }
 
 // ApiParams represents environments defined in configuration file
 type ApiParams struct {
 	// Environments contains all environments in a configuration
 	Environments []Environment `yaml:"environments"`
-	Deploy       APIVCSParams  `yaml:"deploy"`
+	// Bases contains the blocks of configs which are only extended by the environments
+	Bases  []Environment `yaml:"bases,omitempty"`
+	Deploy APIVCSParams  `yaml:"deploy"`
 }
 
 type ApiProductParams struct {
@@ -163,42 +170,22 @@ func GetEnvSubstitutedFileContent(path string) (string, error) {
 }
 
 // LoadApiParamsFromDirectory loads an API Project configuration YAML file located in path when the root
-// directory is provided instead of yaml file.
+// directory is provided instead of yaml file. The secret references are resolved only for the given environment.
 //
 //	It returns an error or a valid ApiParams
-func LoadApiParamsFromDirectory(path string) (*ApiParams, error) {
+func LoadApiParamsFromDirectory(path, environment string) (*ApiParams, error) {
 	paramsFilePath := filepath.Join(path, utils.ParamFile)
 	utils.Logln(utils.LogPrefixInfo + "Loading params from " + paramsFilePath)
-	fileContent, err := GetEnvSubstitutedFileContent(paramsFilePath)
-	if err != nil {
-		return nil, err
-	}
-
-	apiParams := &ApiParams{}
-	err = yaml.Unmarshal([]byte(fileContent), &apiParams)
-	if err != nil {
-		return nil, err
-	}
-
-	return apiParams, err
+	return loadApiParams(paramsFilePath, environment, true)
 }
 
-// LoadApiParamsFromFile loads an API Project configuration YAML file located in path.
+// LoadApiParamsFromFile loads an API Project configuration YAML file located in path. The environments extending
+// other environments or bases are resolved, the Go-template expressions of an opted-in file and the environment
+// variables are substituted, and the secret references are resolved only for the given environment.
 //
 //	It returns an error or a valid ApiParams
-func LoadApiParamsFromFile(path string) (*ApiParams, error) {
-	fileContent, err := GetEnvSubstitutedFileContent(path)
-	if err != nil {
-		return nil, err
-	}
-
-	apiParams := &ApiParams{}
-	err = yaml.Unmarshal([]byte(fileContent), &apiParams)
-	if err != nil {
-		return nil, err
-	}
-
-	return apiParams, err
+func LoadApiParamsFromFile(path, environment string) (*ApiParams, error) {
+	return loadApiParams(path, environment, true)
 }
 
 // LoadApiProductParamsFromFile loads an API Product project configuration YAML file located in path.
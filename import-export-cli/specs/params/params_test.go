@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 // loadAPIFromFile loads API file from the path and returns a slice of bytes or an error
@@ -26,13 +27,13 @@ func loadAPIFromFile(path string) ([]byte, error) {
 }
 
 func TestLoadApiParamsFromFileInvalidYAML(t *testing.T) {
-	conf, err := LoadApiParamsFromFile("testdata/api_params-invalid.yml")
+	conf, err := LoadApiParamsFromFile("testdata/api_params-invalid.yml", "dev")
 	assert.Error(t, err, "Should return an error for invalid yaml files")
 	assert.Nil(t, conf, "Should return nil when errors are returned")
 }
 
 func TestLoadApiParamsFromFileWithoutEnv(t *testing.T) {
-	conf, err := LoadApiParamsFromFile("testdata/api_params-env.yml")
+	conf, err := LoadApiParamsFromFile("testdata/api_params-env.yml", "dev")
 	assert.Error(t, err, "Should return error when environment variables not present")
 	assert.Nil(t, conf, "Conf should be nil")
 }
@@ -53,9 +54,104 @@ func TestExtractAPIEndpointConfig(t *testing.T) {
 }
 
 func TestAPIConfig_ContainsEnv(t *testing.T) {
-	configData, err := LoadApiParamsFromFile("testdata/api_params.yml")
+	configData, err := LoadApiParamsFromFile("testdata/api_params.yml", "dev")
 	assert.Nil(t, err, "Error should be nil for correct yaml loading")
 
 	assert.NotNil(t, configData.GetEnv("dev"), "Should contain correct environment")
 	assert.Nil(t, configData.GetEnv("prod"), "Should not contain undefined environment")
 }
+
+// allowExecSecrets enables the exec secret references until the test ends
+func allowExecSecrets(t *testing.T) {
+	utils.AllowExecSecrets = true
+	t.Cleanup(func() { utils.AllowExecSecrets = false })
+}
+
+func TestLoadApiParamsFromFileWithLayers(t *testing.T) {
+	allowExecSecrets(t)
+	_ = os.Setenv("APICTL_TEST_REGION", "us-east")
+	defer os.Unsetenv("APICTL_TEST_REGION")
+	configData, err := LoadApiParamsFromFile("testdata/api_params-layered.yml", "prod")
+	assert.Nil(t, err, "Error should be nil for correct yaml loading")
+
+	dev := configData.GetEnv("dev")
+	assert.NotNil(t, dev, "Should contain correct environment")
+	endpoints := dev.Config["endpoints"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "https://us-east.backend.com", endpoints["url"], "Should inherit the templated base config")
+	security := dev.Config["security"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "${file:api_params-secret.txt}", security["password"],
+		"Should not resolve the secret references of the other environments")
+
+	prod := configData.GetEnv("prod")
+	assert.NotNil(t, prod, "Should contain correct environment")
+	endpoints = prod.Config["endpoints"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "https://prod.backend.com", endpoints["url"], "Should override the inherited config")
+	endpointConfig := endpoints["config"].(map[string]interface{})
+	assert.Equal(t, 60, endpointConfig["retryTimeOut"], "Should merge the inherited nested config")
+	assert.Equal(t, 10, endpointConfig["retryDelay"], "Should override the inherited nested config")
+	security = prod.Config["security"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "s3cret", security["password"], "Should resolve the exec secret reference")
+	assert.Equal(t, "admin", security["username"], "Should inherit from the extended environment")
+	assert.Equal(t, []interface{}{"Gold", "Silver"}, prod.Config["policies"], "Should replace the inherited lists")
+	assert.Equal(t, []string{filepath.Join("testdata", "overlays", "common.yaml"),
+		filepath.Join("testdata", "overlays", "prod.yaml")}, prod.Overlays,
+		"Should append the overlays to the inherited overlays relative to the params file")
+	assert.Nil(t, configData.GetEnv("common"), "Should not contain the bases as environments")
+}
+
+func TestLoadApiParamsFromFileResolvesSecretsOfEnvironment(t *testing.T) {
+	configData, err := LoadApiParamsFromFile("testdata/api_params-layered.yml", "dev")
+	assert.Nil(t, err, "Error should be nil for correct yaml loading")
+
+	security := configData.GetEnv("dev").Config["security"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "dev-password", security["password"], "Should resolve the file secret reference")
+	security = configData.GetEnv("prod").Config["security"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "${exec:echo s3cret}", security["password"], "Should not run the commands of the other environments")
+}
+
+func TestGetRenderedFileContentWithoutTemplateDirective(t *testing.T) {
+	_ = os.Setenv("APICTL_TEST_REGION", "us-east")
+	defer os.Unsetenv("APICTL_TEST_REGION")
+	path := filepath.Join(t.TempDir(), "api_params.yaml")
+	_ = os.WriteFile(path, []byte("url: 'https://{{ .Env.APICTL_TEST_REGION }}/${APICTL_TEST_REGION}'\n"), 0644)
+	content, err := GetRenderedFileContent(path)
+	assert.Nil(t, err, "Error should be nil for a file without the template directive")
+	assert.Equal(t, "url: 'https://{{ .Env.APICTL_TEST_REGION }}/us-east'\n", content,
+		"Should only substitute the environment variables")
+}
+
+func TestLoadApiParamsFromFileWithCyclicLayers(t *testing.T) {
+	conf, err := LoadApiParamsFromFile("testdata/api_params-cycle.yml", "dev")
+	assert.Error(t, err, "Should return an error for environments extending each other")
+	assert.Nil(t, conf, "Conf should be nil")
+}
+
+func TestLoadApiParamsFromFileWithoutAllowingExecSecrets(t *testing.T) {
+	_, err := LoadApiParamsFromFile("testdata/api_params-layered.yml", "prod")
+	assert.Error(t, err, "Should not run the commands unless they are allowed")
+	assert.Contains(t, err.Error(), "--allow-exec-secrets")
+
+	configData, err := LoadApiParamsFromFile("testdata/api_params-layered.yml", "dev")
+	assert.Nil(t, err, "Should resolve the file secret references without allowing the commands")
+	security := configData.GetEnv("dev").Config["security"].(map[string]interface{})["production"].(map[string]interface{})
+	assert.Equal(t, "dev-password", security["password"])
+}
+
+func TestRenderApiParamsMasksSecrets(t *testing.T) {
+	allowExecSecrets(t)
+	_ = os.Setenv("APICTL_TEST_REGION", "eu-west")
+	defer os.Unsetenv("APICTL_TEST_REGION")
+	rendered, err := RenderApiParams("testdata/api_params-layered.yml", "prod", false)
+	assert.Nil(t, err, "Error should be nil for correct yaml loading")
+	assert.Contains(t, rendered, "name: prod")
+	assert.Contains(t, rendered, "retryTimeOut: 60")
+	assert.Contains(t, rendered, secretMask)
+	assert.NotContains(t, rendered, "s3cret")
+
+	rendered, err = RenderApiParams("testdata/api_params-layered.yml", "prod", true)
+	assert.Nil(t, err, "Error should be nil for correct yaml loading")
+	assert.Contains(t, rendered, "s3cret")
+
+	_, err = RenderApiParams("testdata/api_params-layered.yml", "qa", false)
+	assert.Error(t, err, "Should return an error for undefined environment")
+}
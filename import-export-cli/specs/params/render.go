@@ -0,0 +1,374 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package params
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// secretMask replaces the values of the secret references when the params are rendered for a review
+const secretMask = "********"
+
+// reSecretRef matches the secret references in the values of the params, which are ${file:<path>} to read a file and
+// ${exec:<command>} to run a command. The surrounding white spaces of the file content and the command output are
+// trimmed. The commands are only run if utils.AllowExecSecrets is set by the --allow-exec-secrets flag.
+var reSecretRef = regexp.MustCompile(`\${(file|exec):([^}]+)}`)
+
+// templateDirective opts a params file in to the Go-template expressions when it is the first line of the file. The
+// other params files are only substituted with the environment variables.
+const templateDirective = "# apictl:template"
+
+// paramsTemplateFuncs are the functions available to the Go-template expressions in the params files
+var paramsTemplateFuncs = template.FuncMap{
+	"env": os.Getenv,
+	"default": func(defaultValue string, value interface{}) string {
+		if value == nil || fmt.Sprint(value) == "" {
+			return defaultValue
+		}
+		return fmt.Sprint(value)
+	},
+	"required": func(message string, value interface{}) (string, error) {
+		if value == nil || fmt.Sprint(value) == "" {
+			return "", errors.New(message)
+		}
+		return fmt.Sprint(value), nil
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"quote": func(value interface{}) string {
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	},
+}
+
+// GetRenderedFileContent loads the given file in path, executes the Go-template expressions in it if the file starts
+// with the template directive and substitutes the environment variables that are defined as ${var}. The environment
+// variables are available to the templates as .Env, for example {{ .Env.HOME }}.
+//
+//	returns the file as string.
+func GetRenderedFileContent(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	firstLine := strings.SplitN(string(data), "\n", 2)[0]
+	if strings.TrimSpace(firstLine) != templateDirective {
+		return utils.EnvSubstituteForCurlyBraces(string(data))
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(paramsTemplateFuncs).Option("missingkey=zero").
+		Parse(string(data))
+	if err != nil {
+		return "", err
+	}
+	env := make(map[string]string)
+	for _, keyValue := range os.Environ() {
+		if i := strings.Index(keyValue, "="); i > 0 {
+			env[keyValue[:i]] = keyValue[i+1:]
+		}
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, map[string]interface{}{"Env": env}); err != nil {
+		return "", err
+	}
+
+	return utils.EnvSubstituteForCurlyBraces(rendered.String())
+}
+
+// loadApiParams loads an API params file, resolves the environments which extend other environments or base blocks and
+// resolves the secret references of the given environment. The secrets are masked unless revealSecrets is true.
+func loadApiParams(path, environment string, revealSecrets bool) (*ApiParams, error) {
+	fileContent, err := GetRenderedFileContent(path)
+	if err != nil {
+		return nil, err
+	}
+
+	apiParams := &ApiParams{}
+	err = yaml.Unmarshal([]byte(fileContent), &apiParams)
+	if err != nil {
+		return nil, err
+	}
+
+	err = apiParams.resolveEnvironments(filepath.Dir(path), environment, revealSecrets)
+	if err != nil {
+		return nil, err
+	}
+	return apiParams, nil
+}
+
+// resolveEnvironments merges the configs of each environment over the configs of the environment or the base block it
+// extends, and resolves the secret references of the merged configs of the given environment only. The secret
+// references of the other environments are kept as they are, so that their secrets are neither read nor executed.
+func (config *ApiParams) resolveEnvironments(baseDir, environment string, revealSecrets bool) error {
+	resolved := make(map[string]map[string]interface{})
+	resolvedOverlays := make([][]string, len(config.Environments))
+	for i := range config.Environments {
+		resolvedOverlays[i] = config.resolveOverlays(config.Environments[i].Name, nil)
+	}
+	for i := range config.Environments {
+		configs, err := config.resolveConfigs(config.Environments[i].Name, resolved, nil)
+		if err != nil {
+			return err
+		}
+		resolvedConfigs := normalizeConfigs(configs)
+		if config.Environments[i].Name == environment {
+			resolvedConfigs, err = resolveSecretRefs(configs, baseDir, revealSecrets)
+			if err != nil {
+				return fmt.Errorf("resolving secrets of the environment %s failed: %w", environment, err)
+			}
+		}
+		overlays := resolvedOverlays[i]
+		for j, overlay := range overlays {
+			if !filepath.IsAbs(overlay) {
+				overlays[j] = filepath.Join(baseDir, overlay)
+			}
+		}
+		config.Environments[i].Config = resolvedConfigs.(map[string]interface{})
+		config.Environments[i].Overlays = overlays
+		config.Environments[i].Extends = ""
+	}
+	return nil
+}
+
+// resolveOverlays returns the overlays of the environment or the base block it extends followed by the overlays of
+// the environment itself. An extension cycle is not followed, as it fails the resolution of the configs.
+func (config *ApiParams) resolveOverlays(name string, chain []string) []string {
+	block := config.getBlock(name)
+	if block == nil {
+		return nil
+	}
+	for _, visited := range chain {
+		if visited == name {
+			return nil
+		}
+	}
+	overlays := []string{}
+	if block.Extends != "" {
+		overlays = append(overlays, config.resolveOverlays(block.Extends, append(chain, name))...)
+	}
+	return append(overlays, block.Overlays...)
+}
+
+// resolveConfigs returns the configs of an environment or a base block merged over the configs it extends
+func (config *ApiParams) resolveConfigs(name string, resolved map[string]map[string]interface{},
+	chain []string) (map[string]interface{}, error) {
+	if configs, ok := resolved[name]; ok {
+		return configs, nil
+	}
+	for _, visited := range chain {
+		if visited == name {
+			return nil, fmt.Errorf("environments extend each other in a cycle: %s -> %s",
+				strings.Join(chain, " -> "), name)
+		}
+	}
+	block := config.getBlock(name)
+	if block == nil {
+		return nil, fmt.Errorf("%s extends %s, which is neither an environment nor a base", chain[len(chain)-1], name)
+	}
+	configs := block.Config
+	if block.Extends != "" {
+		baseConfigs, err := config.resolveConfigs(block.Extends, resolved, append(chain, name))
+		if err != nil {
+			return nil, err
+		}
+		configs = mergeConfigs(baseConfigs, block.Config)
+	}
+	if configs == nil {
+		configs = make(map[string]interface{})
+	}
+	resolved[name] = configs
+	return configs, nil
+}
+
+// getBlock returns the environment of the given name, or the base block of the given name if there is no such
+// environment
+func (config *ApiParams) getBlock(name string) *Environment {
+	if env := config.GetEnv(name); env != nil {
+		return env
+	}
+	for i := range config.Bases {
+		if config.Bases[i].Name == name {
+			return &config.Bases[i]
+		}
+	}
+	return nil
+}
+
+// mergeConfigs returns a copy of the base configs overridden by the given configs. The maps are merged recursively,
+// while the lists and the other values replace the values of the base.
+func mergeConfigs(base, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(override))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range override {
+		baseMap, baseIsMap := toStringKeyedMap(merged[key])
+		overrideMap, overrideIsMap := toStringKeyedMap(value)
+		if baseIsMap && overrideIsMap {
+			merged[key] = mergeConfigs(baseMap, overrideMap)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// toStringKeyedMap converts the maps decoded from YAML to maps keyed by strings
+func toStringKeyedMap(value interface{}) (map[string]interface{}, bool) {
+	switch m := value.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(m))
+		for key, v := range m {
+			converted[fmt.Sprint(key)] = v
+		}
+		return converted, true
+	}
+	return nil, false
+}
+
+// normalizeConfigs returns the configs with the nested maps decoded from YAML converted to maps keyed by strings
+func normalizeConfigs(value interface{}) interface{} {
+	if m, ok := toStringKeyedMap(value); ok {
+		normalizedMap := make(map[string]interface{}, len(m))
+		for key, v := range m {
+			normalizedMap[key] = normalizeConfigs(v)
+		}
+		return normalizedMap
+	}
+	if list, ok := value.([]interface{}); ok {
+		normalizedList := make([]interface{}, len(list))
+		for i, item := range list {
+			normalizedList[i] = normalizeConfigs(item)
+		}
+		return normalizedList
+	}
+	return value
+}
+
+// resolveSecretRefs replaces the secret references in the string values of the configs with their secrets, or with a
+// mask if revealSecrets is false. A secret which can not be read fails the resolution even if it is masked.
+func resolveSecretRefs(value interface{}, baseDir string, revealSecrets bool) (interface{}, error) {
+	if m, ok := toStringKeyedMap(value); ok {
+		resolvedMap := make(map[string]interface{}, len(m))
+		for key, v := range m {
+			resolvedValue, err := resolveSecretRefs(v, baseDir, revealSecrets)
+			if err != nil {
+				return nil, err
+			}
+			resolvedMap[key] = resolvedValue
+		}
+		return resolvedMap, nil
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		resolvedList := make([]interface{}, len(v))
+		for i, item := range v {
+			resolvedItem, err := resolveSecretRefs(item, baseDir, revealSecrets)
+			if err != nil {
+				return nil, err
+			}
+			resolvedList[i] = resolvedItem
+		}
+		return resolvedList, nil
+	case string:
+		var resolveErr error
+		resolvedString := reSecretRef.ReplaceAllStringFunc(v, func(ref string) string {
+			match := reSecretRef.FindStringSubmatch(ref)
+			secret, err := readSecret(match[1], strings.TrimSpace(match[2]), baseDir)
+			if err != nil && resolveErr == nil {
+				resolveErr = err
+			}
+			if !revealSecrets {
+				return secretMask
+			}
+			return secret
+		})
+		return resolvedString, resolveErr
+	}
+	return value, nil
+}
+
+// readSecret reads a file relative to the directory of the params file, or runs a command and reads its output if
+// the exec secret references are allowed
+func readSecret(refType, ref, baseDir string) (string, error) {
+	if refType == "file" {
+		if !filepath.IsAbs(ref) {
+			ref = filepath.Join(baseDir, ref)
+		}
+		utils.Logln(utils.LogPrefixInfo+"Reading secret from", ref)
+		content, err := ioutil.ReadFile(ref)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+	if !utils.AllowExecSecrets {
+		return "", fmt.Errorf("exec secret reference ${exec:%s} is not allowed, provide --allow-exec-secrets to run "+
+			"the commands of the params file", ref)
+	}
+	args := strings.Fields(ref)
+	if len(args) == 0 {
+		return "", errors.New("exec secret reference has no command")
+	}
+	utils.Logln(utils.LogPrefixInfo+"Reading secret from the output of", args[0])
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = baseDir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running %s failed: %w %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// RenderApiParams loads an API params file, or the params file of a deployment directory, and returns the configs of
+// the given environment after the templates, the environment variables, the inheritance and the secret references
+// are resolved. The secrets are masked unless revealSecrets is true.
+func RenderApiParams(path, environment string, revealSecrets bool) (string, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, utils.ParamFile)
+	}
+	apiParams, err := loadApiParams(path, environment, revealSecrets)
+	if err != nil {
+		return "", err
+	}
+	env := apiParams.GetEnv(environment)
+	if env == nil {
+		return "", fmt.Errorf("environment '%s' does not exist in %s", environment, path)
+	}
+	rendered, err := yaml.Marshal(env)
+	if err != nil {
+		return "", err
+	}
+	return string(rendered), nil
+}
@@ -31,6 +31,10 @@ var HttpRequestTimeout = DefaultHttpRequestTimeout
 var AIThreadCount = DefaultAIThreadCount
 var AIToken string
 var Insecure bool
+
+// AllowExecSecrets enables the ${exec:<command>} secret references of the params files, which run the commands in
+// them. It is disabled by default, so that a params file can not run commands unless it is explicitly allowed.
+var AllowExecSecrets bool
 var ExportDirectory string
 
 // TLSRenegotiationMode : Defines TLS Renegotiation support mode, default is never
@@ -69,6 +73,7 @@ func SetConfigVars(mainConfigFilePath string) error {
 	Logln(LogPrefixInfo + "Setting ExportDirectory " + mainConfig.Config.ExportDirectory)
 
 	setTLSRenegotiationMode(mainConfig)
+	setEnvTransportSettings(mainConfig)
 
 	return nil
 }
@@ -93,13 +98,7 @@ func IsValid(fp string) bool {
 }
 
 func setTLSRenegotiationMode(mainConfig *MainConfig) {
-	modeMap := map[string]tls.RenegotiationSupport{
-		TLSRenegotiationOnce:   tls.RenegotiateOnceAsClient,
-		TLSRenegotiationFreely: tls.RenegotiateFreelyAsClient,
-		TLSRenegotiationNever:  tls.RenegotiateNever,
-	}
-
-	if val, ok := modeMap[mainConfig.Config.TLSRenegotiationMode]; ok {
+	if val, ok := tlsRenegotiationModes[mainConfig.Config.TLSRenegotiationMode]; ok {
 		if ok {
 			TLSRenegotiationMode = val
 			Logln(LogPrefixInfo + "Setting TLSRenegotiationMode : " + mainConfig.Config.TLSRenegotiationMode)
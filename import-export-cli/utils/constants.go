@@ -103,7 +103,9 @@ const ExportedAPIPoliciesDirName = "api"
 const ExportedApiProductsDirName = "api-products"
 const ExportedAppsDirName = "apps"
 const ExportedMigrationArtifactsDirName = "migration"
+const ExportedAdminResourcesDirName = "admin"
 const CertificatesDirName = "certs"
+const PluginsDirName = "plugins"
 
 const (
 	InitProjectDefinitions              = "Definitions"
@@ -125,6 +127,7 @@ const DeploymentCertificatesDirectory = "certificates"
 
 var DefaultExportDirPath = filepath.Join(GetConfigDirPath(), DefaultExportDirName)
 var DefaultCertDirPath = filepath.Join(ConfigDirPath, CertificatesDirName)
+var DefaultPluginDirPath = filepath.Join(ConfigDirPath, PluginsDirName)
 
 const defaultApiApplicationImportExportSuffix = "api/am/admin/v4"
 const defaultPublisherApiImportExportSuffix = "api/am/publisher/v4"
@@ -135,9 +138,12 @@ const defaultUnifiedSearchEndpointSuffix = "api/am/publisher/v4/search"
 const defaultAdminApplicationListEndpointSuffix = "api/am/admin/v4/applications"
 const defaultDevPortalApplicationListEndpointSuffix = "api/am/devportal/v3/applications"
 const defaultDevPortalThrottlingPoliciesEndpointSuffix = "api/am/devportal/v3/throttling-policies"
+const defaultDevPortalApiListEndpointSuffix = "api/am/devportal/v3/apis"
+const defaultDevPortalSubscriptionListEndpointSuffix = "api/am/devportal/v3/subscriptions"
 const defaultClientRegistrationEndpointSuffix = "client-registration/v0.17/register"
 const defaultTokenEndPoint = "oauth2/token"
 const defaultRevokeEndpointSuffix = "oauth2/revoke"
+const defaultAuthorizeEndpointSuffix = "oauth2/authorize"
 const defaultAPILoggingBaseEndpoint = "api/am/devops/v0/tenant-logs"
 const defaultAPILoggingApisEndpoint = "apis"
 const defaultCorrelationLoggingEndpoint = "api/am/devops/v0/config/correlation"
@@ -152,6 +158,7 @@ const DefaultApiProductType = "APIProduct"
 // Application keys related constants
 const ProductionKeyType = "PRODUCTION"
 const SandboxKeyType = "SANDBOX"
+const DefaultKeyManager = "Resident Key Manager"
 
 var GrantTypesToBeSupported = []string{"refresh_token", "password", "client_credentials"}
 
@@ -233,17 +240,20 @@ var EnvReplacePoliciesFileExtensions = []string{
 
 // project types
 const (
-	ProjectTypeNone        = "None"
-	ProjectTypeApi         = "API"
-	ProjectTypeApiProduct  = "API Product"
-	ProjectTypeApplication = "Application"
-	ProjectTypeRevision    = "Revision"
-	ProjectTypePolicy      = "Policy"
-	ProjectTypeAPIPolicy   = "API Policy"
+	ProjectTypeNone          = "None"
+	ProjectTypeApi           = "API"
+	ProjectTypeApiProduct    = "API Product"
+	ProjectTypeApplication   = "Application"
+	ProjectTypeSubscription  = "Subscription"
+	ProjectTypeRevision      = "Revision"
+	ProjectTypePolicy        = "Policy"
+	ProjectTypeAPIPolicy     = "API Policy"
+	ProjectTypeAdminResource = "Admin Resource"
 )
 
 // project param files
 const ParamFile = "params.yaml"
+const ParamFileAPI = "api_params.yaml"
 const ParamsIntermediateFile = "intermediate_params.yaml"
 
 const (
@@ -253,13 +263,16 @@ const (
 	APIProductDefinitionFileJson  = "api_product.json"
 	ApplicationDefinitionFileYaml = "application.yaml"
 	ApplicationDefinitionFileJson = "application.json"
+	AdminResourceFileYaml         = "admin_resource.yaml"
+	AdminResourceFileJson         = "admin_resource.json"
 )
 
 // project meta files
 const (
-	MetaFileAPI         = "api_meta.yaml"
-	MetaFileAPIProduct  = "api_product_meta.yaml"
-	MetaFileApplication = "application_meta.yaml"
+	MetaFileAPI           = "api_meta.yaml"
+	MetaFileAPIProduct    = "api_product_meta.yaml"
+	MetaFileApplication   = "application_meta.yaml"
+	MetaFileAdminResource = "admin_resource_meta.yaml"
 )
 
 // Constants related to meta file structs
@@ -274,6 +287,7 @@ const SidecarModeConst = "sidecar"
 const DefaultApisDisplayLimit = 25
 const DefaultApiProductsDisplayLimit = 25
 const DefaultAppsDisplayLimit = 25
+const DefaultSubscriptionsDisplayLimit = 25
 const DefaultExportFormat = "YAML"
 const DefaultPoliciesDisplayLimit = 25
 
@@ -0,0 +1,98 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+)
+
+// EnvFlagRequiredAnnotation marks the environment flag of a command which is required unless the current context
+// provides the environment. The flag is validated after the current context is applied, as a flag marked required
+// with cobra is validated before it can be set from the context.
+const EnvFlagRequiredAnnotation = "apictl_annotation_environment_required"
+
+// MarkEnvFlagRequired marks the environment flag of the command as required unless the current context provides it
+// @param cmd : Command with the environment flag
+func MarkEnvFlagRequired(cmd *cobra.Command) {
+	_ = cmd.Flags().SetAnnotation("environment", EnvFlagRequiredAnnotation, []string{"true"})
+}
+
+// GetCurrentContext returns the name and the context set as the current context in the main config file
+// @param filePath : Path to the main config file
+// @return name of the current context, or blank if there is no current context
+// @return current context, or nil if there is no current context
+func GetCurrentContext(filePath string) (string, *Context) {
+	mainConfig := GetMainConfigFromFileSilently(filePath)
+	if mainConfig.CurrentContext == "" {
+		return "", nil
+	}
+	context, ok := mainConfig.Contexts[mainConfig.CurrentContext]
+	if !ok {
+		return "", nil
+	}
+	return mainConfig.CurrentContext, &context
+}
+
+// GetContextFromMainConfigFile returns the context of the given name
+// @param name : Name of the context
+// @param filePath : Path to the main config file
+// @return context
+// @return error
+func GetContextFromMainConfigFile(name, filePath string) (*Context, error) {
+	mainConfig := GetMainConfigFromFile(filePath)
+	context, ok := mainConfig.Contexts[name]
+	if !ok {
+		return nil, errors.New("context '" + name + "' does not exist in " + filePath)
+	}
+	return &context, nil
+}
+
+// SetContextInMainConfigFile adds or replaces a context in the main config file
+// @param name : Name of the context
+// @param context : Context to be stored
+// @param filePath : Path to the main config file
+// @return error
+func SetContextInMainConfigFile(name string, context Context, filePath string) error {
+	if !EnvExistsInMainConfigFile(context.Environment, filePath) {
+		return errors.New("environment '" + context.Environment + "' does not exist in " + filePath)
+	}
+	mainConfig := GetMainConfigFromFile(filePath)
+	if mainConfig.Contexts == nil {
+		mainConfig.Contexts = make(map[string]Context)
+	}
+	mainConfig.Contexts[name] = context
+	WriteConfigFile(mainConfig, filePath)
+	return nil
+}
+
+// SetCurrentContextInMainConfigFile sets the current context in the main config file
+// @param name : Name of the context
+// @param filePath : Path to the main config file
+// @return error
+func SetCurrentContextInMainConfigFile(name, filePath string) error {
+	mainConfig := GetMainConfigFromFile(filePath)
+	if _, ok := mainConfig.Contexts[name]; !ok {
+		return errors.New("context '" + name + "' does not exist in " + filePath)
+	}
+	mainConfig.CurrentContext = name
+	WriteConfigFile(mainConfig, filePath)
+	return nil
+}
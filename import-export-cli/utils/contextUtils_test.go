@@ -0,0 +1,52 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContexts(t *testing.T) {
+	mainConfigFilePath := filepath.Join(t.TempDir(), MainConfigFileName)
+	mainConfig := new(MainConfig)
+	mainConfig.Environments = map[string]EnvEndpoints{
+		"dev": {ApiManagerEndpoint: "https://localhost:9443", TokenEndpoint: "https://localhost:8243/token"},
+	}
+	WriteConfigFile(mainConfig, mainConfigFilePath)
+
+	name, context := GetCurrentContext(mainConfigFilePath)
+	assert.Equal(t, "", name)
+	assert.Nil(t, context, "Should not return a context when the current context is not set")
+
+	err := SetContextInMainConfigFile("dev-admin", Context{Environment: "dev", User: "admin", Tenant: "carbon.super"},
+		mainConfigFilePath)
+	assert.NoError(t, err)
+	err = SetContextInMainConfigFile("prod", Context{Environment: "production"}, mainConfigFilePath)
+	assert.Error(t, err, "Should return an error when the environment does not exist")
+	err = SetCurrentContextInMainConfigFile("prod", mainConfigFilePath)
+	assert.Error(t, err, "Should return an error when the context does not exist")
+
+	assert.NoError(t, SetCurrentContextInMainConfigFile("dev-admin", mainConfigFilePath))
+	name, context = GetCurrentContext(mainConfigFilePath)
+	assert.Equal(t, "dev-admin", name)
+	assert.Equal(t, &Context{Environment: "dev", User: "admin", Tenant: "carbon.super"}, context)
+}
@@ -276,6 +276,32 @@ func GetDevPortalThrottlingPoliciesEndpointOfEnv(env, filePath string) string {
 	}
 }
 
+// GetDevPortalApiListEndpointOfEnv returns the DevPortal APIs endpoint of a given environment
+func GetDevPortalApiListEndpointOfEnv(env, filePath string) string {
+	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
+	if !(envEndpoints.DevPortalEndpoint == "" || envEndpoints == nil) {
+		envEndpoints.DevPortalEndpoint = AppendSlashToString(envEndpoints.DevPortalEndpoint)
+		return envEndpoints.DevPortalEndpoint + defaultDevPortalApiListEndpointSuffix
+	} else {
+		apiManagerEndpoint := GetApiManagerEndpointOfEnv(env, filePath)
+		apiManagerEndpoint = AppendSlashToString(apiManagerEndpoint)
+		return apiManagerEndpoint + defaultDevPortalApiListEndpointSuffix
+	}
+}
+
+// GetDevPortalSubscriptionListEndpointOfEnv returns the DevPortal subscriptions endpoint of a given environment
+func GetDevPortalSubscriptionListEndpointOfEnv(env, filePath string) string {
+	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
+	if !(envEndpoints.DevPortalEndpoint == "" || envEndpoints == nil) {
+		envEndpoints.DevPortalEndpoint = AppendSlashToString(envEndpoints.DevPortalEndpoint)
+		return envEndpoints.DevPortalEndpoint + defaultDevPortalSubscriptionListEndpointSuffix
+	} else {
+		apiManagerEndpoint := GetApiManagerEndpointOfEnv(env, filePath)
+		apiManagerEndpoint = AppendSlashToString(apiManagerEndpoint)
+		return apiManagerEndpoint + defaultDevPortalSubscriptionListEndpointSuffix
+	}
+}
+
 // Get TokenEndpoint of a given environment
 func GetTokenEndpointOfEnv(env, filePath string) string {
 	envEndpoints, _ := GetEndpointsOfEnvironment(env, filePath)
@@ -443,6 +469,15 @@ func GetTokenRevokeEndpoint(env, filePath string) string {
 	return extractedTokenEndpoint + defaultRevokeEndpointSuffix
 }
 
+// GetAuthorizeEndpointOfEnv returns the OAuth authorize endpoint used for the SSO login
+// @param env : Name of the environment
+// @param filePath : Path to file where tokens are stored
+// @return endpoint URL of authorize endpoint
+func GetAuthorizeEndpointOfEnv(env, filePath string) string {
+	internalTokenEndpoint := GetInternalTokenEndpointOfEnv(env, filePath)
+	return strings.Split(internalTokenEndpoint, defaultTokenEndPoint)[0] + defaultAuthorizeEndpointSuffix
+}
+
 // RequiredAPIMEndpointsExists checks for required apim endpoints.
 // It returns true if all the endpoints are present
 func RequiredAPIMEndpointsExists(envEndpoints *EnvEndpoints) bool {
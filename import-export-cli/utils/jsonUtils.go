@@ -84,6 +84,12 @@ func selectTypeOfOutputEntry(data []byte, artifactType string) ([]byte, error) {
 
 		// Formatting data to get the JsonArray object in prettyPrint format
 		return json.MarshalIndent(applicationEntries, "", " ")
+	} else if artifactType == ProjectTypeSubscription {
+		var subscriptionEntries []SubscriptionEntry
+		json.Unmarshal(data, &subscriptionEntries)
+
+		// Formatting data to get the JsonArray object in prettyPrint format
+		return json.MarshalIndent(subscriptionEntries, "", " ")
 	} else if artifactType == ProjectTypePolicy {
 		var policyEntries []PolicyEntry
 		json.Unmarshal(data, &policyEntries)
@@ -0,0 +1,149 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// PluginPrefix is the prefix of the names of the plugin executables. The plugin apictl-foo is run as apictl foo.
+const PluginPrefix = ProjectName + "-"
+
+// Environment variables passed to the plugins
+const (
+	PluginEnvVarEnvironment          = "APICTL_ENVIRONMENT"
+	PluginEnvVarAccessToken          = "APICTL_ACCESS_TOKEN"
+	PluginEnvVarTenantDomain         = "APICTL_TENANT_DOMAIN"
+	PluginEnvVarOutputFormat         = "APICTL_OUTPUT_FORMAT"
+	PluginEnvVarInsecure             = "APICTL_INSECURE"
+	PluginEnvVarConfigDir            = "APICTL_CONFIG_DIR"
+	PluginEnvVarApiManagerEndpoint   = "APICTL_APIM_ENDPOINT"
+	PluginEnvVarPublisherEndpoint    = "APICTL_PUBLISHER_ENDPOINT"
+	PluginEnvVarDevPortalEndpoint    = "APICTL_DEVPORTAL_ENDPOINT"
+	PluginEnvVarAdminEndpoint        = "APICTL_ADMIN_ENDPOINT"
+	PluginEnvVarRegistrationEndpoint = "APICTL_REGISTRATION_ENDPOINT"
+	PluginEnvVarTokenEndpoint        = "APICTL_TOKEN_ENDPOINT"
+	PluginEnvVarMiManagementEndpoint = "APICTL_MI_ENDPOINT"
+)
+
+// Plugin is an external executable exposed as a subcommand
+type Plugin struct {
+	// Name is the name of the subcommand, which is the name of the executable without the prefix
+	Name string
+	// Path is the path of the executable
+	Path string
+	// Shadowed lists the paths of the executables of the same name found later in the plugin directories
+	Shadowed []string
+}
+
+// GetPluginDirs returns the directories searched for plugins, which are the plugins directory in the config directory
+// followed by the directories in PATH
+func GetPluginDirs() []string {
+	return append([]string{DefaultPluginDirPath}, filepath.SplitList(os.Getenv("PATH"))...)
+}
+
+// FindPlugins finds the plugin executables in the given directories. If several executables have the same name, the
+// one in the directory that comes first is used.
+// @param dirs : Directories to search in order
+// @return plugins sorted by their names
+func FindPlugins(dirs []string) []Plugin {
+	plugins := make(map[string]*Plugin)
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			path := filepath.Join(dir, file.Name())
+			name, ok := getPluginName(path)
+			if !ok {
+				continue
+			}
+			if plugin, exists := plugins[name]; exists {
+				if plugin.Path != path {
+					plugin.Shadowed = append(plugin.Shadowed, path)
+				}
+				continue
+			}
+			plugins[name] = &Plugin{Name: name, Path: path}
+		}
+	}
+
+	found := make([]Plugin, 0, len(plugins))
+	for _, plugin := range plugins {
+		found = append(found, *plugin)
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	return found
+}
+
+// FindPlugin finds the plugin executable of the given name in the given directories, without listing the directories.
+// If several executables have the name, the one in the directory that comes first is used.
+// @param dirs : Directories to search in order
+// @param name : Name of the subcommand
+// @return plugin
+// @return true if the plugin is found
+func FindPlugin(dirs []string, name string) (Plugin, bool) {
+	fileNames := []string{PluginPrefix + name}
+	if runtime.GOOS == "windows" {
+		fileNames = []string{PluginPrefix + name + ".exe", PluginPrefix + name + ".bat", PluginPrefix + name + ".cmd"}
+	}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		for _, fileName := range fileNames {
+			path := filepath.Join(dir, fileName)
+			if pluginName, ok := getPluginName(path); ok && pluginName == name {
+				return Plugin{Name: name, Path: path}, true
+			}
+		}
+	}
+	return Plugin{}, false
+}
+
+// getPluginName returns the name of the subcommand if the file is a plugin executable. The symbolic links are followed.
+func getPluginName(path string) (string, bool) {
+	if !strings.HasPrefix(filepath.Base(path), PluginPrefix) {
+		return "", false
+	}
+	file, err := os.Stat(path)
+	if err != nil || file.IsDir() {
+		return "", false
+	}
+	name := strings.TrimPrefix(filepath.Base(path), PluginPrefix)
+	if runtime.GOOS == "windows" {
+		extension := strings.ToLower(filepath.Ext(name))
+		if extension != ".exe" && extension != ".bat" && extension != ".cmd" {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	} else if file.Mode()&0111 == 0 {
+		return "", false
+	}
+	return name, name != ""
+}
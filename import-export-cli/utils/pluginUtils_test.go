@@ -0,0 +1,85 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePlugin(t *testing.T, dir, name string, mode os.FileMode) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\necho plugin\n"), mode))
+	return path
+}
+
+func TestFindPlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are identified by their extensions on windows")
+	}
+	pluginDir := t.TempDir()
+	pathDir := t.TempDir()
+
+	hello := writePlugin(t, pluginDir, PluginPrefix+"hello", 0755)
+	shadowed := writePlugin(t, pathDir, PluginPrefix+"hello", 0755)
+	sync := writePlugin(t, pathDir, PluginPrefix+"sync", 0755)
+	writePlugin(t, pathDir, PluginPrefix+"notes", 0644)
+	writePlugin(t, pathDir, "kubectl-hello", 0755)
+	assert.NoError(t, os.Mkdir(filepath.Join(pathDir, PluginPrefix+"dir"), 0755))
+	assert.NoError(t, os.Symlink(sync, filepath.Join(pluginDir, PluginPrefix+"linked")))
+
+	plugins := FindPlugins([]string{pluginDir, "", filepath.Join(pathDir, "missing"), pathDir})
+
+	assert.Equal(t, []Plugin{
+		{Name: "hello", Path: hello, Shadowed: []string{shadowed}},
+		{Name: "linked", Path: filepath.Join(pluginDir, PluginPrefix+"linked")},
+		{Name: "sync", Path: sync},
+	}, plugins)
+}
+
+func TestFindPluginsWithoutPlugins(t *testing.T) {
+	assert.Empty(t, FindPlugins([]string{t.TempDir()}))
+}
+
+func TestFindPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are identified by their extensions on windows")
+	}
+	pluginDir := t.TempDir()
+	pathDir := t.TempDir()
+
+	writePlugin(t, pluginDir, PluginPrefix+"notes", 0644)
+	notes := writePlugin(t, pathDir, PluginPrefix+"notes", 0755)
+	hello := writePlugin(t, pluginDir, PluginPrefix+"hello", 0755)
+	writePlugin(t, pathDir, PluginPrefix+"hello", 0755)
+
+	plugin, ok := FindPlugin([]string{pluginDir, "", pathDir}, "hello")
+	assert.True(t, ok)
+	assert.Equal(t, Plugin{Name: "hello", Path: hello}, plugin)
+	plugin, ok = FindPlugin([]string{pluginDir, pathDir}, "notes")
+	assert.True(t, ok, "Should skip the files which are not executable")
+	assert.Equal(t, Plugin{Name: "notes", Path: notes}, plugin)
+	_, ok = FindPlugin([]string{pluginDir, pathDir}, "sync")
+	assert.False(t, ok)
+}
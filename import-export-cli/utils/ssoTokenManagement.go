@@ -0,0 +1,216 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// SSOLoginTimeout is the time to wait for the browser to complete the SSO login
+var SSOLoginTimeout = 5 * time.Minute
+
+const ssoCallbackPath = "/callback"
+
+const ssoCallbackSuccessPage = `<html><body><h3>Logged in successfully. You can close this window and return to ` +
+	ProjectName + `.</h3></body></html>`
+
+const ssoCallbackErrorPage = `<html><body><h3>Login failed: %s</h3></body></html>`
+
+// ssoCallbackResult is the authorization code or the error received by the loopback listener
+type ssoCallbackResult struct {
+	code string
+	err  error
+}
+
+// GetOAuthTokensWithSSO logs in through the browser using the authorization code grant with PKCE. A loopback listener
+// receives the authorization code, which is exchanged for the tokens.
+// @param clientID : Client ID of the OAuth application registered with the loopback callback URL
+// @param clientSecret : Client secret of the OAuth application. Could be blank for public clients
+// @param authorizeEndpoint : OAuth authorize endpoint
+// @param tokenEndpoint : OAuth token endpoint
+// @param callbackPort : Port of the loopback listener. A free port is used if it is 0
+// @param openBrowser : Function opening the authorize URL in a browser
+// @return tokens
+// @return error
+func GetOAuthTokensWithSSO(clientID, clientSecret, authorizeEndpoint, tokenEndpoint string, callbackPort int,
+	openBrowser func(string) error) (*TokenResponse, error) {
+	codeVerifier, err := generateRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+	state, err := generateRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:"+strconv.Itoa(callbackPort))
+	if err != nil {
+		return nil, fmt.Errorf("unable to start the loopback listener for the SSO callback: %w", err)
+	}
+	redirectURI := "http://" + listener.Addr().String() + ssoCallbackPath
+
+	results := make(chan ssoCallbackResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(ssoCallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		result := readSSOCallback(r, state)
+		if result.err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, ssoCallbackErrorPage, result.err)
+		} else {
+			fmt.Fprint(w, ssoCallbackSuccessPage)
+		}
+		select {
+		case results <- result:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	defer server.Shutdown(context.Background())
+
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", clientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("scope", OAuthScopes)
+	query.Set("state", state)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	authorizeURL := authorizeEndpoint + "?" + query.Encode()
+
+	fmt.Println("Opening the browser to login. If it does not open, visit the following URL:")
+	fmt.Println(authorizeURL)
+	if err := openBrowser(authorizeURL); err != nil {
+		Logln(LogPrefixWarning+"Unable to open the browser:", err)
+	}
+
+	var result ssoCallbackResult
+	select {
+	case result = <-results:
+	case <-time.After(SSOLoginTimeout):
+		return nil, errors.New("timed out waiting for the SSO login to complete in the browser")
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	body := url.Values{}
+	body.Set("grant_type", "authorization_code")
+	body.Set("code", result.code)
+	body.Set("redirect_uri", redirectURI)
+	body.Set("code_verifier", codeVerifier)
+	return requestOAuthTokens(body, clientID, clientSecret, tokenEndpoint)
+}
+
+// RefreshOAuthTokens gets new tokens using the refresh token grant
+// @param refreshToken : Refresh token received with the previous tokens
+// @param clientID : Client ID of the OAuth application
+// @param clientSecret : Client secret of the OAuth application. Could be blank for public clients
+// @param tokenEndpoint : OAuth token endpoint
+// @return tokens
+// @return error
+func RefreshOAuthTokens(refreshToken, clientID, clientSecret, tokenEndpoint string) (*TokenResponse, error) {
+	body := url.Values{}
+	body.Set("grant_type", "refresh_token")
+	body.Set("refresh_token", refreshToken)
+	return requestOAuthTokens(body, clientID, clientSecret, tokenEndpoint)
+}
+
+// OpenBrowser opens the URL in the default browser of the platform
+func OpenBrowser(location string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", location).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", location).Start()
+	default:
+		return exec.Command("xdg-open", location).Start()
+	}
+}
+
+// readSSOCallback reads the authorization code from the callback request after verifying the state
+func readSSOCallback(r *http.Request, state string) ssoCallbackResult {
+	query := r.URL.Query()
+	if errorCode := query.Get("error"); errorCode != "" {
+		return ssoCallbackResult{err: errors.New(errorCode + " " + query.Get("error_description"))}
+	}
+	if query.Get("state") != state {
+		return ssoCallbackResult{err: errors.New("state of the SSO callback does not match")}
+	}
+	if query.Get("code") == "" {
+		return ssoCallbackResult{err: errors.New("authorization code is missing in the SSO callback")}
+	}
+	return ssoCallbackResult{code: query.Get("code")}
+}
+
+// requestOAuthTokens posts the grant to the token endpoint. The client authenticates with the basic auth if it has a
+// secret, or sends its ID in the body otherwise.
+func requestOAuthTokens(body url.Values, clientID, clientSecret, tokenEndpoint string) (*TokenResponse, error) {
+	headers := make(map[string]string)
+	headers[HeaderContentType] = HeaderValueXWWWFormUrlEncoded
+	headers[HeaderAccept] = HeaderValueApplicationJSON
+	if clientSecret != "" {
+		headers[HeaderAuthorization] = HeaderValueAuthBasicPrefix + " " + GetBase64EncodedCredentials(clientID,
+			clientSecret)
+	} else {
+		body.Set("client_id", clientID)
+	}
+
+	Logln(LogPrefixInfo + "connecting to " + tokenEndpoint)
+	resp, err := InvokePOSTRequest(tokenEndpoint, headers, body.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New("Unable to get the tokens. Status: " + resp.Status())
+	}
+
+	tokenResponse := &TokenResponse{}
+	err = json.Unmarshal(resp.Body(), tokenResponse)
+	if err != nil {
+		return nil, err
+	}
+	if tokenResponse.AccessToken == "" {
+		return nil, errors.New("access_token not found")
+	}
+	return tokenResponse, nil
+}
+
+// generateRandomString returns a URL safe string of the given number of random bytes
+func generateRandomString(size int) (string, error) {
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
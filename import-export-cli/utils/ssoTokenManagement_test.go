@@ -0,0 +1,109 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// getFakeAuthorizationServer returns an authorization server which redirects the authorize requests back with a code,
+// and issues the tokens for the code if the PKCE verifier matches the challenge
+func getFakeAuthorizationServer(t *testing.T, callbackState string) *httptest.Server {
+	var codeChallenge string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/authorize":
+			query := r.URL.Query()
+			assert.Equal(t, "code", query.Get("response_type"))
+			assert.Equal(t, "S256", query.Get("code_challenge_method"))
+			assert.Equal(t, "apictl_sso", query.Get("client_id"))
+			codeChallenge = query.Get("code_challenge")
+			state := query.Get("state")
+			if callbackState != "" {
+				state = callbackState
+			}
+			http.Redirect(w, r, query.Get("redirect_uri")+"?code=auth-code&state="+url.QueryEscape(state),
+				http.StatusFound)
+		case "/oauth2/token":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "apictl_sso", r.PostForm.Get("client_id"))
+			w.Header().Set(HeaderContentType, HeaderValueApplicationJSON)
+			switch r.PostForm.Get("grant_type") {
+			case "authorization_code":
+				verifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+				if r.PostForm.Get("code") != "auth-code" ||
+					base64.RawURLEncoding.EncodeToString(verifierHash[:]) != codeChallenge {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.Write([]byte(`{"access_token":"` + sampleAccessToken + `","refresh_token":"` +
+					sampleRefreshToken + `","token_type":"Bearer","expires_in":3600}`))
+			case "refresh_token":
+				assert.Equal(t, sampleRefreshToken, r.PostForm.Get("refresh_token"))
+				w.Write([]byte(`{"access_token":"refreshed","refresh_token":"rotated","expires_in":3600}`))
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// followInBrowser simulates the browser by following the redirects of the authorize URL to the loopback listener
+func followInBrowser(authorizeURL string) error {
+	resp, err := http.Get(authorizeURL)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func TestGetOAuthTokensWithSSO(t *testing.T) {
+	server := getFakeAuthorizationServer(t, "")
+	defer server.Close()
+
+	tokens, err := GetOAuthTokensWithSSO("apictl_sso", "", server.URL+"/oauth2/authorize",
+		server.URL+"/oauth2/token", 0, followInBrowser)
+	assert.NoError(t, err)
+	assert.Equal(t, sampleAccessToken, tokens.AccessToken)
+	assert.Equal(t, sampleRefreshToken, tokens.RefreshToken)
+	assert.Equal(t, int32(3600), tokens.ExpiresIn)
+
+	tokens, err = RefreshOAuthTokens(sampleRefreshToken, "apictl_sso", "", server.URL+"/oauth2/token")
+	assert.NoError(t, err)
+	assert.Equal(t, "refreshed", tokens.AccessToken)
+	assert.Equal(t, "rotated", tokens.RefreshToken)
+}
+
+func TestGetOAuthTokensWithSSOStateMismatch(t *testing.T) {
+	server := getFakeAuthorizationServer(t, "forged")
+	defer server.Close()
+
+	_, err := GetOAuthTokensWithSSO("apictl_sso", "", server.URL+"/oauth2/authorize",
+		server.URL+"/oauth2/token", 0, followInBrowser)
+	assert.Error(t, err, "Should return an error when the state of the callback does not match")
+}
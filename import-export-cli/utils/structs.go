@@ -19,6 +19,8 @@
 package utils
 
 import (
+	"encoding/json"
+
 	"gopkg.in/yaml.v2"
 )
 
@@ -36,6 +38,17 @@ type MainConfig struct {
 	Config         Config                  `yaml:"config"`
 	Environments   map[string]EnvEndpoints `yaml:"environments"`
 	MgwAdapterEnvs map[string]MgwEndpoints `yaml:"mgw-clusters"`
+	// CurrentContext is the name of the context used when a command is run without an environment
+	CurrentContext string             `yaml:"current-context,omitempty"`
+	Contexts       map[string]Context `yaml:"contexts,omitempty"`
+}
+
+// Context combines an environment with the user logged into it and the defaults of the commands run on it
+type Context struct {
+	Environment string `yaml:"environment"`
+	User        string `yaml:"user,omitempty"`
+	Tenant      string `yaml:"tenant,omitempty"`
+	Output      string `yaml:"output,omitempty"`
 }
 
 type Config struct {
@@ -66,10 +79,26 @@ type EnvEndpoints struct {
 	AdminEndpoint        string `yaml:"admin"`
 	TokenEndpoint        string `yaml:"token"`
 	MiManagementEndpoint string `yaml:"mi"`
+	TransportSettings    `yaml:",inline"`
 }
 
 type MgwEndpoints struct {
-	AdapterEndpoint string `yaml:"adapter"`
+	AdapterEndpoint   string `yaml:"adapter"`
+	TransportSettings `yaml:",inline"`
+}
+
+// TransportSettings are the TLS and proxy settings of the connections to the endpoints of an environment. The relative
+// paths are resolved against the config directory.
+type TransportSettings struct {
+	// CABundle is a PEM file with the CA certificates trusted in addition to the certificates in the certs directory
+	CABundle string `yaml:"ca_bundle,omitempty"`
+	// ClientCertificate and ClientKey are the PEM files of the certificate presented for mutual TLS
+	ClientCertificate string `yaml:"client_cert,omitempty"`
+	ClientKey         string `yaml:"client_key,omitempty"`
+	// Proxy is the URL of the HTTP proxy. The proxy environment variables are used if it is blank
+	Proxy string `yaml:"proxy,omitempty"`
+	// TLSRenegotiationMode overrides the tls-renegotiation-mode of the config
+	TLSRenegotiationMode string `yaml:"tls_renegotiation_mode,omitempty"`
 }
 
 // ---------------- End of Structs for YAML Config Files ---------------------------------
@@ -332,6 +361,15 @@ type ExportThrottlePolicy struct {
 	Data    yaml.MapSlice `json:"data"`
 }
 
+// ExportAdminResource is the envelope of a key manager, gateway environment, scope, API category, deny policy or
+// tenant config exported from an environment
+type ExportAdminResource struct {
+	Type    string          `json:"type"`
+	Subtype string          `json:"subtype"`
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
 // Throttling Policies List response struct
 type ThrottlingPoliciesList struct {
 	Count      int                `json:"count"`
@@ -387,6 +425,47 @@ type SubscriptionCreateRequest struct {
 	ThrottlingPolicy string `json:"throttlingPolicy"`
 }
 
+// DevPortal APIs list response struct
+type DevPortalApiList struct {
+	Count int            `json:"count"`
+	List  []DevPortalApi `json:"list"`
+}
+
+// API or API Product listed in the DevPortal
+type DevPortalApi struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Version            string   `json:"version"`
+	Provider           string   `json:"provider"`
+	Type               string   `json:"type"`
+	ThrottlingPolicies []string `json:"throttlingPolicies"`
+}
+
+// OAuth keys of an application
+type OAuthKeyList struct {
+	Count int        `json:"count"`
+	List  []OAuthKey `json:"list"`
+}
+
+// OAuth key of an application generated in or mapped from a key manager
+type OAuthKey struct {
+	KeyMappingID   string `json:"keyMappingId"`
+	KeyManager     string `json:"keyManager"`
+	ConsumerKey    string `json:"consumerKey"`
+	ConsumerSecret string `json:"consumerSecret"`
+	KeyState       string `json:"keyState"`
+	KeyType        string `json:"keyType"`
+	Mode           string `json:"mode"`
+}
+
+// Request to map the OAuth keys created out of band to an application
+type KeyMappingRequest struct {
+	ConsumerKey    string `json:"consumerKey"`
+	ConsumerSecret string `json:"consumerSecret"`
+	KeyType        string `json:"keyType"`
+	KeyManager     string `json:"keyManager"`
+}
+
 // API Search response struct. This includes common attributes for both store and publisher REST API search
 type ApiSearch struct {
 	Count int `json:"count"`
@@ -512,6 +591,16 @@ type ApplicationEntry struct {
 	GroupId string
 }
 
+// SubscriptionEntry Subscription List Entry struct to support  different formats of output in the list command
+type SubscriptionEntry struct {
+	Id               string
+	ApiName          string
+	ApiVersion       string
+	Application      string
+	ThrottlingPolicy string
+	Status           string
+}
+
 // RevisionEntry Revision List Entry struct to support  different formats of output in the list command
 type RevisionEntry struct {
 	Id             string
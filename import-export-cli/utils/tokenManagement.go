@@ -27,6 +27,7 @@ import (
 	encodeURL "net/url"
 	"strings"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/renstrom/dedent"
 )
 
@@ -250,8 +251,37 @@ func ExecutePreCommandWithOAuth(environment, flagUsername, flagPassword, mainCon
 // @param url : Registration Endpoint for the environment
 // @return client_id, client_secret, error
 func GetClientIDSecret(username, password, url string) (clientID string, clientSecret string, err error) {
-	updatedUsername := strings.ReplaceAll(username, "@", "_")
-	body := dedent.Dedent(`{"clientName": "rest_api_import_export_` + updatedUsername +  `",
+	resp, err := invokeClientRegistration(username, password, url)
+	if err != nil {
+		HandleErrorAndExit("Error in connecting.", err)
+	}
+	return readClientRegistrationResponse(resp)
+}
+
+// RegisterClient registers the DCR client of the user as GetClientIDSecret does, but returns the error of a failed
+// connection instead of exiting
+// @param username : Username for application server account
+// @param password : Password for application server account
+// @param url : Registration Endpoint for the environment
+// @return client_id, client_secret, error
+func RegisterClient(username, password, url string) (clientID string, clientSecret string, err error) {
+	resp, err := invokeClientRegistration(username, password, url)
+	if err != nil {
+		return "", "", err
+	}
+	return readClientRegistrationResponse(resp)
+}
+
+// GetRegisteredClientName returns the name of the DCR client registered for the user
+// @param username : Username for application server account
+// @return name of the client
+func GetRegisteredClientName(username string) string {
+	return "rest_api_import_export_" + strings.ReplaceAll(username, "@", "_")
+}
+
+// invokeClientRegistration sends the DCR request of the client of the user
+func invokeClientRegistration(username, password, url string) (*resty.Response, error) {
+	body := dedent.Dedent(`{"clientName": "` + GetRegisteredClientName(username) + `",
 								  "callbackUrl": "www.google.lk",
 								  "grantType":"password refresh_token",
 								  "saasApp": true,
@@ -265,12 +295,11 @@ func GetClientIDSecret(username, password, url string) (clientID string, clientS
 	headers[HeaderAuthorization] = HeaderValueAuthBasicPrefix + " " + GetBase64EncodedCredentials(username, password)
 
 	// POST request using resty
-	resp, err := InvokePOSTRequest(url, headers, body)
-
-	if err != nil {
-		HandleErrorAndExit("Error in connecting.", err)
-	}
+	return InvokePOSTRequest(url, headers, body)
+}
 
+// readClientRegistrationResponse reads the client ID and the client secret from the response of the DCR request
+func readClientRegistrationResponse(resp *resty.Response) (clientID string, clientSecret string, err error) {
 	Logln("Getting ClientID, ClientSecret: Status - " + resp.Status())
 
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
@@ -305,6 +334,11 @@ func GetBase64EncodedCredentials(key, secret string) (encodedValue string) {
 	return encoded
 }
 
+// OAuthScopes are the scopes requested for the access tokens of the CLI
+const OAuthScopes = "apim:app_import_export apim:api_import_export apim:api_product_import_export apim:app_manage " +
+	"apim:sub_manage apim:api_view apim:api_delete apim:app_owner_change apim:subscribe apim:api_publish " +
+	"apim:admin apim:policies_import_export apim:subscription_block apim:shared_scope_manage"
+
 // GetOAuthTokens implemented using go-resty/resty
 // @param username
 // @param password
@@ -314,9 +348,7 @@ func GetBase64EncodedCredentials(key, secret string) (encodedValue string) {
 // @return error
 func GetOAuthTokens(username, password, b64EncodedClientIDClientSecret, url string) (map[string]string, error) {
 	body := "grant_type=password&username=" + username + "&password=" + encodeURL.QueryEscape(password) +
-		"&scope=apim:app_import_export+apim:api_import_export+apim:api_product_import_export+apim:app_manage+" +
-		"apim:sub_manage+apim:api_view+apim:api_delete+apim:app_owner_change+apim:subscribe+apim:api_publish+" +
-		"apim:admin+apim:policies_import_export"
+		"&scope=" + strings.ReplaceAll(OAuthScopes, " ", "+")
 
 	// set headers
 	headers := make(map[string]string)
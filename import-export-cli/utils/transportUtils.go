@@ -0,0 +1,212 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// tlsRenegotiationModes maps the TLS renegotiation modes of the config to the renegotiation support of the client
+var tlsRenegotiationModes = map[string]tls.RenegotiationSupport{
+	TLSRenegotiationOnce:   tls.RenegotiateOnceAsClient,
+	TLSRenegotiationFreely: tls.RenegotiateFreelyAsClient,
+	TLSRenegotiationNever:  tls.RenegotiateNever,
+}
+
+// endpointTransportSettings are the transport settings of the environment an endpoint belongs to
+type endpointTransportSettings struct {
+	env      string
+	endpoint *url.URL
+	settings TransportSettings
+}
+
+// envTransportSettings are the transport settings of the endpoints of the environments in the main config
+var envTransportSettings []endpointTransportSettings
+
+// setEnvTransportSettings keeps the transport settings of the environments, which are matched with the URLs of the
+// requests when the HTTP clients are created
+func setEnvTransportSettings(mainConfig *MainConfig) {
+	envTransportSettings = nil
+	addEndpoints := func(env string, settings TransportSettings, endpoints ...string) {
+		if settings == (TransportSettings{}) {
+			return
+		}
+		for _, endpoint := range endpoints {
+			if endpointURL, err := url.Parse(endpoint); err == nil && endpointURL.Host != "" {
+				envTransportSettings = append(envTransportSettings,
+					endpointTransportSettings{env: env, endpoint: endpointURL, settings: settings})
+			}
+		}
+	}
+	for env, endpoints := range mainConfig.Environments {
+		addEndpoints(env, endpoints.TransportSettings, endpoints.ApiManagerEndpoint, endpoints.PublisherEndpoint,
+			endpoints.DevPortalEndpoint, endpoints.RegistrationEndpoint, endpoints.AdminEndpoint,
+			endpoints.TokenEndpoint, endpoints.MiManagementEndpoint)
+	}
+	for env, endpoints := range mainConfig.MgwAdapterEnvs {
+		addEndpoints(env, endpoints.TransportSettings, endpoints.AdapterEndpoint)
+	}
+}
+
+// getTransportSettingsOfURL returns the transport settings of the environment with an endpoint on the same scheme, host
+// and port as the URL. If several endpoints match, the endpoint with the longest path prefixing the path of the URL
+// is preferred, and then the environment which comes first by name.
+// @param requestURL : URL of the request
+// @return transport settings, or nil if the URL does not belong to an environment with transport settings
+func getTransportSettingsOfURL(requestURL string) *TransportSettings {
+	parsedURL, err := url.Parse(requestURL)
+	if err != nil {
+		return nil
+	}
+	var matched *endpointTransportSettings
+	matchedPathLength := -1
+	for i, candidate := range envTransportSettings {
+		if !strings.EqualFold(candidate.endpoint.Scheme, parsedURL.Scheme) ||
+			!strings.EqualFold(hostWithPort(candidate.endpoint), hostWithPort(parsedURL)) {
+			continue
+		}
+		// An endpoint prefixing the path of the URL is preferred over an endpoint on the same host only
+		pathLength := 0
+		if strings.HasPrefix(parsedURL.Path, strings.TrimSuffix(candidate.endpoint.Path, "/")) {
+			pathLength = len(candidate.endpoint.Path) + 1
+		}
+		if pathLength > matchedPathLength || (pathLength == matchedPathLength && candidate.env < matched.env) {
+			matched = &envTransportSettings[i]
+			matchedPathLength = pathLength
+		}
+	}
+	if matched == nil {
+		return nil
+	}
+	Logln(LogPrefixInfo + "Using the transport settings of the environment " + matched.env + " for " +
+		parsedURL.Host)
+	return &matched.settings
+}
+
+// hostWithPort returns the host of the URL with the default port of the scheme if the URL has no port
+func hostWithPort(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	if strings.EqualFold(u.Scheme, "http") {
+		return u.Hostname() + ":80"
+	}
+	return u.Hostname() + ":443"
+}
+
+// ValidateTransportSettings checks the renegotiation mode and the client certificate of the transport settings
+// @param settings : Transport settings of an environment
+// @return error
+func ValidateTransportSettings(settings TransportSettings) error {
+	if settings.TLSRenegotiationMode != "" {
+		if _, ok := tlsRenegotiationModes[settings.TLSRenegotiationMode]; !ok {
+			return fmt.Errorf("invalid TLS renegotiation mode '%s'. It should be one of %s, %s or %s",
+				settings.TLSRenegotiationMode, TLSRenegotiationNever, TLSRenegotiationOnce, TLSRenegotiationFreely)
+		}
+	}
+	if (settings.ClientCertificate == "") != (settings.ClientKey == "") {
+		return errors.New("both the client certificate and the client key should be given for mutual TLS")
+	}
+	if settings.Proxy != "" {
+		if proxyURL, err := url.Parse(settings.Proxy); err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return fmt.Errorf("invalid proxy URL '%s'", settings.Proxy)
+		}
+	}
+	return nil
+}
+
+// GetTLSConfigOfEnv returns the TLS config of the connections to an environment. The CA bundle of the environment is
+// trusted in addition to the default certificates, and the client certificate is presented for mutual TLS.
+// @param settings : Transport settings of the environment, or nil for the default TLS config
+// @return TLS config
+// @return error
+func GetTLSConfigOfEnv(settings *TransportSettings) (*tls.Config, error) {
+	var tlsConfig *tls.Config
+	if Insecure {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
+			Renegotiation: TLSRenegotiationMode}
+	} else {
+		tlsConfig = GetTlsConfigWithCertificate()
+	}
+	if settings == nil {
+		return tlsConfig, nil
+	}
+	if err := ValidateTransportSettings(*settings); err != nil {
+		return nil, err
+	}
+	if settings.TLSRenegotiationMode != "" {
+		tlsConfig.Renegotiation = tlsRenegotiationModes[settings.TLSRenegotiationMode]
+	}
+	if settings.CABundle != "" && !Insecure {
+		caBundle, err := ioutil.ReadFile(resolveConfigPath(settings.CABundle))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CA bundle: %w", err)
+		}
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("no certificates found in the CA bundle " + settings.CABundle)
+		}
+	}
+	if settings.ClientCertificate != "" {
+		clientCert, err := tls.LoadX509KeyPair(resolveConfigPath(settings.ClientCertificate),
+			resolveConfigPath(settings.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	return tlsConfig, nil
+}
+
+// resolveConfigPath resolves a path relative to the config directory
+func resolveConfigPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(ConfigDirPath, path)
+}
+
+// newHTTPClient creates a resty client for the request URL with the TLS and proxy settings of the environment the URL
+// belongs to and the configured request timeout
+// @param requestURL : URL of the request
+// @return resty client
+// @return error
+func newHTTPClient(requestURL string) (*resty.Client, error) {
+	settings := getTransportSettingsOfURL(requestURL)
+	tlsConfig, err := GetTLSConfigOfEnv(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	client := resty.New()
+	client.SetTLSClientConfig(tlsConfig)
+	if settings != nil && settings.Proxy != "" {
+		client.SetProxy(settings.Proxy)
+	}
+	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
+	return client, nil
+}
@@ -0,0 +1,200 @@
+/*
+*  Copyright (c) 2024, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 LLC. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeClientCertificate writes a self-signed client certificate and its key as PEM files to the directory
+func writeClientCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	assert.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		0600))
+	assert.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0600))
+	return certPath, keyPath
+}
+
+// writeCABundle writes the certificate of the test server as a PEM file to the directory
+func writeCABundle(t *testing.T, dir string, server *httptest.Server) string {
+	caPath := filepath.Join(dir, "ca.pem")
+	assert.NoError(t, ioutil.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE",
+		Bytes: server.Certificate().Raw}), 0600))
+	return caPath
+}
+
+func TestGetTransportSettingsOfURL(t *testing.T) {
+	defer func() { envTransportSettings = nil }()
+	setEnvTransportSettings(&MainConfig{
+		Environments: map[string]EnvEndpoints{
+			"dev": {
+				ApiManagerEndpoint: "https://apim.dev.internal",
+				TransportSettings:  TransportSettings{Proxy: "http://proxy.dev.internal:3128"},
+			},
+			"prod": {
+				PublisherEndpoint: "https://apim.internal:9443/api/am/publisher",
+				TokenEndpoint:     "https://gw.internal:8243/token",
+				TransportSettings: TransportSettings{CABundle: "prod-ca.pem"},
+			},
+			"qa": {
+				ApiManagerEndpoint: "https://apim.internal:9443",
+				TransportSettings:  TransportSettings{CABundle: "qa-ca.pem"},
+			},
+			"local": {
+				ApiManagerEndpoint: "https://localhost:9443",
+			},
+		},
+		MgwAdapterEnvs: map[string]MgwEndpoints{
+			"adapter": {
+				AdapterEndpoint:   "https://adapter.internal:9843/api/mgw/adapter/0.1",
+				TransportSettings: TransportSettings{TLSRenegotiationMode: TLSRenegotiationOnce},
+			},
+		},
+	})
+
+	settings := getTransportSettingsOfURL("https://apim.dev.internal:443/api/am/admin/v4/tenant-info")
+	if assert.NotNil(t, settings) {
+		assert.Equal(t, "http://proxy.dev.internal:3128", settings.Proxy)
+	}
+
+	settings = getTransportSettingsOfURL("https://apim.internal:9443/api/am/publisher/v4/apis")
+	if assert.NotNil(t, settings) {
+		assert.Equal(t, "prod-ca.pem", settings.CABundle, "The endpoint with the longest matching path is preferred")
+	}
+
+	settings = getTransportSettingsOfURL("https://apim.internal:9443/api/am/devportal/v3/apis")
+	if assert.NotNil(t, settings) {
+		assert.Equal(t, "qa-ca.pem", settings.CABundle)
+	}
+
+	settings = getTransportSettingsOfURL("https://gw.internal:8243/token")
+	if assert.NotNil(t, settings) {
+		assert.Equal(t, "prod-ca.pem", settings.CABundle)
+	}
+
+	settings = getTransportSettingsOfURL("https://adapter.internal:9843/api/mgw/adapter/0.1/apis")
+	if assert.NotNil(t, settings) {
+		assert.Equal(t, TLSRenegotiationOnce, settings.TLSRenegotiationMode)
+	}
+
+	assert.Nil(t, getTransportSettingsOfURL("https://localhost:9443/api/am/publisher/v4/apis"),
+		"Environments without transport settings should use the defaults")
+	assert.Nil(t, getTransportSettingsOfURL("http://apim.internal:9443/api/am/publisher/v4/apis"))
+}
+
+func TestValidateTransportSettings(t *testing.T) {
+	assert.NoError(t, ValidateTransportSettings(TransportSettings{}))
+	assert.NoError(t, ValidateTransportSettings(TransportSettings{ClientCertificate: "client.crt",
+		ClientKey: "client.key", Proxy: "http://proxy:3128", TLSRenegotiationMode: TLSRenegotiationFreely}))
+	assert.Error(t, ValidateTransportSettings(TransportSettings{TLSRenegotiationMode: "always"}))
+	assert.Error(t, ValidateTransportSettings(TransportSettings{ClientCertificate: "client.crt"}))
+	assert.Error(t, ValidateTransportSettings(TransportSettings{Proxy: "proxy:3128"}))
+}
+
+func TestInvokeGETRequestWithCABundleAndClientCertificate(t *testing.T) {
+	defer func() { envTransportSettings = nil }()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	certPath, keyPath := writeClientCertificate(t, dir)
+	caPath := writeCABundle(t, dir, server)
+
+	_, err := InvokeGETRequest(server.URL, nil)
+	assert.Error(t, err, "The certificate of the server should not be trusted without the CA bundle")
+
+	setEnvTransportSettings(&MainConfig{Environments: map[string]EnvEndpoints{
+		"internal": {
+			ApiManagerEndpoint: server.URL,
+			TransportSettings:  TransportSettings{CABundle: caPath},
+		},
+	}})
+	_, err = InvokeGETRequest(server.URL, nil)
+	assert.Error(t, err, "The server should reject the connection without a client certificate")
+
+	setEnvTransportSettings(&MainConfig{Environments: map[string]EnvEndpoints{
+		"internal": {
+			ApiManagerEndpoint: server.URL,
+			TransportSettings:  TransportSettings{CABundle: caPath, ClientCertificate: certPath, ClientKey: keyPath},
+		},
+	}})
+	resp, err := InvokeGETRequest(server.URL+"/api/am/publisher/v4/apis", nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+	}
+}
+
+func TestInvokeGETRequestThroughProxy(t *testing.T) {
+	defer func() { envTransportSettings = nil }()
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	setEnvTransportSettings(&MainConfig{Environments: map[string]EnvEndpoints{
+		"internal": {
+			MiManagementEndpoint: "http://mi.internal:9164",
+			TransportSettings:    TransportSettings{Proxy: proxy.URL},
+		},
+	}})
+	resp, err := InvokeGETRequest("http://mi.internal:9164/management/apis", nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, "http://mi.internal:9164/management/apis", proxiedURL)
+	}
+}